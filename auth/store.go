@@ -17,6 +17,7 @@ package auth
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"encoding/binary"
 	"errors"
 	"sort"
@@ -206,6 +207,10 @@ type authStore struct {
 
 	tokenProvider TokenProvider
 	bcryptCost    int // the algorithm cost / strength for hashing auth passwords
+
+	// certSANUsername is true to take the first DNS SAN of client
+	// certificates without a Common Name as their user name.
+	certSANUsername bool
 }
 
 func (as *authStore) AuthEnable() error {
@@ -1159,7 +1164,7 @@ func (as *authStore) AuthInfoFromTLS(ctx context.Context) (ai *AuthInfo) {
 			continue
 		}
 		ai = &AuthInfo{
-			Username: chains[0].Subject.CommonName,
+			Username: as.usernameFromCert(chains[0]),
 			Revision: as.Revision(),
 		}
 		if as.lg != nil {
@@ -1177,6 +1182,26 @@ func (as *authStore) AuthInfoFromTLS(ctx context.Context) (ai *AuthInfo) {
 	return ai
 }
 
+// EnableCertSANUsername makes client certificates without a Common Name
+// authenticate as the user named by their first DNS Subject Alternative
+// Name. It must be called before the auth store serves requests.
+func (as *authStore) EnableCertSANUsername() {
+	as.certSANUsername = true
+}
+
+// usernameFromCert returns the etcd user a client certificate maps to:
+// its Common Name or, if enabled and the Common Name is empty, its first
+// DNS Subject Alternative Name.
+func (as *authStore) usernameFromCert(cert *x509.Certificate) string {
+	if cert.Subject.CommonName != "" {
+		return cert.Subject.CommonName
+	}
+	if as.certSANUsername && len(cert.DNSNames) > 0 {
+		return cert.DNSNames[0]
+	}
+	return ""
+}
+
 func (as *authStore) AuthInfoFromCtx(ctx context.Context) (*AuthInfo, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -1185,14 +1210,20 @@ func (as *authStore) AuthInfoFromCtx(ctx context.Context) (*AuthInfo, error) {
 
 	//TODO(mitake|hexfusion) review unifying key names
 	ts, ok := md[rpctypes.TokenFieldNameGRPC]
+	bearer := false
 	if !ok {
 		ts, ok = md[rpctypes.TokenFieldNameSwagger]
+		bearer = ok
 	}
 	if !ok {
 		return nil, nil
 	}
 
 	token := ts[0]
+	if bearer {
+		// the gateway's WebSocket proxy passes tokens as "Bearer <token>"
+		token = strings.TrimPrefix(token, "Bearer ")
+	}
 	authInfo, uok := as.authInfoFromToken(ctx, token)
 	if !uok {
 		if as.lg != nil {
@@ -16,6 +16,9 @@ package auth
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
 	"os"
 	"reflect"
@@ -31,7 +34,9 @@ import (
 
 	"go.uber.org/zap"
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 func dummyIndexWaiter(index uint64) <-chan struct{} {
@@ -509,6 +514,15 @@ func TestAuthInfoFromCtx(t *testing.T) {
 	if ai.Username != "foo" {
 		t.Errorf("expected %v, got %v", "foo", ai.Username)
 	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{rpctypes.TokenFieldNameSwagger: "Bearer " + resp.Token}))
+	ai, err = as.AuthInfoFromCtx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ai.Username != "foo" {
+		t.Errorf("expected %v, got %v", "foo", ai.Username)
+	}
 }
 
 func TestAuthDisable(t *testing.T) {
@@ -763,3 +777,66 @@ func testAuthInfoFromCtxWithRoot(t *testing.T, opts string) {
 		t.Errorf("expected user name 'root', got %+v", ai)
 	}
 }
+
+func TestAuthInfoFromTLS(t *testing.T) {
+	b, tPath := backend.NewDefaultTmpBackend()
+	defer os.Remove(tPath)
+
+	as := NewAuthStore(zap.NewExample(), b, nil, bcrypt.MinCost)
+	defer as.Close()
+
+	tests := []struct {
+		cert  *x509.Certificate
+		san   bool
+		wuser string
+	}{
+		{&x509.Certificate{Subject: pkix.Name{CommonName: "kube-apiserver"}, DNSNames: []string{"api.example.com"}}, false, "kube-apiserver"},
+		{&x509.Certificate{Subject: pkix.Name{CommonName: "kube-apiserver"}, DNSNames: []string{"api.example.com"}}, true, "kube-apiserver"},
+		{&x509.Certificate{DNSNames: []string{"metrics.example.com", "m.example.com"}}, false, ""},
+		{&x509.Certificate{DNSNames: []string{"metrics.example.com", "m.example.com"}}, true, "metrics.example.com"},
+		{&x509.Certificate{}, true, ""},
+	}
+	for i, tt := range tests {
+		as.certSANUsername = tt.san
+		ctx := peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{tt.cert}}}},
+		})
+		ai := as.AuthInfoFromTLS(ctx)
+		if ai == nil {
+			t.Fatalf("#%d: expected non-nil *AuthInfo", i)
+		}
+		if ai.Username != tt.wuser {
+			t.Errorf("#%d: user name = %q, want %q", i, ai.Username, tt.wuser)
+		}
+	}
+}
+
+// TestAuthInfoFromTLSSANDisabled ensures a client certificate without a
+// Common Name is not granted the permissions of the user named by its SAN
+// unless SAN user names are enabled.
+func TestAuthInfoFromTLSSANDisabled(t *testing.T) {
+	as, tearDown := setupAuthStore(t)
+	defer tearDown(t)
+
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{&x509.Certificate{DNSNames: []string{"root"}}}}}},
+	})
+	ai := as.AuthInfoFromTLS(ctx)
+	if ai == nil {
+		t.Fatal("expected non-nil *AuthInfo")
+	}
+	if ai.Username != "" {
+		t.Fatalf("user name = %q, want empty", ai.Username)
+	}
+	if err := as.IsAdminPermitted(ai); err == nil {
+		t.Fatal("expected error for certificate without Common Name")
+	}
+
+	as.EnableCertSANUsername()
+	if ai = as.AuthInfoFromTLS(ctx); ai.Username != "root" {
+		t.Fatalf("user name = %q, want %q", ai.Username, "root")
+	}
+	if err := as.IsAdminPermitted(ai); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -242,6 +242,13 @@ func (c *Client) dialSetupOpts(endpoint string, dopts ...grpc.DialOption) (opts
 		}
 		opts = append(opts, grpc.WithKeepaliveParams(params))
 	}
+	if c.cfg.AcceptGZIP {
+		opts = append(opts,
+			grpc.WithDecompressor(grpc.NewGZIPDecompressor()),
+			grpc.WithUnaryInterceptor(acceptGZIPUnaryInterceptor),
+			grpc.WithStreamInterceptor(acceptGZIPStreamInterceptor),
+		)
+	}
 	opts = append(opts, dopts...)
 
 	f := func(host string, t time.Duration) (net.Conn, error) {
@@ -363,6 +370,21 @@ func WithRequireLeader(ctx context.Context) context.Context {
 	return metadata.NewOutgoingContext(ctx, md)
 }
 
+// withAcceptGZIP asks the server to gzip-compress the responses of the
+// requests made with the returned context.
+func withAcceptGZIP(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	return metadata.NewOutgoingContext(ctx, metadata.Join(md, metadata.Pairs("grpc-accept-encoding", "gzip")))
+}
+
+func acceptGZIPUnaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(withAcceptGZIP(ctx), method, req, reply, cc, opts...)
+}
+
+func acceptGZIPStreamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(withAcceptGZIP(ctx), desc, cc, method, opts...)
+}
+
 func newClient(cfg *Config) (*Client, error) {
 	if cfg == nil {
 		cfg = &Config{}
@@ -0,0 +1,84 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util
+
+import (
+	"context"
+	"errors"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+// DefaultBatchGetOps is the number of keys BatchGet reads per transaction
+// by default. It matches the default --max-txn-ops of the server.
+const DefaultBatchGetOps = 128
+
+// ErrBatchGetRange is returned by BatchGet when opts read a range of keys,
+// such as clientv3.WithPrefix or clientv3.WithRange.
+var ErrBatchGetRange = errors.New("clientv3util: BatchGet reads exact keys, not ranges")
+
+// BatchGet reads the given keys in one read-only transaction, so fetching
+// many known keys costs one round trip instead of one per key. It returns
+// the keys in the order given, with nil for keys that do not exist, and the
+// revision they were read at. More than maxOps keys are read in several
+// transactions, all at the same revision, so the result is still a
+// consistent view; maxOps must not exceed the --max-txn-ops of the server,
+// and 0 means DefaultBatchGetOps. opts apply to every key read; with
+// clientv3.WithRev, the keys are read, and the revision returned, at that
+// revision.
+func BatchGet(ctx context.Context, kv clientv3.KV, keys []string, maxOps int, opts ...clientv3.OpOption) ([]*mvccpb.KeyValue, int64, error) {
+	if len(keys) == 0 {
+		return nil, 0, nil
+	}
+	op := clientv3.OpGet(keys[0], opts...)
+	if op.RangeBytes() != nil {
+		return nil, 0, ErrBatchGetRange
+	}
+	if maxOps <= 0 {
+		maxOps = DefaultBatchGetOps
+	}
+	kvs := make([]*mvccpb.KeyValue, 0, len(keys))
+	rev := op.Rev()
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxOps {
+			n = maxOps
+		}
+		ops := make([]clientv3.Op, n)
+		for i, k := range keys[:n] {
+			ops[i] = clientv3.OpGet(k, opts...)
+		}
+		resp, err := kv.Txn(ctx).Then(ops...).Commit()
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, r := range resp.Responses {
+			var found *mvccpb.KeyValue
+			if rkvs := r.GetResponseRange().Kvs; len(rkvs) > 0 {
+				found = rkvs[0]
+			}
+			kvs = append(kvs, found)
+		}
+		if rev == 0 {
+			// pin later batches to the revision of the first
+			rev = resp.Header.Revision
+			// copy opts; appending could write to the caller's array
+			opts = append(append([]clientv3.OpOption(nil), opts...), clientv3.WithRev(rev))
+		}
+		keys = keys[n:]
+	}
+	return kvs, rev, nil
+}
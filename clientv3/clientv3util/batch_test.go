@@ -0,0 +1,131 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util_test
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/clientv3util"
+)
+
+func TestBatchGetConsistentAcrossTxns(t *testing.T) {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: endpoints})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	var keys []string
+	for i := 0; i < 100; i++ {
+		k := fmt.Sprintf("batch/%03d", i)
+		if i%2 == 0 {
+			if _, err = cli.Put(context.TODO(), k, "v1"); err != nil {
+				t.Fatal(err)
+			}
+		}
+		keys = append(keys, k)
+	}
+
+	// overwrite the keys while they are read, one per transaction
+	ctx, cancel := context.WithCancel(context.Background())
+	donec := make(chan struct{})
+	go func() {
+		defer close(donec)
+		for i := 0; ctx.Err() == nil; i = (i + 2) % len(keys) {
+			cli.Put(ctx, keys[i], fmt.Sprintf("v%d", i+2))
+		}
+	}()
+	kvs, rev, err := clientv3util.BatchGet(context.TODO(), cli, keys, 1)
+	cancel()
+	<-donec
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gresp, err := cli.Get(context.TODO(), "batch/", clientv3.WithPrefix(), clientv3.WithRev(rev))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != len(keys) || len(gresp.Kvs) != len(keys)/2 {
+		t.Fatalf("got %d keys and %d at revision %d, want %d and %d", len(kvs), len(gresp.Kvs), rev, len(keys), len(keys)/2)
+	}
+	for i, got := range kvs {
+		if i%2 == 1 {
+			if got != nil {
+				t.Fatalf("#%d: got %q, want missing key", i, got.Key)
+			}
+			continue
+		}
+		if want := gresp.Kvs[i/2]; got == nil || !reflect.DeepEqual(*got, *want) {
+			t.Fatalf("#%d: got %+v, want %+v as of revision %d", i, got, want, rev)
+		}
+	}
+}
+
+func TestBatchGetWithRev(t *testing.T) {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: endpoints})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	presp, err := cli.Put(context.TODO(), "batchrev/a", "v1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(context.TODO(), "batchrev/a", "v2"); err != nil {
+		t.Fatal(err)
+	}
+
+	kvs, rev, err := clientv3util.BatchGet(context.TODO(), cli, []string{"batchrev/a"}, 0, clientv3.WithRev(presp.Header.Revision))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rev != presp.Header.Revision {
+		t.Fatalf("revision = %d, want %d", rev, presp.Header.Revision)
+	}
+	if len(kvs) != 1 || kvs[0] == nil || string(kvs[0].Value) != "v1" {
+		t.Fatalf("got %+v, want batchrev/a = v1", kvs)
+	}
+
+	for _, opt := range []clientv3.OpOption{clientv3.WithPrefix(), clientv3.WithRange("batchrev/b"), clientv3.WithFromKey()} {
+		if _, _, err = clientv3util.BatchGet(context.TODO(), cli, []string{"batchrev/a"}, 0, opt); err != clientv3util.ErrBatchGetRange {
+			t.Fatalf("expected %v, got %v", clientv3util.ErrBatchGetRange, err)
+		}
+	}
+}
+
+// TestBatchGetKeepsOpts ensures BatchGet does not write to the spare
+// capacity of the opts passed to it.
+func TestBatchGetKeepsOpts(t *testing.T) {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: endpoints})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	opts := make([]clientv3.OpOption, 1, 2)
+	opts[0] = clientv3.WithSerializable()
+	if _, _, err = clientv3util.BatchGet(context.TODO(), cli, []string{"batchopts/a", "batchopts/b"}, 1, opts...); err != nil {
+		t.Fatal(err)
+	}
+	if opts[:2][1] != nil {
+		t.Fatal("BatchGet appended to the opts of the caller")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+// ConflictError is returned by CompareAndSwap and CompareAndDelete when
+// the key was modified since the expected revision.
+type ConflictError struct {
+	Key string
+	// ExpectedModRevision is the mod revision the caller expected.
+	ExpectedModRevision int64
+	// Current is the key as read in the failed transaction,
+	// or nil if the key does not exist.
+	Current *mvccpb.KeyValue
+	// Revision is the store revision the transaction was evaluated at.
+	Revision int64
+}
+
+func (e *ConflictError) Error() string {
+	cur := int64(0)
+	if e.Current != nil {
+		cur = e.Current.ModRevision
+	}
+	return fmt.Sprintf("clientv3util: key %q mod revision is %d, expected %d", e.Key, cur, e.ExpectedModRevision)
+}
+
+// CompareAndSwap puts val at key iff the key's mod revision equals
+// modRev, where 0 means the key must not exist. It returns the revision
+// of the put. If the comparison fails, the current key is read in the
+// same transaction and returned in a *ConflictError, so callers can
+// retry without an extra Get round trip.
+func CompareAndSwap(ctx context.Context, kv clientv3.KV, key, val string, modRev int64, opts ...clientv3.OpOption) (int64, error) {
+	return compareAndDo(ctx, kv, key, modRev, clientv3.OpPut(key, val, opts...))
+}
+
+// CompareAndDelete deletes key iff its mod revision equals modRev.
+// It returns the revision of the delete. On conflict it returns a
+// *ConflictError holding the current key, as CompareAndSwap does.
+func CompareAndDelete(ctx context.Context, kv clientv3.KV, key string, modRev int64) (int64, error) {
+	return compareAndDo(ctx, kv, key, modRev, clientv3.OpDelete(key))
+}
+
+func compareAndDo(ctx context.Context, kv clientv3.KV, key string, modRev int64, op clientv3.Op) (int64, error) {
+	resp, err := kv.Txn(ctx).
+		If(clientv3.Compare(clientv3.ModRevision(key), "=", modRev)).
+		Then(op).
+		Else(clientv3.OpGet(key)).
+		Commit()
+	if err != nil {
+		return 0, err
+	}
+	if resp.Succeeded {
+		return resp.Header.Revision, nil
+	}
+	cerr := &ConflictError{Key: key, ExpectedModRevision: modRev, Revision: resp.Header.Revision}
+	if kvs := resp.Responses[0].GetResponseRange().Kvs; len(kvs) > 0 {
+		cerr.Current = kvs[0]
+	}
+	return 0, cerr
+}
@@ -16,7 +16,9 @@ package clientv3util_test
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/clientv3/clientv3util"
@@ -24,7 +26,7 @@ import (
 
 func ExampleKeyMissing() {
 	cli, err := clientv3.New(clientv3.Config{
-		Endpoints: []string{"127.0.0.1:2379"},
+		Endpoints: endpoints,
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -37,17 +39,23 @@ func ExampleKeyMissing() {
 	// the existing key which would generate potentially unwanted events,
 	// unless of course you wanted to do an overwrite no matter what.
 	_, err = kvc.Txn(context.Background()).
-		If(clientv3util.KeyMissing("purpleidea")).
-		Then(clientv3.OpPut("purpleidea", "hello world")).
+		If(clientv3util.KeyMissing("keymissing/purpleidea")).
+		Then(clientv3.OpPut("keymissing/purpleidea", "hello world")).
 		Commit()
 	if err != nil {
 		log.Fatal(err)
 	}
+	resp, err := kvc.Get(context.Background(), "keymissing/purpleidea")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", resp.Kvs[0].Value)
+	// Output: hello world
 }
 
 func ExampleKeyExists() {
 	cli, err := clientv3.New(clientv3.Config{
-		Endpoints: []string{"127.0.0.1:2379"},
+		Endpoints: endpoints,
 	})
 	if err != nil {
 		log.Fatal(err)
@@ -55,12 +63,216 @@ func ExampleKeyExists() {
 	defer cli.Close()
 	kvc := clientv3.NewKV(cli)
 
+	if _, err = kvc.Put(context.Background(), "keyexists/purpleidea", "hello world"); err != nil {
+		log.Fatal(err)
+	}
+
 	// perform a delete only if key already exists
 	_, err = kvc.Txn(context.Background()).
-		If(clientv3util.KeyExists("purpleidea")).
-		Then(clientv3.OpDelete("purpleidea")).
+		If(clientv3util.KeyExists("keyexists/purpleidea")).
+		Then(clientv3.OpDelete("keyexists/purpleidea")).
 		Commit()
 	if err != nil {
 		log.Fatal(err)
 	}
+	resp, err := kvc.Get(context.Background(), "keyexists/purpleidea", clientv3.WithCountOnly())
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println("keys:", resp.Count)
+	// Output: keys: 0
+}
+
+func ExampleExists() {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints: endpoints,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer cli.Close()
+
+	if _, err = cli.Put(context.Background(), "exists/foo", "bar"); err != nil {
+		log.Fatal(err)
+	}
+	for _, k := range []string{"exists/foo", "exists/missing"} {
+		ok, err := clientv3util.Exists(context.Background(), cli, k)
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Println(k, ok)
+	}
+	// Output:
+	// exists/foo true
+	// exists/missing false
+}
+
+func ExampleCompareAndSwap() {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints: endpoints,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer cli.Close()
+	kvc := clientv3.NewKV(cli)
+
+	// create the key only if it is missing, then keep retrying
+	// an update against whatever the conflict reports as current
+	modRev := int64(0)
+	for {
+		_, err = clientv3util.CompareAndSwap(context.Background(), kvc, "cas/purpleidea", "hello world", modRev)
+		cerr, ok := err.(*clientv3util.ConflictError)
+		if !ok {
+			break
+		}
+		if cerr.Current != nil {
+			modRev = cerr.Current.ModRevision
+		} else {
+			modRev = 0
+		}
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	resp, err := kvc.Get(context.Background(), "cas/purpleidea")
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("%s\n", resp.Kvs[0].Value)
+	// Output: hello world
+}
+
+func ExamplePollPrefix() {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints: endpoints,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer cli.Close()
+
+	if _, err = cli.Put(context.Background(), "poll/foo", "bar"); err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pch := clientv3util.PollPrefix(ctx, cli, "poll/", 100*time.Millisecond)
+
+	// the first poll reports every key under the prefix as put
+	presp := <-pch
+	for _, ev := range presp.Events {
+		fmt.Printf("%s %q : %q\n", ev.Type, ev.Kv.Key, ev.Kv.Value)
+	}
+
+	if _, err = cli.Delete(context.Background(), "poll/foo"); err != nil {
+		log.Fatal(err)
+	}
+	presp = <-pch
+	for _, ev := range presp.Events {
+		fmt.Printf("%s %q\n", ev.Type, ev.Kv.Key)
+	}
+	// Output:
+	// PUT "poll/foo" : "bar"
+	// DELETE "poll/foo"
+}
+
+func ExampleBatchGet() {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints: endpoints,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer cli.Close()
+
+	for _, k := range []string{"batchget/a", "batchget/c"} {
+		if _, err = cli.Put(context.Background(), k, "running"); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	// read several known keys in one round trip
+	kvs, _, err := clientv3util.BatchGet(context.Background(), cli, []string{"batchget/a", "batchget/b", "batchget/c"}, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, kv := range kvs {
+		if kv == nil {
+			fmt.Println("missing")
+			continue
+		}
+		fmt.Printf("%s : %s\n", kv.Key, kv.Value)
+	}
+	// Output:
+	// batchget/a : running
+	// missing
+	// batchget/c : running
+}
+
+func ExampleListWatch() {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints: endpoints,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer cli.Close()
+
+	if _, err = cli.Put(context.Background(), "listwatch/a", "1"); err != nil {
+		log.Fatal(err)
+	}
+
+	defer cli.Delete(context.Background(), "listwatch/", clientv3.WithPrefix())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp, wch, err := clientv3util.ListWatch(ctx, cli, cli, "listwatch/")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, kv := range resp.Kvs {
+		fmt.Printf("listed %s : %s\n", kv.Key, kv.Value)
+	}
+
+	// changes after the list arrive on the watch channel
+	if _, err = cli.Put(context.Background(), "listwatch/b", "2"); err != nil {
+		log.Fatal(err)
+	}
+	wresp := <-wch
+	for _, ev := range wresp.Events {
+		fmt.Printf("%s %s : %s\n", ev.Type, ev.Kv.Key, ev.Kv.Value)
+	}
+	// Output:
+	// listed listwatch/a : 1
+	// PUT listwatch/b : 2
+}
+
+func ExampleDoOnce() {
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints: endpoints,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer cli.Close()
+
+	// a retry with the same token after an ambiguous failure is not
+	// applied twice, and reports the revision of the first attempt
+	op := clientv3.OpPut("doonce/key", "value")
+	var revs []int64
+	for i := 0; i < 2; i++ {
+		rev, applied, err := clientv3util.DoOnce(context.Background(), cli, "doonce/tokens/request-1", clientv3.NoLease, op)
+		if err != nil {
+			log.Fatal(err)
+		}
+		revs = append(revs, rev)
+		fmt.Println("applied:", applied)
+	}
+	fmt.Println("same revision:", revs[0] == revs[1])
+	// Output:
+	// applied: true
+	// applied: false
+	// same revision: true
 }
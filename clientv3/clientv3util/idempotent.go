@@ -0,0 +1,45 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util
+
+import (
+	"context"
+
+	"github.com/coreos/etcd/clientv3"
+)
+
+// DoOnce applies op at most once per token key, so that a put or delete
+// retried after an ambiguous failure, such as a timeout on a flaky link,
+// does not create a second revision. The token key is created in the same
+// transaction as op; if it already exists, op is not applied again and the
+// revision of the first application, which is the token's mod revision,
+// is returned with applied false. Tokens should be unique per logical
+// request, e.g. a UUID under a dedicated prefix, and attached to leaseID
+// so that they expire; clientv3.NoLease keeps them until deleted.
+func DoOnce(ctx context.Context, kv clientv3.KV, token string, leaseID clientv3.LeaseID, op clientv3.Op) (rev int64, applied bool, err error) {
+	resp, err := kv.Txn(ctx).
+		If(KeyMissing(token)).
+		Then(op, clientv3.OpPut(token, "", clientv3.WithLease(leaseID))).
+		Else(clientv3.OpGet(token)).
+		Commit()
+	if err != nil {
+		return 0, false, err
+	}
+	if resp.Succeeded {
+		return resp.Header.Revision, true, nil
+	}
+	kvs := resp.Responses[0].GetResponseRange().Kvs
+	return kvs[0].ModRevision, false, nil
+}
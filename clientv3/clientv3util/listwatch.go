@@ -0,0 +1,43 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util
+
+import (
+	"context"
+
+	"github.com/coreos/etcd/clientv3"
+)
+
+// ListWatch lists the keys under prefix and watches them from the revision
+// right after the list, so that every change after the listed state is
+// delivered exactly once, with none lost between the two calls. The list
+// is read in pages, all at the revision of the first, so that it is the
+// state at the header revision however many keys there are. The watch
+// is canceled with ctx. If the list revision is compacted before the watch
+// starts, the watch channel reports it in CompactRevision and closes; the
+// caller should then list and watch again.
+func ListWatch(ctx context.Context, kv clientv3.KV, w clientv3.Watcher, prefix string) (*clientv3.GetResponse, clientv3.WatchChan, error) {
+	key := prefix
+	if key == "" {
+		// list all keys, as WithPrefix does for an empty prefix
+		key = "\x00"
+	}
+	resp, err := getRange(ctx, kv, key, clientv3.GetPrefixRangeEnd(prefix), 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	wch := w.Watch(ctx, prefix, clientv3.WithPrefix(), clientv3.WithRev(resp.Header.Revision+1))
+	return resp, wch, nil
+}
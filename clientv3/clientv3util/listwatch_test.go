@@ -0,0 +1,113 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/clientv3util"
+)
+
+// TestListWatchBoundary lists more keys than fit in one page while they
+// are written, and checks that the list is the state at its header
+// revision and the watch delivers exactly the changes after it.
+func TestListWatchBoundary(t *testing.T) {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: endpoints})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	// more keys than a page holds, put in transactions within the
+	// default max-txn-ops
+	const nkeys = 1100
+	var ops []clientv3.Op
+	for i := 0; i < nkeys; i++ {
+		ops = append(ops, clientv3.OpPut(fmt.Sprintf("lwb/%04d", i), "v0"))
+		if len(ops) == 100 || i == nkeys-1 {
+			if _, err = cli.Txn(context.TODO()).Then(ops...).Commit(); err != nil {
+				t.Fatal(err)
+			}
+			ops = nil
+		}
+	}
+
+	// overwrite the keys of the last page while they are listed
+	wctx, wcancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; wctx.Err() == nil; i = (i + 4) % 100 {
+				cli.Put(wctx, fmt.Sprintf("lwb/%04d", nkeys-100+i), "v1")
+			}
+		}(w)
+	}
+	// let the writes start before listing
+	time.Sleep(50 * time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp, wch, err := clientv3util.ListWatch(ctx, cli, cli, "lwb/")
+	if err != nil {
+		wcancel()
+		wg.Wait()
+		t.Fatal(err)
+	}
+	wcancel()
+	wg.Wait()
+
+	rev := resp.Header.Revision
+	if len(resp.Kvs) != nkeys || resp.More {
+		t.Fatalf("listed %d keys (more %v), want %d", len(resp.Kvs), resp.More, nkeys)
+	}
+	gresp, err := cli.Get(context.TODO(), "lwb/", clientv3.WithPrefix(), clientv3.WithRev(rev))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, kv := range resp.Kvs {
+		if want := gresp.Kvs[i]; kv.ModRevision != want.ModRevision || string(kv.Value) != string(want.Value) {
+			t.Fatalf("listed %q at revision %d, want revision %d as of %d", kv.Key, kv.ModRevision, want.ModRevision, rev)
+		}
+	}
+
+	// the watch starts right after the list and ends at the last write
+	last, err := cli.Get(context.TODO(), "lwb/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	next := rev + 1
+	for next <= last.Header.Revision {
+		select {
+		case wresp := <-wch:
+			if err = wresp.Err(); err != nil {
+				t.Fatal(err)
+			}
+			for _, ev := range wresp.Events {
+				if ev.Kv.ModRevision != next {
+					t.Fatalf("got event at revision %d, want %d", ev.Kv.ModRevision, next)
+				}
+				next++
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for revision %d", next)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+// PollResponse holds the changes to the keys under a prefix found by one
+// poll of PollPrefix.
+type PollResponse struct {
+	// Revision is the store revision the poll read the keys at.
+	Revision int64
+	// Events are the keys put or deleted since the previous poll; the
+	// first poll reports every key as put. A key put several times
+	// between polls is reported once, with its latest value. Delete
+	// events only carry the key, with the poll revision as mod revision.
+	Events []*clientv3.Event
+	// Err is the error that failed the poll. Polling continues at the
+	// next interval.
+	Err error
+}
+
+// PollPrefix reads the keys under prefix every interval and sends the
+// changes since the previous poll, for consumers that cannot hold a
+// watch open. Each poll only fetches the keys modified since the last
+// one, using WithMinModRev; all keys are listed, without values, only
+// when the key count shows that some were deleted. Both reads are paged,
+// at the poll revision, so large prefixes are not fetched in one
+// response. Polls that find no changes are not sent, except the first.
+// The returned channel is closed when ctx is done.
+func PollPrefix(ctx context.Context, kv clientv3.KV, prefix string, interval time.Duration) <-chan PollResponse {
+	ch := make(chan PollResponse)
+	p := &poller{kv: kv, key: prefix, end: clientv3.GetPrefixRangeEnd(prefix), keys: make(map[string]struct{})}
+	if prefix == "" {
+		// poll all keys, as WithPrefix does for an empty prefix
+		p.key = "\x00"
+	}
+	go func() {
+		defer close(ch)
+		// the first successful poll is always sent, to report its revision
+		synced := false
+		for {
+			resp := p.poll(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if !synced || resp.Err != nil || len(resp.Events) > 0 {
+				select {
+				case ch <- resp:
+				case <-ctx.Done():
+					return
+				}
+				synced = synced || resp.Err == nil
+			}
+			select {
+			case <-time.After(interval):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+type poller struct {
+	kv       clientv3.KV
+	key, end string
+	// rev is the revision of the last successful poll.
+	rev int64
+	// keys is the set of keys under the prefix at rev.
+	keys map[string]struct{}
+}
+
+func (p *poller) poll(ctx context.Context) PollResponse {
+	cresp, err := p.kv.Get(ctx, p.key, clientv3.WithRange(p.end), clientv3.WithCountOnly())
+	if err != nil {
+		return PollResponse{Err: err}
+	}
+	rev := cresp.Header.Revision
+	if rev == p.rev {
+		return PollResponse{Revision: rev}
+	}
+
+	var opts []clientv3.OpOption
+	if p.rev > 0 {
+		opts = append(opts, clientv3.WithMinModRev(p.rev+1))
+	}
+	mresp, err := getRange(ctx, p.kv, p.key, p.end, rev, opts...)
+	if err != nil {
+		return PollResponse{Err: err}
+	}
+	var evs []*clientv3.Event
+	created := 0
+	for _, kv := range mresp.Kvs {
+		if _, ok := p.keys[string(kv.Key)]; !ok {
+			created++
+		}
+		evs = append(evs, &clientv3.Event{Type: clientv3.EventTypePut, Kv: kv})
+	}
+
+	// keys were deleted iff fewer keys exist than were known plus created
+	var deleted []string
+	if int64(len(p.keys)+created) != cresp.Count {
+		kresp, err := getRange(ctx, p.kv, p.key, p.end, rev, clientv3.WithKeysOnly())
+		if err != nil {
+			return PollResponse{Err: err}
+		}
+		live := make(map[string]struct{}, len(kresp.Kvs))
+		for _, kv := range kresp.Kvs {
+			live[string(kv.Key)] = struct{}{}
+		}
+		for k := range p.keys {
+			if _, ok := live[k]; !ok {
+				deleted = append(deleted, k)
+			}
+		}
+		sort.Strings(deleted)
+	}
+	for _, k := range deleted {
+		delete(p.keys, k)
+		kv := &mvccpb.KeyValue{Key: []byte(k), ModRevision: rev}
+		evs = append(evs, &clientv3.Event{Type: clientv3.EventTypeDelete, Kv: kv})
+	}
+	for _, kv := range mresp.Kvs {
+		p.keys[string(kv.Key)] = struct{}{}
+	}
+	p.rev = rev
+	return PollResponse{Revision: rev, Events: evs}
+}
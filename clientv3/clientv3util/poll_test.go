@@ -0,0 +1,101 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/clientv3util"
+)
+
+// TestPollPrefixPages checks that polls report every key of a prefix
+// larger than one page of results.
+func TestPollPrefixPages(t *testing.T) {
+	cli, err := clientv3.New(clientv3.Config{Endpoints: endpoints})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	// more keys than are fetched per request
+	nkeys := 1500
+	putKeys(t, cli, "pollpage/", nkeys)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pch := clientv3util.PollPrefix(ctx, cli, "pollpage/", 50*time.Millisecond)
+	presp := recvPoll(t, pch)
+	if len(presp.Events) != nkeys {
+		t.Fatalf("first poll reported %d events, want %d", len(presp.Events), nkeys)
+	}
+
+	// delete a key on the second page and update one on the first
+	if _, err = cli.Delete(context.TODO(), fmt.Sprintf("pollpage/%04d", 1200)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = cli.Put(context.TODO(), fmt.Sprintf("pollpage/%04d", 10), "v2"); err != nil {
+		t.Fatal(err)
+	}
+	// polls may see only the delete first
+	var puts, dels []string
+	for len(puts) == 0 || len(dels) == 0 {
+		for _, ev := range recvPoll(t, pch).Events {
+			if ev.Type == clientv3.EventTypeDelete {
+				dels = append(dels, string(ev.Kv.Key))
+			} else {
+				puts = append(puts, string(ev.Kv.Key))
+			}
+		}
+	}
+	if len(dels) != 1 || dels[0] != "pollpage/1200" {
+		t.Errorf("deleted %v, want [pollpage/1200]", dels)
+	}
+	if len(puts) != 1 || puts[0] != "pollpage/0010" {
+		t.Errorf("put %v, want [pollpage/0010]", puts)
+	}
+}
+
+func recvPoll(t *testing.T, pch <-chan clientv3util.PollResponse) clientv3util.PollResponse {
+	select {
+	case presp := <-pch:
+		if presp.Err != nil {
+			t.Fatal(presp.Err)
+		}
+		return presp
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for poll")
+	}
+	return clientv3util.PollResponse{}
+}
+
+// putKeys puts n keys under prefix, 100 per transaction.
+func putKeys(t *testing.T, cli *clientv3.Client, prefix string, n int) {
+	if _, err := cli.Delete(context.TODO(), prefix, clientv3.WithPrefix()); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < n; i += 100 {
+		var ops []clientv3.Op
+		for j := i; j < i+100 && j < n; j++ {
+			ops = append(ops, clientv3.OpPut(fmt.Sprintf("%s%04d", prefix, j), "v"))
+		}
+		if _, err := cli.Txn(context.TODO()).Then(ops...).Commit(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
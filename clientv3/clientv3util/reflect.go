@@ -0,0 +1,66 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util
+
+import (
+	"context"
+
+	"github.com/coreos/etcd/clientv3"
+)
+
+// Reflect keeps a caller's copy of the keys under prefix in sync, as
+// informers do. It lists and watches the prefix with ListWatch, calling
+// onList with every list and onEvents with every batch of changes after
+// it. When the watch falls behind compaction, Reflect lists again at the
+// current revision and calls onList, which should replace the caller's
+// copy, before resuming the watch. Callbacks run on the calling goroutine.
+// Reflect blocks until ctx is done or the list or watch fail with another
+// error, which it returns.
+func Reflect(ctx context.Context, kv clientv3.KV, w clientv3.Watcher, prefix string, onList func(*clientv3.GetResponse), onEvents func([]*clientv3.Event)) error {
+	for {
+		wctx, cancel := context.WithCancel(ctx)
+		resp, wch, err := ListWatch(wctx, kv, w, prefix)
+		if err != nil {
+			cancel()
+			return err
+		}
+		onList(resp)
+		err = reflectEvents(wch, onEvents)
+		cancel()
+		if err != nil {
+			return err
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+}
+
+// reflectEvents hands the watch events to onEvents until the watch
+// is compacted, returning nil, or fails.
+func reflectEvents(wch clientv3.WatchChan, onEvents func([]*clientv3.Event)) error {
+	for wresp := range wch {
+		if wresp.CompactRevision != 0 {
+			return nil
+		}
+		if err := wresp.Err(); err != nil {
+			return err
+		}
+		if len(wresp.Events) > 0 {
+			onEvents(wresp.Events)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientv3util_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/clientv3util"
+	"github.com/coreos/etcd/integration"
+)
+
+func TestReflectRelistsAfterCompaction(t *testing.T) {
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+	cli, kv := clus.Client(0), clus.Client(1)
+
+	if _, err := kv.Put(context.TODO(), "reflect/a", "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	lists, events := make(chan []string, 2), make(chan string, 10)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- clientv3util.Reflect(ctx, cli, cli, "reflect/",
+			func(resp *clientv3.GetResponse) {
+				var keys []string
+				for _, kv := range resp.Kvs {
+					keys = append(keys, string(kv.Key))
+				}
+				lists <- keys
+			},
+			func(evs []*clientv3.Event) {
+				for _, ev := range evs {
+					events <- string(ev.Kv.Key)
+				}
+			})
+	}()
+	waitList := func(want []string) {
+		select {
+		case keys := <-lists:
+			if !reflect.DeepEqual(keys, want) {
+				t.Fatalf("listed %v, want %v", keys, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for list %v", want)
+		}
+	}
+	waitEvent := func(want string) {
+		select {
+		case key := <-events:
+			if key != want {
+				t.Fatalf("got event on %q, want %q", key, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event on %q", want)
+		}
+	}
+
+	waitList([]string{"reflect/a"})
+	if _, err := kv.Put(context.TODO(), "reflect/b", "2"); err != nil {
+		t.Fatal(err)
+	}
+	waitEvent("reflect/b")
+
+	// cut the watch off while the revisions after it are compacted, so it
+	// resumes at a compacted revision
+	clus.Members[0].PauseConnections()
+	clus.Members[0].DropConnections()
+	var presp *clientv3.PutResponse
+	for i := 0; i < 2; i++ {
+		var err error
+		if presp, err = kv.Put(context.TODO(), "reflect/c", "3"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := kv.Compact(context.TODO(), presp.Header.Revision); err != nil {
+		t.Fatal(err)
+	}
+	clus.Members[0].UnpauseConnections()
+
+	waitList([]string{"reflect/a", "reflect/b", "reflect/c"})
+	if _, err := kv.Put(context.TODO(), "reflect/d", "4"); err != nil {
+		t.Fatal(err)
+	}
+	waitEvent("reflect/d")
+
+	cancel()
+	if err := <-errc; err != context.Canceled {
+		t.Fatalf("Reflect returned %v, want %v", err, context.Canceled)
+	}
+}
@@ -16,6 +16,8 @@
 package clientv3util
 
 import (
+	"context"
+
 	"github.com/coreos/etcd/clientv3"
 )
 
@@ -31,3 +33,46 @@ func KeyExists(key string) clientv3.Cmp {
 func KeyMissing(key string) clientv3.Cmp {
 	return clientv3.Compare(clientv3.Version(key), "=", 0)
 }
+
+// Exists reports whether key exists. It only asks for the key count, which
+// the server answers from its in-memory index without reading the value.
+// opts apply to the read, e.g. clientv3.WithRev or clientv3.WithPrefix to
+// check for any key under a prefix.
+func Exists(ctx context.Context, kv clientv3.KV, key string, opts ...clientv3.OpOption) (bool, error) {
+	resp, err := kv.Get(ctx, key, append(opts, clientv3.WithCountOnly())...)
+	if err != nil {
+		return false, err
+	}
+	return resp.Count > 0, nil
+}
+
+// rangePageSize is the number of keys fetched per request by getRange.
+const rangePageSize = 1000
+
+// getRange reads every key in [key, end) at revision rev, or at the
+// revision of the first request if rev is 0, fetching rangePageSize keys
+// per request until the server reports no more. The pages are merged into
+// the first response, so its header and count are those of the first page.
+func getRange(ctx context.Context, kv clientv3.KV, key, end string, rev int64, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	var resp *clientv3.GetResponse
+	for {
+		gopts := append([]clientv3.OpOption{clientv3.WithRange(end), clientv3.WithLimit(rangePageSize), clientv3.WithRev(rev)}, opts...)
+		page, err := kv.Get(ctx, key, gopts...)
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			resp = page
+			if rev == 0 {
+				rev = page.Header.Revision
+			}
+		} else {
+			resp.Kvs = append(resp.Kvs, page.Kvs...)
+		}
+		if !page.More || len(page.Kvs) == 0 {
+			resp.More = false
+			return resp, nil
+		}
+		key = string(page.Kvs[len(page.Kvs)-1].Key) + "\x00"
+	}
+}
@@ -45,7 +45,12 @@ func (op CompactOp) toRequest() *pb.CompactionRequest {
 }
 
 // WithCompactPhysical makes Compact wait until all compacted entries are
-// removed from the etcd server's storage.
+// removed from the etcd server's storage and the removal is committed to
+// its backend. Without it, Compact returns once the compaction revision
+// is recorded, and entries are removed in the background. In both cases
+// reads below the compaction revision fail with ErrCompacted as soon as
+// Compact returns. Freed space is only returned to the file system by
+// Maintenance.Defragment.
 func WithCompactPhysical() CompactOption {
 	return func(op *CompactOp) { op.physical = true }
 }
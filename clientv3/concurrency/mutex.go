@@ -94,6 +94,12 @@ func (m *Mutex) IsOwner() v3.Cmp {
 
 func (m *Mutex) Key() string { return m.myKey }
 
+// Rev returns the lock key's creation revision, if the lock is held.
+// Each acquisition of the lock gets a greater revision than the previous
+// owner's, so it can be passed to other systems as a fencing token that
+// lets them reject requests from a stale owner.
+func (m *Mutex) Rev() int64 { return m.myRev }
+
 // Header is the response header received from etcd on acquiring the lock.
 func (m *Mutex) Header() *pb.ResponseHeader { return m.hdr }
 
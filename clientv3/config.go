@@ -69,7 +69,29 @@ type Config struct {
 	// DialOptions is a list of dial options for the grpc client (e.g., for interceptors).
 	DialOptions []grpc.DialOption
 
+	// AcceptGZIP asks servers to gzip-compress responses, which they do on
+	// the listeners set with --experimental-grpc-compression-urls. It sets
+	// the client interceptors, so it has no effect if DialOptions sets
+	// them as well.
+	AcceptGZIP bool `json:"accept-gzip"`
+
 	// Context is the default client context; it can be used to cancel grpc dial out and
 	// other operations that do not have an explicit context.
 	Context context.Context
+
+	// ReadTimeout is the timeout for KV gets and read-only txns whose
+	// context has no deadline, so that an unresponsive cluster does not
+	// block them forever. 0 disables it.
+	ReadTimeout time.Duration `json:"read-timeout"`
+
+	// WriteTimeout is the timeout for KV puts, deletes, txns that write
+	// and compactions whose context has no deadline. Txns that only read
+	// take ReadTimeout. 0 disables it.
+	WriteTimeout time.Duration `json:"write-timeout"`
+
+	// WatchEstablishTimeout bounds how long Watch waits for the server to
+	// create the watch. A watch not created in time is canceled and its
+	// channel returns context.DeadlineExceeded. It does not limit the
+	// lifetime of a created watch. 0 disables it.
+	WatchEstablishTimeout time.Duration `json:"watch-establish-timeout"`
 }
@@ -32,13 +32,20 @@ func ExampleWatcher_watch() {
 	}
 	defer cli.Close()
 
-	rch := cli.Watch(context.Background(), "foo")
-	for wresp := range rch {
-		for _, ev := range wresp.Events {
-			fmt.Printf("%s %q : %q\n", ev.Type, ev.Kv.Key, ev.Kv.Value)
-		}
+	presp, err := cli.Put(context.TODO(), "foo", "bar")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// watch from the revision of the put so its event is not missed
+	rch := cli.Watch(ctx, "foo", clientv3.WithRev(presp.Header.Revision))
+	wresp := <-rch
+	for _, ev := range wresp.Events {
+		fmt.Printf("%s %q : %q\n", ev.Type, ev.Kv.Key, ev.Kv.Value)
 	}
-	// PUT "foo" : "bar"
+	// Output: PUT "foo" : "bar"
 }
 
 func ExampleWatcher_watchWithPrefix() {
@@ -51,13 +58,19 @@ func ExampleWatcher_watchWithPrefix() {
 	}
 	defer cli.Close()
 
-	rch := cli.Watch(context.Background(), "foo", clientv3.WithPrefix())
-	for wresp := range rch {
-		for _, ev := range wresp.Events {
-			fmt.Printf("%s %q : %q\n", ev.Type, ev.Kv.Key, ev.Kv.Value)
-		}
+	presp, err := cli.Put(context.TODO(), "foo1", "bar")
+	if err != nil {
+		log.Fatal(err)
 	}
-	// PUT "foo1" : "bar"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rch := cli.Watch(ctx, "foo", clientv3.WithPrefix(), clientv3.WithRev(presp.Header.Revision))
+	wresp := <-rch
+	for _, ev := range wresp.Events {
+		fmt.Printf("%s %q : %q\n", ev.Type, ev.Kv.Key, ev.Kv.Value)
+	}
+	// Output: PUT "foo1" : "bar"
 }
 
 func ExampleWatcher_watchWithRange() {
@@ -70,13 +83,25 @@ func ExampleWatcher_watchWithRange() {
 	}
 	defer cli.Close()
 
+	tresp, err := cli.Txn(context.TODO()).Then(
+		clientv3.OpPut("foo1", "bar"),
+		clientv3.OpPut("foo2", "bar"),
+		clientv3.OpPut("foo3", "bar"),
+		clientv3.OpPut("foo4", "bar"),
+	).Commit()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 	// watches within ['foo1', 'foo4'), in lexicographical order
-	rch := cli.Watch(context.Background(), "foo1", clientv3.WithRange("foo4"))
-	for wresp := range rch {
-		for _, ev := range wresp.Events {
-			fmt.Printf("%s %q : %q\n", ev.Type, ev.Kv.Key, ev.Kv.Value)
-		}
+	rch := cli.Watch(ctx, "foo1", clientv3.WithRange("foo4"), clientv3.WithRev(tresp.Header.Revision))
+	wresp := <-rch
+	for _, ev := range wresp.Events {
+		fmt.Printf("%s %q : %q\n", ev.Type, ev.Kv.Key, ev.Kv.Value)
 	}
+	// Output:
 	// PUT "foo1" : "bar"
 	// PUT "foo2" : "bar"
 	// PUT "foo3" : "bar"
@@ -90,11 +115,20 @@ func ExampleWatcher_watchWithProgressNotify() {
 	if err != nil {
 		log.Fatal(err)
 	}
+	defer cli.Close()
+
+	gresp, err := cli.Get(context.TODO(), "foo")
+	if err != nil {
+		log.Fatal(err)
+	}
 
-	rch := cli.Watch(context.Background(), "foo", clientv3.WithProgressNotify())
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	rch := cli.Watch(ctx, "foo", clientv3.WithProgressNotify())
 	wresp := <-rch
-	fmt.Printf("wresp.Header.Revision: %d\n", wresp.Header.Revision)
+	fmt.Println("wresp.Header.Revision is current:", wresp.Header.Revision == gresp.Header.Revision)
 	fmt.Println("wresp.IsProgressNotify:", wresp.IsProgressNotify())
-	// wresp.Header.Revision: 0
+	// Output:
+	// wresp.Header.Revision is current: true
 	// wresp.IsProgressNotify: true
 }
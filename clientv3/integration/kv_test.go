@@ -826,6 +826,78 @@ func TestKVPutStoppedServerAndClose(t *testing.T) {
 	}
 }
 
+// TestKVDefaultTimeouts ensures requests without a deadline time out after
+// the configured read and write timeouts, and that deadlines set by the
+// caller take precedence.
+func TestKVDefaultTimeouts(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:    []string{clus.Members[0].GRPCAddr()},
+		ReadTimeout:  500 * time.Millisecond,
+		WriteTimeout: time.Second,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+
+	clus.Members[0].Stop(t)
+
+	tests := []struct {
+		name    string
+		timeout time.Duration
+		do      func(ctx context.Context) error
+	}{
+		{"get", 500 * time.Millisecond, func(ctx context.Context) error {
+			_, err := cli.Get(ctx, "foo")
+			return err
+		}},
+		{"put", time.Second, func(ctx context.Context) error {
+			_, err := cli.Put(ctx, "foo", "baz")
+			return err
+		}},
+		{"read-only txn", 500 * time.Millisecond, func(ctx context.Context) error {
+			_, err := cli.Txn(ctx).Then(clientv3.OpGet("foo")).Commit()
+			return err
+		}},
+		{"txn", time.Second, func(ctx context.Context) error {
+			_, err := cli.Txn(ctx).Then(clientv3.OpGet("foo")).Else(clientv3.OpPut("foo", "baz")).Commit()
+			return err
+		}},
+		{"read-only txn op", 500 * time.Millisecond, func(ctx context.Context) error {
+			_, err := cli.Do(ctx, clientv3.OpTxn(nil, []clientv3.Op{clientv3.OpGet("foo")}, nil))
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		start := time.Now()
+		if err := tt.do(context.TODO()); err != context.DeadlineExceeded {
+			t.Fatalf("%s: expected %v, got %v", tt.name, context.DeadlineExceeded, err)
+		}
+		if took := time.Since(start); took < tt.timeout || took > tt.timeout+3*time.Second {
+			t.Fatalf("%s: took %v, expected about %v", tt.name, took, tt.timeout)
+		}
+	}
+
+	// a shorter deadline of the caller wins
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err = cli.Get(ctx, "foo"); err != context.DeadlineExceeded {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+	if took := time.Since(start); took > 400*time.Millisecond {
+		t.Fatalf("took %v, expected about 100ms", took)
+	}
+}
+
 // TestKVPutAtMostOnce ensures that a Put will only occur at most once
 // in the presence of network errors.
 func TestKVPutAtMostOnce(t *testing.T) {
@@ -27,6 +27,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	"github.com/coreos/etcd/integration"
 	"github.com/coreos/etcd/lease"
@@ -68,6 +69,243 @@ func TestMaintenanceHashKV(t *testing.T) {
 	}
 }
 
+func TestMaintenanceRevisionTime(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1, RevisionTimes: true})
+	defer clus.Terminate(t)
+
+	cli, ep := clus.RandClient(), clus.Members[0].GRPCAddr()
+	presp1, err := cli.Put(context.TODO(), "foo", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	between := time.Now()
+	time.Sleep(10 * time.Millisecond)
+	presp2, err := cli.Put(context.TODO(), "foo", "baz")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rresp, err := cli.RevisionAt(context.TODO(), ep, between)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rresp.Revision != presp1.Header.Revision {
+		t.Fatalf("revision at %v = %d, want %d", between, rresp.Revision, presp1.Header.Revision)
+	}
+	if rresp.Time > between.UnixNano() {
+		t.Fatalf("revision %d committed at %d, after %d", rresp.Revision, rresp.Time, between.UnixNano())
+	}
+
+	tresp, err := cli.RevisionTime(context.TODO(), ep, presp2.Header.Revision)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tresp.Time <= between.UnixNano() {
+		t.Fatalf("revision %d committed at %d, before %d", tresp.Revision, tresp.Time, between.UnixNano())
+	}
+
+	if _, err = cli.RevisionTime(context.TODO(), ep, presp2.Header.Revision+1); err != rpctypes.ErrFutureRev {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrFutureRev, err)
+	}
+	if _, err = cli.RevisionAt(context.TODO(), ep, time.Unix(0, 0)); err != rpctypes.ErrRevisionTimeUnknown {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrRevisionTimeUnknown, err)
+	}
+}
+
+func TestMaintenanceRevisionTimeDisabled(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.RandClient()
+	if _, err := cli.RevisionTime(context.TODO(), clus.Members[0].GRPCAddr(), 1); err != rpctypes.ErrRevisionTimesDisabled {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrRevisionTimesDisabled, err)
+	}
+}
+
+// TestMaintenanceMode ensures the MAINTENANCE alarm rejects writes on every
+// member while serving reads and keeping watches open, and that writes are
+// accepted again once it is disarmed.
+func TestMaintenanceMode(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	if _, err := cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	wch := clus.Client(1).Watch(context.Background(), "foo")
+
+	if _, err := cli.EnterMaintenanceMode(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	for i := range clus.Members {
+		kv := clus.Client(i)
+		if _, err := kv.Put(context.TODO(), "foo", "baz"); err != rpctypes.ErrMaintenanceMode {
+			t.Fatalf("#%d: put expected %v, got %v", i, rpctypes.ErrMaintenanceMode, err)
+		}
+		if _, err := kv.Delete(context.TODO(), "foo"); err != rpctypes.ErrMaintenanceMode {
+			t.Fatalf("#%d: delete expected %v, got %v", i, rpctypes.ErrMaintenanceMode, err)
+		}
+		if _, err := kv.Txn(context.TODO()).Then(clientv3.OpPut("foo", "baz")).Commit(); err != rpctypes.ErrMaintenanceMode {
+			t.Fatalf("#%d: txn expected %v, got %v", i, rpctypes.ErrMaintenanceMode, err)
+		}
+		resp, err := kv.Get(context.TODO(), "foo")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "bar" {
+			t.Fatalf("#%d: expected foo = bar, got %+v", i, resp.Kvs)
+		}
+		if _, err = kv.Txn(context.TODO()).Then(clientv3.OpGet("foo")).Commit(); err != nil {
+			t.Fatalf("#%d: read-only txn failed (%v)", i, err)
+		}
+	}
+	if _, err := cli.Grant(context.TODO(), 10); err != rpctypes.ErrMaintenanceMode {
+		t.Fatalf("lease grant expected %v, got %v", rpctypes.ErrMaintenanceMode, err)
+	}
+
+	if _, err := cli.ExitMaintenanceMode(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	aresp, err := cli.AlarmList(context.TODO())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(aresp.Alarms) != 0 {
+		t.Fatalf("expected no alarms, got %+v", aresp.Alarms)
+	}
+	if _, err = cli.Put(context.TODO(), "foo", "baz"); err != nil {
+		t.Fatal(err)
+	}
+
+	// the watch stayed open and sees the first accepted write
+	select {
+	case wresp := <-wch:
+		if len(wresp.Events) != 1 || string(wresp.Events[0].Kv.Value) != "baz" {
+			t.Fatalf("expected put of baz, got %+v (%v)", wresp, wresp.Err())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch event")
+	}
+}
+
+// TestMaintenanceModeTimeout ensures the leader disarms the MAINTENANCE
+// alarm once the maintenance mode timeout passes.
+func TestMaintenanceModeTimeout(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3, MaintenanceModeTimeout: 2 * time.Second})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	if _, err := cli.EnterMaintenanceMode(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.Put(context.TODO(), "foo", "bar"); err != rpctypes.ErrMaintenanceMode {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrMaintenanceMode, err)
+	}
+
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		_, err := cli.Put(context.TODO(), "foo", "bar")
+		if err == nil {
+			break
+		}
+		if err != rpctypes.ErrMaintenanceMode {
+			t.Fatal(err)
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for maintenance mode to time out")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
+
+func TestMaintenanceCompactionConfig(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	if _, err := cli.SetCompactionConfig(context.TODO(), "hourly", 1); err != rpctypes.ErrInvalidCompactionConfig {
+		t.Fatalf("expected %v, got %v", rpctypes.ErrInvalidCompactionConfig, err)
+	}
+	resp, err := cli.SetCompactionConfig(context.TODO(), "periodic", int64(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Mode != "periodic" || resp.Retention != int64(time.Second) {
+		t.Fatalf("unexpected compaction config %+v", resp)
+	}
+
+	// every member takes the configuration once it has applied it
+	for i := range clus.Members {
+		mcli := clus.Client(i)
+		if _, err = mcli.Get(context.TODO(), "foo"); err != nil {
+			t.Fatal(err)
+		}
+		if resp, err = mcli.CompactionConfig(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Mode != "periodic" || resp.Retention != int64(time.Second) {
+			t.Fatalf("#%d: unexpected compaction config %+v", i, resp)
+		}
+	}
+
+	// the leader compacts the history older than the retention
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(10 * time.Second)
+	for {
+		if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+			t.Fatal(err)
+		}
+		_, err = cli.Get(context.TODO(), "foo", clientv3.WithRev(2))
+		if err == rpctypes.ErrCompacted {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for auto-compaction")
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	// the configuration is persisted, overriding the member flags on restart
+	clus.Members[1].Stop(t)
+	if err = clus.Members[1].Restart(t); err != nil {
+		t.Fatal(err)
+	}
+	clus.WaitLeader(t)
+	if resp, err = clus.Client(1).CompactionConfig(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Mode != "periodic" || resp.Retention != int64(time.Second) {
+		t.Fatalf("unexpected compaction config after restart %+v", resp)
+	}
+
+	if _, err = cli.SetCompactionConfig(context.TODO(), "revision", 0); err != nil {
+		t.Fatal(err)
+	}
+	if resp, err = cli.CompactionConfig(context.TODO()); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Mode != "revision" || resp.Retention != 0 {
+		t.Fatalf("unexpected compaction config %+v", resp)
+	}
+}
+
 func TestMaintenanceMoveLeader(t *testing.T) {
 	defer testutil.AfterTest(t)
 
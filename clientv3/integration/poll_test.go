@@ -0,0 +1,97 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package integration
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/clientv3util"
+	"github.com/coreos/etcd/integration"
+	"github.com/coreos/etcd/pkg/testutil"
+)
+
+func TestPollPrefix(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.RandClient()
+	for _, k := range []string{"foo/a", "foo/b", "bar"} {
+		if _, err := kv.Put(context.TODO(), k, "1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	pch := clientv3util.PollPrefix(ctx, kv, "foo/", 10*time.Millisecond)
+
+	type event struct {
+		typ      string
+		key, val string
+	}
+	recv := func() (int64, []event) {
+		select {
+		case resp := <-pch:
+			if resp.Err != nil {
+				t.Fatal(resp.Err)
+			}
+			var evs []event
+			for _, ev := range resp.Events {
+				evs = append(evs, event{ev.Type.String(), string(ev.Kv.Key), string(ev.Kv.Value)})
+			}
+			return resp.Revision, evs
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for poll response")
+		}
+		return 0, nil
+	}
+
+	rev, evs := recv()
+	wevs := []event{{"PUT", "foo/a", "1"}, {"PUT", "foo/b", "1"}}
+	if rev != 4 || !reflect.DeepEqual(evs, wevs) {
+		t.Fatalf("got revision %d events %v, want revision 4 events %v", rev, evs, wevs)
+	}
+
+	_, err := kv.Txn(context.TODO()).Then(
+		clientv3.OpPut("foo/a", "2"),
+		clientv3.OpDelete("foo/b"),
+		clientv3.OpPut("foo/c", "1"),
+		clientv3.OpPut("bar", "2"),
+	).Commit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rev, evs = recv()
+	wevs = []event{{"PUT", "foo/a", "2"}, {"PUT", "foo/c", "1"}, {"DELETE", "foo/b", ""}}
+	if rev != 5 || !reflect.DeepEqual(evs, wevs) {
+		t.Fatalf("got revision %d events %v, want revision 5 events %v", rev, evs, wevs)
+	}
+
+	// writes outside the prefix are not reported
+	if _, err = kv.Put(context.TODO(), "bar", "3"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case resp := <-pch:
+		t.Fatalf("unexpected poll response %+v", resp)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
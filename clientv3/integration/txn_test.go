@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/clientv3/clientv3util"
 	"github.com/coreos/etcd/embed"
 	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	"github.com/coreos/etcd/integration"
@@ -227,3 +228,49 @@ func TestTxnNested(t *testing.T) {
 		t.Errorf("unexpected Get response %+v", resp)
 	}
 }
+
+func TestTxnCompareAndSwapConflict(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	kv := clus.Client(0)
+	ctx := context.TODO()
+
+	rev, err := clientv3util.CompareAndSwap(ctx, kv, "foo", "bar", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// stale expected revision; conflict must carry the current key
+	_, err = clientv3util.CompareAndSwap(ctx, kv, "foo", "baz", 0)
+	cerr, ok := err.(*clientv3util.ConflictError)
+	if !ok {
+		t.Fatalf("expected *ConflictError, got %v", err)
+	}
+	if cerr.Current == nil || cerr.Current.ModRevision != rev || string(cerr.Current.Value) != "bar" {
+		t.Fatalf("unexpected current key %+v, want mod revision %d", cerr.Current, rev)
+	}
+
+	if _, err = clientv3util.CompareAndSwap(ctx, kv, "foo", "baz", cerr.Current.ModRevision); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = clientv3util.CompareAndDelete(ctx, kv, "foo", rev); err == nil {
+		t.Fatal("expected conflict on delete with stale revision")
+	}
+	resp, err := kv.Get(ctx, "foo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = clientv3util.CompareAndDelete(ctx, kv, "foo", resp.Kvs[0].ModRevision); err != nil {
+		t.Fatal(err)
+	}
+
+	// deleted key reports no current value
+	_, err = clientv3util.CompareAndDelete(ctx, kv, "foo", rev)
+	if cerr, ok = err.(*clientv3util.ConflictError); !ok || cerr.Current != nil {
+		t.Fatalf("expected conflict without current key, got %v", err)
+	}
+}
@@ -1061,3 +1061,166 @@ func TestWatchCancelDisconnected(t *testing.T) {
 		t.Fatal("took too long to cancel disconnected watcher")
 	}
 }
+
+// TestWatchEstablishTimeout ensures a watch the server does not create
+// within the establish timeout is closed with a deadline error, and that
+// the timeout does not limit watches that were created.
+func TestWatchEstablishTimeout(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:             []string{clus.Members[0].GRPCAddr()},
+		WatchEstablishTimeout: 500 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	wch := cli.Watch(context.Background(), "foo")
+	time.Sleep(time.Second)
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case wr := <-wch:
+		if err = wr.Err(); err != nil || len(wr.Events) != 1 {
+			t.Fatalf("expected one event, got %+v (%v)", wr, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	clus.Members[0].Stop(t)
+
+	start := time.Now()
+	wch = cli.Watch(context.Background(), "bar")
+	if took := time.Since(start); took < 500*time.Millisecond || took > 3*time.Second {
+		t.Fatalf("watch took %v, expected about 500ms", took)
+	}
+	select {
+	case wr, ok := <-wch:
+		if !ok || wr.Err() != context.DeadlineExceeded {
+			t.Fatalf("expected %v, got %+v (%v)", context.DeadlineExceeded, wr, wr.Err())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected closed watch channel")
+	}
+	if _, ok := <-wch; ok {
+		t.Fatal("expected closed watch channel")
+	}
+}
+
+// TestWatchEstablishTimeoutMemberDown ensures the establish timeout also
+// bounds a watch whose stream cannot be opened because the member was
+// down before Watch was called.
+func TestWatchEstablishTimeoutMemberDown(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli, err := clientv3.New(clientv3.Config{
+		Endpoints:             []string{clus.Members[0].GRPCAddr()},
+		WatchEstablishTimeout: 500 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+
+	clus.Members[0].Stop(t)
+
+	start := time.Now()
+	wch := cli.Watch(context.Background(), "foo")
+	if took := time.Since(start); took < 500*time.Millisecond || took > 3*time.Second {
+		t.Fatalf("watch took %v, expected about 500ms", took)
+	}
+	select {
+	case wr, ok := <-wch:
+		if !ok || wr.Err() != context.DeadlineExceeded {
+			t.Fatalf("expected %v, got %+v (%v)", context.DeadlineExceeded, wr, wr.Err())
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected closed watch channel")
+	}
+	if _, ok := <-wch; ok {
+		t.Fatal("expected closed watch channel")
+	}
+}
+
+// TestWatchReadAfterWrite ensures that a write's header revision is
+// immediately visible to a Get on every member, and that the watch event
+// for the write carries the same mod revision, so header and event
+// revisions never drift apart.
+func TestWatchReadAfterWrite(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := integration.NewClusterV3(t, &integration.ClusterConfig{Size: 3})
+	defer clus.Terminate(t)
+
+	ctx := context.Background()
+	wchs := make([]clientv3.WatchChan, len(clus.Members))
+	for i := range clus.Members {
+		wchs[i] = clus.Client(i).Watch(ctx, "foo/", clientv3.WithPrefix(), clientv3.WithCreatedNotify())
+		if wr := <-wchs[i]; !wr.Created {
+			t.Fatalf("#%d: expected created notification, got %+v", i, wr)
+		}
+	}
+
+	for j := 0; j < 10; j++ {
+		wcli := clus.Client(j % len(clus.Members))
+		key := fmt.Sprintf("foo/%d", j%3)
+
+		var rev int64
+		if j%4 == 3 {
+			dresp, err := wcli.Delete(ctx, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if dresp.Deleted == 0 {
+				continue
+			}
+			rev = dresp.Header.Revision
+		} else {
+			presp, err := wcli.Put(ctx, key, fmt.Sprintf("%d", j))
+			if err != nil {
+				t.Fatal(err)
+			}
+			rev = presp.Header.Revision
+		}
+
+		for i := range clus.Members {
+			cli := clus.Client(i)
+			gresp, err := cli.Get(ctx, key)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gresp.Header.Revision < rev {
+				t.Fatalf("#%d: get header revision %d is behind write revision %d", i, gresp.Header.Revision, rev)
+			}
+			if j%4 == 3 {
+				if len(gresp.Kvs) != 0 {
+					t.Fatalf("#%d: expected %q to be deleted, got %+v", i, key, gresp.Kvs)
+				}
+			} else if len(gresp.Kvs) != 1 || gresp.Kvs[0].ModRevision != rev {
+				t.Fatalf("#%d: expected %q at mod revision %d, got %+v", i, key, rev, gresp.Kvs)
+			}
+
+			select {
+			case wr := <-wchs[i]:
+				if len(wr.Events) != 1 {
+					t.Fatalf("#%d: expected 1 event, got %+v", i, wr.Events)
+				}
+				if ev := wr.Events[0]; ev.Kv.ModRevision != rev || string(ev.Kv.Key) != key {
+					t.Fatalf("#%d: expected event on %q at %d, got %q at %d", i, key, rev, ev.Kv.Key, ev.Kv.ModRevision)
+				}
+				if wr.Header.Revision < rev {
+					t.Fatalf("#%d: watch header revision %d is behind event revision %d", i, wr.Header.Revision, rev)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("#%d: timed out waiting for event at revision %d", i, rev)
+			}
+		}
+	}
+}
@@ -16,6 +16,7 @@ package clientv3
 
 import (
 	"context"
+	"time"
 
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 
@@ -92,12 +93,15 @@ func (resp *TxnResponse) OpResponse() OpResponse {
 type kv struct {
 	remote   pb.KVClient
 	callOpts []grpc.CallOption
+
+	readTimeout, writeTimeout time.Duration
 }
 
 func NewKV(c *Client) KV {
 	api := &kv{remote: RetryKVClient(c)}
 	if c != nil {
 		api.callOpts = c.callOpts
+		api.readTimeout, api.writeTimeout = c.cfg.ReadTimeout, c.cfg.WriteTimeout
 	}
 	return api
 }
@@ -106,10 +110,19 @@ func NewKVFromKVClient(remote pb.KVClient, c *Client) KV {
 	api := &kv{remote: remote}
 	if c != nil {
 		api.callOpts = c.callOpts
+		api.readTimeout, api.writeTimeout = c.cfg.ReadTimeout, c.cfg.WriteTimeout
 	}
 	return api
 }
 
+// withTimeout bounds ctx by timeout if ctx has no deadline of its own.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok || timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
 func (kv *kv) Put(ctx context.Context, key, val string, opts ...OpOption) (*PutResponse, error) {
 	r, err := kv.Do(ctx, OpPut(key, val, opts...))
 	return r.put, toErr(ctx, err)
@@ -126,6 +139,8 @@ func (kv *kv) Delete(ctx context.Context, key string, opts ...OpOption) (*Delete
 }
 
 func (kv *kv) Compact(ctx context.Context, rev int64, opts ...CompactOption) (*CompactResponse, error) {
+	ctx, cancel := withTimeout(ctx, kv.writeTimeout)
+	defer cancel()
 	resp, err := kv.remote.Compact(ctx, OpCompact(rev, opts...).toRequest(), kv.callOpts...)
 	if err != nil {
 		return nil, toErr(ctx, err)
@@ -142,6 +157,13 @@ func (kv *kv) Txn(ctx context.Context) Txn {
 }
 
 func (kv *kv) Do(ctx context.Context, op Op) (OpResponse, error) {
+	timeout := kv.readTimeout
+	if op.isWrite() {
+		timeout = kv.writeTimeout
+	}
+	ctx, cancel := withTimeout(ctx, timeout)
+	defer cancel()
+
 	var err error
 	switch op.t {
 	case tRange:
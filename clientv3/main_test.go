@@ -22,6 +22,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/etcd/etcdserver/api/v3rpc"
 	"github.com/coreos/etcd/integration"
 	"github.com/coreos/etcd/pkg/testutil"
 )
@@ -46,6 +47,8 @@ func TestMain(m *testing.M) {
 
 	var v int
 	if useCluster {
+		// send the progress notifications of the watch examples promptly
+		v3rpc.SetProgressReportInterval(time.Second)
 		cfg := integration.ClusterConfig{Size: 3}
 		clus := integration.NewClusterV3(nil, &cfg)
 		endpoints = make([]string, 3)
@@ -17,6 +17,7 @@ package clientv3
 import (
 	"context"
 	"io"
+	"time"
 
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 
@@ -30,6 +31,10 @@ type (
 	StatusResponse     pb.StatusResponse
 	HashKVResponse     pb.HashKVResponse
 	MoveLeaderResponse pb.MoveLeaderResponse
+
+	RevisionTimeResponse pb.RevisionTimeResponse
+
+	CompactionConfigResponse pb.CompactionConfigResponse
 )
 
 type Maintenance interface {
@@ -39,6 +44,17 @@ type Maintenance interface {
 	// AlarmDisarm disarms a given alarm.
 	AlarmDisarm(ctx context.Context, m *AlarmMember) (*AlarmResponse, error)
 
+	// EnterMaintenanceMode raises the MAINTENANCE alarm. While it is active
+	// the cluster serves reads and watches but rejects writes with
+	// rpctypes.ErrMaintenanceMode, until ExitMaintenanceMode disarms it or
+	// the leader's maintenance mode timeout passes. It requires the root
+	// role when auth is enabled, and fails with rpctypes.ErrNotCapable
+	// until every member runs etcd v3.4 or later.
+	EnterMaintenanceMode(ctx context.Context) (*AlarmResponse, error)
+
+	// ExitMaintenanceMode disarms the MAINTENANCE alarm.
+	ExitMaintenanceMode(ctx context.Context) (*AlarmResponse, error)
+
 	// Defragment releases wasted space from internal fragmentation on a given etcd member.
 	// Defragment is only needed when deleting a large number of keys and want to reclaim
 	// the resources.
@@ -56,6 +72,15 @@ type Maintenance interface {
 	// is non-zero, the hash is computed on all keys at or below the given revision.
 	HashKV(ctx context.Context, endpoint string, rev int64) (*HashKVResponse, error)
 
+	// RevisionTime returns the time the given revision was committed at on
+	// the endpoint. The member must run with --experimental-revision-times.
+	RevisionTime(ctx context.Context, endpoint string, rev int64) (*RevisionTimeResponse, error)
+
+	// RevisionAt returns the latest revision committed at or before t on the
+	// endpoint, along with its commit time. The keyspace as of t can then be
+	// read with WithRev. The member must run with --experimental-revision-times.
+	RevisionAt(ctx context.Context, endpoint string, t time.Time) (*RevisionTimeResponse, error)
+
 	// Snapshot provides a reader for a point-in-time snapshot of etcd.
 	// If the context "ctx" is canceled or timed out, reading from returned
 	// "io.ReadCloser" would error out (e.g. context.Canceled, context.DeadlineExceeded).
@@ -64,6 +89,18 @@ type Maintenance interface {
 	// MoveLeader requests current leader to transfer its leadership to the transferee.
 	// Request must be made to the leader.
 	MoveLeader(ctx context.Context, transfereeID uint64) (*MoveLeaderResponse, error)
+
+	// CompactionConfig returns the auto-compaction mode and retention in effect.
+	CompactionConfig(ctx context.Context) (*CompactionConfigResponse, error)
+
+	// SetCompactionConfig changes the auto-compaction mode and retention of
+	// every member. The retention is a duration in nanoseconds in "periodic"
+	// mode and a number of revisions in "revision" mode; 0 disables
+	// auto-compaction. The configuration is persisted and overrides the
+	// member flags, including after a restart. It requires the root role when
+	// auth is enabled, and fails with rpctypes.ErrNotCapable until every
+	// member runs etcd v3.4 or later.
+	SetCompactionConfig(ctx context.Context, mode string, retention int64) (*CompactionConfigResponse, error)
 }
 
 type maintenance struct {
@@ -146,6 +183,36 @@ func (m *maintenance) AlarmDisarm(ctx context.Context, am *AlarmMember) (*AlarmR
 	return nil, toErr(ctx, err)
 }
 
+func (m *maintenance) EnterMaintenanceMode(ctx context.Context) (*AlarmResponse, error) {
+	req := &pb.AlarmRequest{
+		Action:   pb.AlarmRequest_ACTIVATE,
+		MemberID: 0, // raised for the cluster, not by a member
+		Alarm:    pb.AlarmType_MAINTENANCE,
+	}
+	resp, err := m.remote.Alarm(ctx, req, m.callOpts...)
+	if err == nil {
+		return (*AlarmResponse)(resp), nil
+	}
+	return nil, toErr(ctx, err)
+}
+
+func (m *maintenance) ExitMaintenanceMode(ctx context.Context) (*AlarmResponse, error) {
+	req := &pb.AlarmRequest{Action: pb.AlarmRequest_GET, Alarm: pb.AlarmType_MAINTENANCE}
+	resp, err := m.remote.Alarm(ctx, req, m.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	ret := AlarmResponse{}
+	for _, am := range resp.Alarms {
+		dresp, derr := m.AlarmDisarm(ctx, (*AlarmMember)(am))
+		if derr != nil {
+			return nil, toErr(ctx, derr)
+		}
+		ret.Alarms = append(ret.Alarms, dresp.Alarms...)
+	}
+	return &ret, nil
+}
+
 func (m *maintenance) Defragment(ctx context.Context, endpoint string) (*DefragmentResponse, error) {
 	remote, cancel, err := m.dial(endpoint)
 	if err != nil {
@@ -185,6 +252,27 @@ func (m *maintenance) HashKV(ctx context.Context, endpoint string, rev int64) (*
 	return (*HashKVResponse)(resp), nil
 }
 
+func (m *maintenance) RevisionTime(ctx context.Context, endpoint string, rev int64) (*RevisionTimeResponse, error) {
+	return m.revisionTime(ctx, endpoint, &pb.RevisionTimeRequest{Revision: rev})
+}
+
+func (m *maintenance) RevisionAt(ctx context.Context, endpoint string, t time.Time) (*RevisionTimeResponse, error) {
+	return m.revisionTime(ctx, endpoint, &pb.RevisionTimeRequest{Time: t.UnixNano()})
+}
+
+func (m *maintenance) revisionTime(ctx context.Context, endpoint string, r *pb.RevisionTimeRequest) (*RevisionTimeResponse, error) {
+	remote, cancel, err := m.dial(endpoint)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	defer cancel()
+	resp, err := remote.RevisionTime(ctx, r, m.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*RevisionTimeResponse)(resp), nil
+}
+
 func (m *maintenance) Snapshot(ctx context.Context) (io.ReadCloser, error) {
 	ss, err := m.remote.Snapshot(ctx, &pb.SnapshotRequest{}, m.callOpts...)
 	if err != nil {
@@ -226,3 +314,19 @@ func (m *maintenance) MoveLeader(ctx context.Context, transfereeID uint64) (*Mov
 	resp, err := m.remote.MoveLeader(ctx, &pb.MoveLeaderRequest{TargetID: transfereeID}, m.callOpts...)
 	return (*MoveLeaderResponse)(resp), toErr(ctx, err)
 }
+
+func (m *maintenance) CompactionConfig(ctx context.Context) (*CompactionConfigResponse, error) {
+	resp, err := m.remote.CompactionConfig(ctx, &pb.CompactionConfigRequest{}, m.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*CompactionConfigResponse)(resp), nil
+}
+
+func (m *maintenance) SetCompactionConfig(ctx context.Context, mode string, retention int64) (*CompactionConfigResponse, error) {
+	resp, err := m.remote.CompactionConfig(ctx, &pb.CompactionConfigRequest{Mode: mode, Retention: retention}, m.callOpts...)
+	if err != nil {
+		return nil, toErr(ctx, err)
+	}
+	return (*CompactionConfigResponse)(resp), nil
+}
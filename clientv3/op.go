@@ -56,6 +56,8 @@ type Op struct {
 	// if true, split watch events when total exceeds
 	// "--max-request-bytes" flag value + 512-byte
 	fragment bool
+	// for watch
+	coalesce bool
 
 	// for put
 	ignoreValue bool
@@ -492,6 +494,26 @@ func WithFragment() OpOption {
 	return func(op *Op) { op.fragment = true }
 }
 
+// WithCoalesce makes the watcher receive only the latest event of each key
+// in a watch response, for consumers such as dashboards that do not need
+// every intermediate revision. While the watcher is behind, the server
+// merges its queued responses first. Responses that dropped events have
+// Coalesced set.
+func WithCoalesce() OpOption {
+	return func(op *Op) { op.coalesce = true }
+}
+
+// WithResumeToken makes the watcher start after the position recorded in
+// the token. The zero token leaves the start revision unchanged. If the
+// position has been compacted, the watch fails with rpctypes.ErrCompacted.
+func WithResumeToken(t ResumeToken) OpOption {
+	return func(op *Op) {
+		if t.Revision != 0 {
+			op.rev = t.Revision + 1
+		}
+	}
+}
+
 // WithIgnoreValue updates the key using its current value.
 // This option can not be combined with non-empty values.
 // Returns an error if the key does not exist.
@@ -47,6 +47,10 @@ type retryStopErrFunc func(error) bool
 // handle itself even with retries.
 func isRepeatableStopError(err error) bool {
 	eErr := rpctypes.Error(err)
+	// maintenance mode may last far longer than a request should retry
+	if eErr == rpctypes.ErrMaintenanceMode {
+		return true
+	}
 	// always stop retry on etcd errors
 	if serverErr, ok := eErr.(rpctypes.EtcdError); ok && serverErr.Code() != codes.Unavailable {
 		return true
@@ -98,7 +102,9 @@ func (c *Client) newRetryWrapper() retryRPCFunc {
 			}
 			lg.Lvl(4).Infof("clientv3/retry: error %q on pinned endpoint %q", err.Error(), pinned)
 
-			if s, ok := status.FromError(err); ok && (s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded || s.Code() == codes.Internal) {
+			// every member rejects writes in maintenance mode, so switching
+			// endpoints does not help
+			if s, ok := status.FromError(err); ok && (s.Code() == codes.Unavailable || s.Code() == codes.DeadlineExceeded || s.Code() == codes.Internal) && rpctypes.Error(err) != rpctypes.ErrMaintenanceMode {
 				// mark this before endpoint switch is triggered
 				c.balancer.HostPortError(pinned, err)
 				c.balancer.Next()
@@ -332,6 +338,22 @@ func (rmc *retryMaintenanceClient) HashKV(ctx context.Context, in *pb.HashKVRequ
 	return resp, err
 }
 
+func (rmc *retryMaintenanceClient) RevisionTime(ctx context.Context, in *pb.RevisionTimeRequest, opts ...grpc.CallOption) (resp *pb.RevisionTimeResponse, err error) {
+	err = rmc.retryf(ctx, func(rctx context.Context) error {
+		resp, err = rmc.mc.RevisionTime(rctx, in, opts...)
+		return err
+	}, repeatable)
+	return resp, err
+}
+
+func (rmc *retryMaintenanceClient) CompactionConfig(ctx context.Context, in *pb.CompactionConfigRequest, opts ...grpc.CallOption) (resp *pb.CompactionConfigResponse, err error) {
+	err = rmc.retryf(ctx, func(rctx context.Context) error {
+		resp, err = rmc.mc.CompactionConfig(rctx, in, opts...)
+		return err
+	}, repeatable)
+	return resp, err
+}
+
 func (rmc *retryMaintenanceClient) Snapshot(ctx context.Context, in *pb.SnapshotRequest, opts ...grpc.CallOption) (stream pb.Maintenance_SnapshotClient, err error) {
 	err = rmc.retryf(ctx, func(rctx context.Context) error {
 		stream, err = rmc.mc.Snapshot(rctx, in, opts...)
@@ -141,11 +141,18 @@ func (txn *txn) Commit() (*TxnResponse, error) {
 
 	r := &pb.TxnRequest{Compare: txn.cmps, Success: txn.sus, Failure: txn.fas}
 
+	timeout := txn.kv.readTimeout
+	if txn.isWrite {
+		timeout = txn.kv.writeTimeout
+	}
+	ctx, cancel := withTimeout(txn.ctx, timeout)
+	defer cancel()
+
 	var resp *pb.TxnResponse
 	var err error
-	resp, err = txn.kv.remote.Txn(txn.ctx, r, txn.callOpts...)
+	resp, err = txn.kv.remote.Txn(ctx, r, txn.callOpts...)
 	if err != nil {
-		return nil, toErr(txn.ctx, err)
+		return nil, toErr(ctx, err)
 	}
 	return (*TxnResponse)(resp), nil
 }
@@ -83,6 +83,10 @@ type WatchResponse struct {
 	// Created is used to indicate the creation of the watcher.
 	Created bool
 
+	// Coalesced is set if the watcher was created with WithCoalesce and
+	// events superseded by later events on the same key were dropped.
+	Coalesced bool
+
 	closeErr error
 
 	// cancelReason is a reason of canceling watch
@@ -120,11 +124,55 @@ func (wr *WatchResponse) IsProgressNotify() bool {
 	return len(wr.Events) == 0 && !wr.Canceled && !wr.Created && wr.CompactRevision == 0 && wr.Header.Revision != 0
 }
 
+// ResumeToken records the position of a watcher, so that a watch can be
+// resumed from it after either the client or the server restarts.
+type ResumeToken struct {
+	// Revision is the last revision delivered to the watcher.
+	Revision int64
+	// CompactRevision is set if the watcher fell behind compaction; the
+	// watch cannot be resumed and the caller must fetch the keys again.
+	CompactRevision int64
+}
+
+// String encodes the token for persisting; see ParseResumeToken.
+func (t ResumeToken) String() string {
+	return fmt.Sprintf("%d/%d", t.Revision, t.CompactRevision)
+}
+
+// ParseResumeToken decodes a token encoded by ResumeToken.String.
+func ParseResumeToken(s string) (ResumeToken, error) {
+	var t ResumeToken
+	if _, err := fmt.Sscanf(s, "%d/%d", &t.Revision, &t.CompactRevision); err != nil {
+		return ResumeToken{}, fmt.Errorf("invalid resume token %q (%v)", s, err)
+	}
+	return t, nil
+}
+
+// ResumeToken returns the position of the watcher after this response,
+// given the token of the previous response. Responses that deliver no
+// events and are not progress notifications leave the position unchanged.
+// With WithFragment, the events of one revision may span several responses,
+// so only the token of the last fragment should be persisted.
+func (wr *WatchResponse) ResumeToken(prev ResumeToken) ResumeToken {
+	switch {
+	case wr.CompactRevision != 0:
+		return ResumeToken{Revision: prev.Revision, CompactRevision: wr.CompactRevision}
+	case len(wr.Events) != 0:
+		return ResumeToken{Revision: wr.Events[len(wr.Events)-1].Kv.ModRevision}
+	case wr.IsProgressNotify():
+		return ResumeToken{Revision: wr.Header.Revision}
+	}
+	return prev
+}
+
 // watcher implements the Watcher interface
 type watcher struct {
 	remote   pb.WatchClient
 	callOpts []grpc.CallOption
 
+	// establishTimeout bounds the wait for a watch to be created
+	establishTimeout time.Duration
+
 	// mu protects the grpc streams map
 	mu sync.RWMutex
 
@@ -183,6 +231,8 @@ type watchRequest struct {
 	// if true, split watch events when total exceeds
 	// "--max-request-bytes" flag value + 512-byte
 	fragment bool
+	// coalesce drops events superseded within a watch response
+	coalesce bool
 
 	// filters is the list of events to filter out
 	filters []pb.WatchCreateRequest_FilterType
@@ -190,6 +240,9 @@ type watchRequest struct {
 	prevKV bool
 	// retc receives a chan WatchResponse once the watcher is established
 	retc chan chan WatchResponse
+	// cancel cancels ctx, a child of the caller's context, if the watch
+	// is not established within the establish timeout
+	cancel context.CancelFunc
 }
 
 // watcherStream represents a registered watcher
@@ -223,6 +276,7 @@ func NewWatchFromWatchClient(wc pb.WatchClient, c *Client) Watcher {
 	}
 	if c != nil {
 		w.callOpts = c.callOpts
+		w.establishTimeout = c.cfg.WatchEstablishTimeout
 	}
 	return w
 }
@@ -279,10 +333,14 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		rev:            ow.rev,
 		progressNotify: ow.progressNotify,
 		fragment:       ow.fragment,
+		coalesce:       ow.coalesce,
 		filters:        filters,
 		prevKV:         ow.prevKV,
 		retc:           make(chan chan WatchResponse, 1),
 	}
+	if w.establishTimeout > 0 {
+		wr.ctx, wr.cancel = context.WithCancel(ctx)
+	}
 
 	ok := false
 	ctxKey := streamKeyFromCtx(ctx)
@@ -308,10 +366,22 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 	// couldn't create channel; return closed channel
 	closeCh := make(chan WatchResponse, 1)
 
+	// the establish timeout covers submitting the request as well, since
+	// the stream may be blocked reconnecting to an unreachable member
+	var establishc <-chan time.Time
+	if w.establishTimeout > 0 {
+		timer := time.NewTimer(w.establishTimeout)
+		defer timer.Stop()
+		establishc = timer.C
+	}
+
 	// submit request
 	select {
 	case reqc <- wr:
 		ok = true
+	case <-establishc:
+		wr.cancel()
+		closeCh <- WatchResponse{closeErr: context.DeadlineExceeded}
 	case <-wr.ctx.Done():
 	case <-donec:
 		if wgs.closeErr != nil {
@@ -327,6 +397,10 @@ func (w *watcher) Watch(ctx context.Context, key string, opts ...OpOption) Watch
 		select {
 		case ret := <-wr.retc:
 			return ret
+		case <-establishc:
+			// drop the pending watch so it is not created later
+			wr.cancel()
+			closeCh <- WatchResponse{closeErr: context.DeadlineExceeded}
 		case <-ctx.Done():
 		case <-donec:
 			if wgs.closeErr != nil {
@@ -493,6 +567,7 @@ func (w *watchGrpcStream) run() {
 				cur.Events = append(cur.Events, pbresp.Events...)
 				// update "Fragment" field; last response with "Fragment" == false
 				cur.Fragment = pbresp.Fragment
+				cur.Coalesced = cur.Coalesced || pbresp.Coalesced
 			}
 
 			switch {
@@ -606,6 +681,7 @@ func (w *watchGrpcStream) dispatchEvent(pbresp *pb.WatchResponse) bool {
 		CompactRevision: pbresp.CompactRevision,
 		Created:         pbresp.Created,
 		Canceled:        pbresp.Canceled,
+		Coalesced:       pbresp.Coalesced,
 		cancelReason:    pbresp.CancelReason,
 	}
 	ws, ok := w.substreams[pbresp.WatchId]
@@ -863,6 +939,7 @@ func (wr *watchRequest) toPB() *pb.WatchRequest {
 		Filters:        wr.filters,
 		PrevKv:         wr.prevKV,
 		Fragment:       wr.fragment,
+		Coalesce:       wr.coalesce,
 	}
 	cr := &pb.WatchRequest_CreateRequest{CreateRequest: req}
 	return &pb.WatchRequest{RequestUnion: cr}
@@ -17,6 +17,7 @@ package clientv3
 import (
 	"testing"
 
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/mvcc/mvccpb"
 )
 
@@ -53,3 +54,48 @@ func TestEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestWatchResponseResumeToken(t *testing.T) {
+	prev := ResumeToken{Revision: 5}
+	tests := []struct {
+		wr WatchResponse
+		w  ResumeToken
+	}{
+		// created response leaves the position unchanged
+		{WatchResponse{Header: pb.ResponseHeader{Revision: 9}, Created: true}, prev},
+		{
+			WatchResponse{
+				Header: pb.ResponseHeader{Revision: 9},
+				Events: []*Event{{Kv: &mvccpb.KeyValue{ModRevision: 6}}, {Kv: &mvccpb.KeyValue{ModRevision: 7}}},
+			},
+			ResumeToken{Revision: 7},
+		},
+		// progress notification
+		{WatchResponse{Header: pb.ResponseHeader{Revision: 9}}, ResumeToken{Revision: 9}},
+		{WatchResponse{Header: pb.ResponseHeader{Revision: 9}, CompactRevision: 8, Canceled: true}, ResumeToken{Revision: 5, CompactRevision: 8}},
+	}
+	for i, tt := range tests {
+		if g := tt.wr.ResumeToken(prev); g != tt.w {
+			t.Errorf("#%d: token = %+v, want %+v", i, g, tt.w)
+		}
+	}
+}
+
+func TestParseResumeToken(t *testing.T) {
+	tok := ResumeToken{Revision: 12, CompactRevision: 3}
+	g, err := ParseResumeToken(tok.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g != tok {
+		t.Errorf("token = %+v, want %+v", g, tok)
+	}
+	if _, err = ParseResumeToken("abc"); err == nil {
+		t.Error("expected error on invalid token")
+	}
+
+	op := OpGet("foo", WithResumeToken(tok))
+	if op.rev != 13 {
+		t.Errorf("rev = %d, want 13", op.rev)
+	}
+}
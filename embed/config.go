@@ -18,6 +18,7 @@ import (
 	"crypto/tls"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net"
 	"net/http"
 	"net/url"
@@ -29,12 +30,14 @@ import (
 
 	"github.com/coreos/etcd/etcdserver"
 	"github.com/coreos/etcd/etcdserver/api/v3compactor"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc"
 	"github.com/coreos/etcd/pkg/flags"
 	"github.com/coreos/etcd/pkg/netutil"
 	"github.com/coreos/etcd/pkg/srv"
 	"github.com/coreos/etcd/pkg/transport"
 	"github.com/coreos/etcd/pkg/types"
 
+	"github.com/coreos/go-semver/semver"
 	"github.com/ghodss/yaml"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -50,11 +53,15 @@ const (
 	DefaultMaxSnapshots          = 5
 	DefaultMaxWALs               = 5
 	DefaultMaxTxnOps             = uint(128)
+	DefaultMetricsPushInterval   = 15 * time.Second
 	DefaultMaxRequestBytes       = 1.5 * 1024 * 1024
 	DefaultGRPCKeepAliveMinTime  = 5 * time.Second
 	DefaultGRPCKeepAliveInterval = 2 * time.Hour
 	DefaultGRPCKeepAliveTimeout  = 20 * time.Second
 
+	DefaultLeaseCheckpointInterval = 5 * time.Minute
+	DefaultMaintenanceModeTimeout  = 10 * time.Minute
+
 	DefaultListenPeerURLs   = "http://localhost:2380"
 	DefaultListenClientURLs = "http://localhost:2379"
 
@@ -168,12 +175,46 @@ type Config struct {
 	MaxTxnOps         uint  `json:"max-txn-ops"`
 	MaxRequestBytes   uint  `json:"max-request-bytes"`
 
+	// MaxRangeLimit is the maximum number of keys returned by a range
+	// request. Requests without a limit or with a larger limit are
+	// truncated and their responses have "more" set. 0 means no limit.
+	MaxRangeLimit int64 `json:"max-range-limit"`
+
+	// MaxWatchers is the maximum number of watchers the server keeps open
+	// across all clients. 0 means no limit.
+	MaxWatchers int `json:"max-watchers"`
+	// MaxWatchersPerConnection is the maximum number of watchers open on a
+	// single client connection. 0 means no limit. Connections are told
+	// apart by remote address, so the clients of a gRPC proxy or the gRPC
+	// gateway share the limit of the connection it opens.
+	MaxWatchersPerConnection int `json:"max-watchers-per-connection"`
+
+	// GRPCMaxRecvMsgBytes is the maximum gRPC message size in bytes the
+	// client server will receive. 0 defaults to "MaxRequestBytes" plus
+	// gRPC overhead. It must not be smaller than "MaxRequestBytes".
+	GRPCMaxRecvMsgBytes uint `json:"grpc-max-recv-msg-bytes"`
+	// GRPCMaxSendMsgBytes is the maximum gRPC message size in bytes the
+	// client server will send, which bounds the size of Range responses
+	// (e.g. large Kubernetes LIST results). 0 defaults to math.MaxInt32.
+	GRPCMaxSendMsgBytes uint `json:"grpc-max-send-msg-bytes"`
+
 	LPUrls, LCUrls []url.URL
 	APUrls, ACUrls []url.URL
 	ClientTLSInfo  transport.TLSInfo
 	ClientAutoTLS  bool
 	PeerTLSInfo    transport.TLSInfo
 	PeerAutoTLS    bool
+	// ClientListenerTLSInfo overrides ClientTLSInfo for the https and unixs
+	// listen client URLs it is keyed by, e.g. to serve another certificate
+	// or require client certificates on a public address only. The other
+	// client listeners use ClientTLSInfo.
+	ClientListenerTLSInfo map[string]transport.TLSInfo
+
+	// SocketActivation is true to serve peer and client URLs on the
+	// listeners passed by systemd socket activation ("LISTEN_FDS"),
+	// when their addresses match, instead of opening new sockets.
+	// URLs without a matching socket are listened on as usual.
+	SocketActivation bool `json:"socket-activation"`
 
 	ClusterState          string `json:"initial-cluster-state"`
 	DNSCluster            string `json:"discovery-srv"`
@@ -256,13 +297,99 @@ type Config struct {
 	//	}
 	//	embed.StartEtcd(cfg)
 	ServiceRegister func(*grpc.Server) `json:"-"`
+	// KeyValidator, if set, is called with the key of every put, including
+	// the puts of a txn, received by this member; a non-nil error rejects
+	// the request before it is proposed. Set it on every member so that
+	// writes cannot bypass it through another one. RegexpKeyValidator and
+	// PrefixKeyValidator build common validators.
+	KeyValidator func(key []byte) error `json:"-"`
 
 	AuthToken  string `json:"auth-token"`
 	BcryptCost uint   `json:"bcrypt-cost"`
 
+	// ClientBearerTokenFile is the path to a YAML or JSON file of static
+	// tokens and JWT verification keys. When set, every client gRPC request
+	// must carry a valid token in the "bearer-token" metadata, and keys
+	// are restricted to the prefixes the token grants.
+	ClientBearerTokenFile string `json:"client-bearer-token-file"`
+
 	ExperimentalInitialCorruptCheck bool          `json:"experimental-initial-corrupt-check"`
 	ExperimentalCorruptCheckTime    time.Duration `json:"experimental-corrupt-check-time"`
-	ExperimentalEnableV2V3          string        `json:"experimental-enable-v2v3"`
+	// ExperimentalOrphanLeaseKeyCheckTime is the interval at which the
+	// leader deletes keys attached to leases that no longer exist.
+	ExperimentalOrphanLeaseKeyCheckTime time.Duration `json:"experimental-orphan-lease-key-check-time"`
+	// ExperimentalEnableLeaseCheckpoint enables the primary lessor to
+	// checkpoint the remaining TTLs of leases, so that leader changes and
+	// restarts do not reset them to their full TTL.
+	ExperimentalEnableLeaseCheckpoint   bool          `json:"experimental-enable-lease-checkpoint"`
+	ExperimentalLeaseCheckpointInterval time.Duration `json:"experimental-lease-checkpoint-interval"`
+	ExperimentalEnableV2V3              string        `json:"experimental-enable-v2v3"`
+	// ExperimentalRequestLogSampling is a comma-separated list of
+	// "method=rate" pairs, e.g. "Range=0.01,Txn=0.1,*=0.001", that sets
+	// the fraction of unary client requests logged with their latency,
+	// key and response size. "*" matches all other methods.
+	ExperimentalRequestLogSampling string `json:"experimental-request-log-sampling"`
+	// ExperimentalGRPCCompressionURLs is a comma-separated list of the
+	// client listen URLs whose gRPC responses, such as watch replays and
+	// large ranges, are gzip-compressed for the clients that accept gzip.
+	ExperimentalGRPCCompressionURLs string `json:"experimental-grpc-compression-urls"`
+	// ExperimentalReportedVersion overrides the server version served on
+	// the client "/version" endpoint, for clients that gate behavior on
+	// the etcd version. It does not affect cluster version negotiation.
+	ExperimentalReportedVersion string `json:"experimental-reported-version"`
+	// ExperimentalRangeCacheTTL is how long the responses of identical
+	// range requests are cached while none of their keys are written.
+	// 0 disables the cache.
+	ExperimentalRangeCacheTTL time.Duration `json:"experimental-range-cache-ttl"`
+	// ExperimentalEnableGRPCWeb serves gRPC-web requests on the client
+	// listeners, so browser based tools can call the gRPC API directly.
+	// Browser origins must be allowed with "CORS".
+	ExperimentalEnableGRPCWeb bool `json:"experimental-enable-grpc-web"`
+	// ExperimentalEnableUI serves a web UI at "/ui/" on the client
+	// listeners to browse keys, compact and watch. The UI is only served
+	// while auth is enabled; it signs in as a user and is granted that
+	// user's permissions.
+	ExperimentalEnableUI bool `json:"experimental-enable-ui"`
+	// ExperimentalCompactionPauseLatency makes compaction wait between
+	// batches of deletes while reads and writes on the store take longer
+	// than this duration, so that it does not add to their latency.
+	// 0 disables it.
+	ExperimentalCompactionPauseLatency time.Duration `json:"experimental-compaction-pause-latency"`
+	// ExperimentalWatchEventHistorySize is the number of recent events
+	// kept in memory, so that watchers starting at a recent revision catch
+	// up without reading the backend. 0 disables it.
+	ExperimentalWatchEventHistorySize int `json:"experimental-watch-event-history-size"`
+	// ExperimentalRevisionTimes records the time each revision is
+	// committed at on this member, to look up the revision as of a time.
+	ExperimentalRevisionTimes bool `json:"experimental-revision-times"`
+	// ExperimentalMetricsPushURL is the Pushgateway ("http://host:port"),
+	// statsd ("statsd://host:port") or DogStatsD ("dogstatsd://host:port")
+	// server the metrics are pushed to. Empty disables pushing.
+	ExperimentalMetricsPushURL string `json:"experimental-metrics-push-url"`
+	// ExperimentalMetricsPushInterval is the wait duration between pushes
+	// of the metrics.
+	ExperimentalMetricsPushInterval time.Duration `json:"experimental-metrics-push-interval"`
+	// ExperimentalMetricsKeyPrefixes is a comma-separated list of at most
+	// mvcc.MaxMetricsKeyPrefixes key prefixes that the revisions written,
+	// put value sizes and watch event sizes are reported by.
+	ExperimentalMetricsKeyPrefixes string `json:"experimental-metrics-key-prefixes"`
+	// ExperimentalQuotaAlertTime is the projected time until the backend
+	// quota is reached, at the growth rate of the last ten minutes, under
+	// which a warning is logged. 0 disables it.
+	ExperimentalQuotaAlertTime time.Duration `json:"experimental-quota-alert-time"`
+	// QuotaAlert, if set, is also called with the backend size, the quota
+	// and the projected time left whenever the ExperimentalQuotaAlertTime
+	// warning is logged, about once a minute.
+	QuotaAlert func(size, quota int64, left time.Duration) `json:"-"`
+	// ExperimentalMaintenanceModeTimeout is how long the MAINTENANCE alarm,
+	// which rejects writes, stays active before the leader disarms it.
+	// 0 keeps it until it is disarmed.
+	ExperimentalMaintenanceModeTimeout time.Duration `json:"experimental-maintenance-mode-timeout"`
+	// ExperimentalClientCertAuthSANUsername authenticates client
+	// certificates without a Common Name as the user named by their first
+	// DNS Subject Alternative Name. Such certificates otherwise carry no
+	// user name.
+	ExperimentalClientCertAuthSANUsername bool `json:"experimental-client-cert-auth-san-username"`
 
 	// ForceNewCluster starts a new cluster even if previously started; unsafe.
 	ForceNewCluster bool `json:"force-new-cluster"`
@@ -331,6 +458,8 @@ type configJSON struct {
 
 	ClientSecurityJSON securityConfig `json:"client-transport-security"`
 	PeerSecurityJSON   securityConfig `json:"peer-transport-security"`
+
+	ClientListenerSecurityJSON map[string]securityConfig `json:"client-listener-transport-security"`
 }
 
 type securityConfig struct {
@@ -379,6 +508,8 @@ func NewConfig() *Config {
 		Metrics:             "basic",
 		EnableV2:            DefaultEnableV2,
 
+		ExperimentalMetricsPushInterval: DefaultMetricsPushInterval,
+
 		CORS:          map[string]struct{}{"*": {}},
 		HostWhitelist: map[string]struct{}{"*": {}},
 
@@ -387,6 +518,9 @@ func NewConfig() *Config {
 
 		PreVote: false, // TODO: enable by default in v3.5
 
+		ExperimentalLeaseCheckpointInterval: DefaultLeaseCheckpointInterval,
+		ExperimentalMaintenanceModeTimeout:  DefaultMaintenanceModeTimeout,
+
 		loggerMu:            new(sync.RWMutex),
 		logger:              nil,
 		Logger:              "capnslog",
@@ -504,12 +638,69 @@ func (cfg *configYAML) configFromFile(path string) error {
 	}
 	copySecurityDetails(&cfg.ClientTLSInfo, &cfg.ClientSecurityJSON)
 	copySecurityDetails(&cfg.PeerTLSInfo, &cfg.PeerSecurityJSON)
+	if len(cfg.ClientListenerSecurityJSON) > 0 {
+		cfg.ClientListenerTLSInfo = make(map[string]transport.TLSInfo, len(cfg.ClientListenerSecurityJSON))
+		for u, ysc := range cfg.ClientListenerSecurityJSON {
+			var tls transport.TLSInfo
+			copySecurityDetails(&tls, &ysc)
+			cfg.ClientListenerTLSInfo[u] = tls
+		}
+	}
 	cfg.ClientAutoTLS = cfg.ClientSecurityJSON.AutoTLS
 	cfg.PeerAutoTLS = cfg.PeerSecurityJSON.AutoTLS
 
 	return cfg.Validate()
 }
 
+// checkClientListenerTLSInfo ensures the per-listener TLS settings are keyed
+// by https or unixs listen client URLs and set a key pair.
+func checkClientListenerTLSInfo(infos map[string]transport.TLSInfo, lcurls []url.URL) error {
+	for us, info := range infos {
+		var lu *url.URL
+		for i := range lcurls {
+			if lcurls[i].String() == us {
+				lu = &lcurls[i]
+			}
+		}
+		if lu == nil {
+			return fmt.Errorf("client listener TLS URL %q is not in --listen-client-urls", us)
+		}
+		if lu.Scheme != "https" && lu.Scheme != "unixs" {
+			return fmt.Errorf("client listener TLS URL %q must have https or unixs scheme", us)
+		}
+		if info.CertFile == "" || info.KeyFile == "" {
+			return fmt.Errorf("client listener TLS for %q must set both cert-file and key-file", us)
+		}
+	}
+	return nil
+}
+
+// clientTLSInfo returns the TLS settings of the listen client URL u.
+func (cfg *Config) clientTLSInfo(u url.URL) *transport.TLSInfo {
+	info, ok := cfg.ClientListenerTLSInfo[u.String()]
+	if !ok {
+		return &cfg.ClientTLSInfo
+	}
+	if info.HandshakeFailure == nil {
+		info.HandshakeFailure = cfg.ClientTLSInfo.HandshakeFailure
+	}
+	return &info
+}
+
+// clientCertAuthEnabled returns true if any client listener verifies
+// client certificates.
+func (cfg *Config) clientCertAuthEnabled() bool {
+	if cfg.ClientTLSInfo.ClientCertAuth {
+		return true
+	}
+	for _, info := range cfg.ClientListenerTLSInfo {
+		if info.ClientCertAuth {
+			return true
+		}
+	}
+	return false
+}
+
 // Validate ensures that '*embed.Config' fields are properly configured.
 func (cfg *Config) Validate() error {
 	if err := cfg.setupLogging(); err != nil {
@@ -566,6 +757,53 @@ func (cfg *Config) Validate() error {
 		return ErrUnsetAdvertiseClientURLsFlag
 	}
 
+	if cfg.MaxRangeLimit < 0 {
+		return fmt.Errorf("--max-range-limit[%d] must not be negative", cfg.MaxRangeLimit)
+	}
+	if cfg.ExperimentalWatchEventHistorySize < 0 {
+		return fmt.Errorf("--experimental-watch-event-history-size[%d] must not be negative", cfg.ExperimentalWatchEventHistorySize)
+	}
+	if cfg.MaxWatchers < 0 {
+		return fmt.Errorf("--max-watchers[%d] must not be negative", cfg.MaxWatchers)
+	}
+	if cfg.MaxWatchersPerConnection < 0 {
+		return fmt.Errorf("--max-watchers-per-connection[%d] must not be negative", cfg.MaxWatchersPerConnection)
+	}
+	if cfg.GRPCMaxRecvMsgBytes > 0 && cfg.GRPCMaxRecvMsgBytes < cfg.MaxRequestBytes {
+		return fmt.Errorf("--grpc-max-recv-msg-bytes[%d] must be at least --max-request-bytes[%d]", cfg.GRPCMaxRecvMsgBytes, cfg.MaxRequestBytes)
+	}
+	if cfg.GRPCMaxRecvMsgBytes > math.MaxInt32 {
+		return fmt.Errorf("--grpc-max-recv-msg-bytes[%d] must be at most %d", cfg.GRPCMaxRecvMsgBytes, math.MaxInt32)
+	}
+	if cfg.GRPCMaxSendMsgBytes > math.MaxInt32 {
+		return fmt.Errorf("--grpc-max-send-msg-bytes[%d] must be at most %d", cfg.GRPCMaxSendMsgBytes, math.MaxInt32)
+	}
+
+	if cfg.ExperimentalReportedVersion != "" {
+		if _, err := semver.NewVersion(cfg.ExperimentalReportedVersion); err != nil {
+			return fmt.Errorf("--experimental-reported-version[%s] is not a valid semantic version (%v)", cfg.ExperimentalReportedVersion, err)
+		}
+	}
+
+	if cfg.ExperimentalMetricsPushURL != "" && cfg.ExperimentalMetricsPushInterval <= 0 {
+		return fmt.Errorf("--experimental-metrics-push-interval[%v] must be >0", cfg.ExperimentalMetricsPushInterval)
+	}
+	if cfg.ExperimentalQuotaAlertTime < 0 {
+		return fmt.Errorf("--experimental-quota-alert-time[%v] must not be negative", cfg.ExperimentalQuotaAlertTime)
+	}
+	if cfg.ExperimentalMaintenanceModeTimeout < 0 {
+		return fmt.Errorf("--experimental-maintenance-mode-timeout[%v] must not be negative", cfg.ExperimentalMaintenanceModeTimeout)
+	}
+
+	if cfg.ClientBearerTokenFile != "" {
+		if _, err := v3rpc.LoadBearerTokens(cfg.ClientBearerTokenFile); err != nil {
+			return fmt.Errorf("--client-bearer-token-file: %v", err)
+		}
+	}
+	if err := checkClientListenerTLSInfo(cfg.ClientListenerTLSInfo, cfg.LCUrls); err != nil {
+		return err
+	}
+
 	switch cfg.AutoCompactionMode {
 	case "":
 	case CompactorModeRevision, CompactorModePeriodic:
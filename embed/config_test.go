@@ -17,11 +17,16 @@ package embed
 import (
 	"fmt"
 	"io/ioutil"
+	"math"
 	"net/url"
 	"os"
+	"reflect"
+	"strings"
 	"testing"
 
+	"github.com/coreos/etcd/mvcc"
 	"github.com/coreos/etcd/pkg/transport"
+	"github.com/coreos/etcd/pkg/types"
 
 	"github.com/ghodss/yaml"
 )
@@ -166,6 +171,69 @@ func TestAutoCompactionModeInvalid(t *testing.T) {
 	}
 }
 
+func TestGRPCMaxRecvMsgBytesInvalid(t *testing.T) {
+	cfg := NewConfig()
+	cfg.Logger = "zap"
+	cfg.LogOutputs = []string{"/dev/null"}
+	cfg.Debug = false
+	cfg.GRPCMaxRecvMsgBytes = cfg.MaxRequestBytes - 1
+	err := cfg.Validate()
+	if err == nil {
+		t.Errorf("expected non-nil error, got %v", err)
+	}
+
+	cfg.GRPCMaxRecvMsgBytes = math.MaxInt32 + 1
+	err = cfg.Validate()
+	if err == nil {
+		t.Errorf("expected non-nil error, got %v", err)
+	}
+}
+
+func TestCheckClientListenerTLSInfo(t *testing.T) {
+	key := transport.TLSInfo{CertFile: "server.crt", KeyFile: "server.key"}
+	tests := []struct {
+		infos map[string]transport.TLSInfo
+		werr  bool
+	}{
+		{map[string]transport.TLSInfo{"https://127.0.0.1:2379": key}, false},
+		{map[string]transport.TLSInfo{"unixs://localhost:2381": key}, false},
+		{map[string]transport.TLSInfo{"https://127.0.0.1:2383": key}, true},
+		{map[string]transport.TLSInfo{"http://127.0.0.1:2380": key}, true},
+		{map[string]transport.TLSInfo{"https://127.0.0.1:2379": {CertFile: "server.crt"}}, true},
+	}
+	lcurls, err := types.NewURLs([]string{"https://127.0.0.1:2379", "http://127.0.0.1:2380", "unixs://localhost:2381"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, tt := range tests {
+		if err = checkClientListenerTLSInfo(tt.infos, lcurls); (err != nil) != tt.werr {
+			t.Errorf("#%d: error = %v, want error %v", i, err, tt.werr)
+		}
+	}
+}
+
+func TestCheckBindURLs(t *testing.T) {
+	tests := []struct {
+		urls string
+		werr bool
+	}{
+		{"http://127.0.0.1:2379,http://[::1]:2379", false},
+		{"https://[::]:2379", false},
+		{"http://localhost:2379", false},
+		{"unix://localhost:2379", false},
+		{"http://example.com:2379", true},
+	}
+	for i, tt := range tests {
+		urls, err := types.NewURLs(strings.Split(tt.urls, ","))
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		if err = checkBindURLs(urls); (err != nil) != tt.werr {
+			t.Errorf("#%d: error = %v, want error %v", i, err, tt.werr)
+		}
+	}
+}
+
 func TestAutoCompactionModeParse(t *testing.T) {
 	dur, err := parseCompactionRetention("revision", "1")
 	if err != nil {
@@ -175,3 +243,49 @@ func TestAutoCompactionModeParse(t *testing.T) {
 		t.Fatalf("AutoCompactionRetention expected 1, got %d", dur)
 	}
 }
+
+func TestRequestLogSamplingParse(t *testing.T) {
+	tests := []struct {
+		s      string
+		wrates map[string]float64
+		werr   bool
+	}{
+		{"", nil, false},
+		{"Range=0.01, *=1", map[string]float64{"Range": 0.01, "*": 1}, false},
+		{"Range", nil, true},
+		{"=0.1", nil, true},
+		{"Range=2", nil, true},
+		{"Range=foo", nil, true},
+	}
+	for i, tt := range tests {
+		rates, err := parseRequestLogSampling(tt.s)
+		if (err != nil) != tt.werr {
+			t.Errorf("#%d: err = %v, want error %v", i, err, tt.werr)
+		}
+		if !reflect.DeepEqual(rates, tt.wrates) {
+			t.Errorf("#%d: rates = %v, want %v", i, rates, tt.wrates)
+		}
+	}
+}
+
+func TestMetricsKeyPrefixesParse(t *testing.T) {
+	tests := []struct {
+		s         string
+		wprefixes []string
+		werr      bool
+	}{
+		{"", nil, false},
+		{"/registry/pods/,/registry/events/", []string{"/registry/pods/", "/registry/events/"}, false},
+		{"/registry/pods/,", nil, true},
+		{strings.Repeat("a,", mvcc.MaxMetricsKeyPrefixes) + "a", nil, true},
+	}
+	for i, tt := range tests {
+		prefixes, err := parseMetricsKeyPrefixes(tt.s)
+		if (err != nil) != tt.werr {
+			t.Errorf("#%d: err = %v, want error %v", i, err, tt.werr)
+		}
+		if !reflect.DeepEqual(prefixes, tt.wprefixes) {
+			t.Errorf("#%d: prefixes = %v, want %v", i, prefixes, tt.wprefixes)
+		}
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"net/url"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -35,13 +36,16 @@ import (
 	"github.com/coreos/etcd/etcdserver/api/v2v3"
 	"github.com/coreos/etcd/etcdserver/api/v3client"
 	"github.com/coreos/etcd/etcdserver/api/v3rpc"
+	"github.com/coreos/etcd/mvcc"
 	"github.com/coreos/etcd/pkg/debugutil"
+	"github.com/coreos/etcd/pkg/metricspush"
 	runtimeutil "github.com/coreos/etcd/pkg/runtime"
 	"github.com/coreos/etcd/pkg/transport"
 	"github.com/coreos/etcd/pkg/types"
 
 	"github.com/coreos/pkg/capnslog"
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/soheilhy/cmux"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -111,13 +115,21 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		e = nil
 	}()
 
+	var al *activatedListeners
+	if cfg.SocketActivation {
+		if al, err = newActivatedListeners(cfg.logger); err != nil {
+			return e, err
+		}
+		defer al.closeUnused()
+	}
+
 	if e.cfg.logger != nil {
 		e.cfg.logger.Info(
 			"configuring peer listeners",
 			zap.Strings("listen-peer-urls", e.cfg.getLPURLs()),
 		)
 	}
-	if e.Peers, err = configurePeerListeners(cfg); err != nil {
+	if e.Peers, err = configurePeerListeners(cfg, al); err != nil {
 		return e, err
 	}
 
@@ -127,7 +139,7 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 			zap.Strings("listen-client-urls", e.cfg.getLCURLs()),
 		)
 	}
-	if e.sctxs, err = configureClientListeners(cfg); err != nil {
+	if e.sctxs, err = configureClientListeners(cfg, al); err != nil {
 		return e, err
 	}
 
@@ -157,6 +169,17 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		return e, err
 	}
 
+	requestLogSampleRates, err := parseRequestLogSampling(cfg.ExperimentalRequestLogSampling)
+	if err != nil {
+		return e, err
+	}
+
+	metricsKeyPrefixes, err := parseMetricsKeyPrefixes(cfg.ExperimentalMetricsKeyPrefixes)
+	if err != nil {
+		return e, err
+	}
+	mvcc.SetMetricsKeyPrefixes(metricsKeyPrefixes)
+
 	srvcfg := etcdserver.ServerConfig{
 		Name:                       cfg.Name,
 		ClientURLs:                 cfg.ACUrls,
@@ -180,14 +203,29 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		QuotaBackendBytes:          cfg.QuotaBackendBytes,
 		MaxTxnOps:                  cfg.MaxTxnOps,
 		MaxRequestBytes:            cfg.MaxRequestBytes,
+		MaxRangeLimit:              cfg.MaxRangeLimit,
+		MaxWatchers:                cfg.MaxWatchers,
+		MaxWatchersPerConnection:   cfg.MaxWatchersPerConnection,
+		GRPCMaxRecvMsgBytes:        cfg.GRPCMaxRecvMsgBytes,
+		GRPCMaxSendMsgBytes:        cfg.GRPCMaxSendMsgBytes,
 		StrictReconfigCheck:        cfg.StrictReconfigCheck,
-		ClientCertAuthEnabled:      cfg.ClientTLSInfo.ClientCertAuth,
+		ClientCertAuthEnabled:      cfg.clientCertAuthEnabled(),
 		AuthToken:                  cfg.AuthToken,
+		ClientBearerTokenFile:      cfg.ClientBearerTokenFile,
 		BcryptCost:                 cfg.BcryptCost,
 		CORS:                       cfg.CORS,
 		HostWhitelist:              cfg.HostWhitelist,
 		InitialCorruptCheck:        cfg.ExperimentalInitialCorruptCheck,
 		CorruptCheckTime:           cfg.ExperimentalCorruptCheckTime,
+		OrphanLeaseKeyCheckTime:    cfg.ExperimentalOrphanLeaseKeyCheckTime,
+		EnableLeaseCheckpoint:      cfg.ExperimentalEnableLeaseCheckpoint,
+		LeaseCheckpointInterval:    cfg.ExperimentalLeaseCheckpointInterval,
+		RequestLogSampleRates:      requestLogSampleRates,
+		ReportedVersion:            cfg.ExperimentalReportedVersion,
+		RangeCacheTTL:              cfg.ExperimentalRangeCacheTTL,
+		CompactionPauseLatency:     cfg.ExperimentalCompactionPauseLatency,
+		WatchEventHistorySize:      cfg.ExperimentalWatchEventHistorySize,
+		RevisionTimes:              cfg.ExperimentalRevisionTimes,
 		PreVote:                    cfg.PreVote,
 		Logger:                     cfg.logger,
 		LoggerConfig:               cfg.loggerConfig,
@@ -195,6 +233,11 @@ func StartEtcd(inCfg *Config) (e *Etcd, err error) {
 		LoggerWriteSyncer:          cfg.loggerWriteSyncer,
 		Debug:                      cfg.Debug,
 		ForceNewCluster:            cfg.ForceNewCluster,
+		KeyValidator:               cfg.KeyValidator,
+		QuotaAlertTime:             cfg.ExperimentalQuotaAlertTime,
+		QuotaAlert:                 cfg.QuotaAlert,
+		MaintenanceModeTimeout:     cfg.ExperimentalMaintenanceModeTimeout,
+		ClientCertAuthSANUsername:  cfg.ExperimentalClientCertAuthSANUsername,
 	}
 	if e.Server, err = etcdserver.NewServer(srvcfg); err != nil {
 		return e, err
@@ -341,6 +384,9 @@ func stopServers(ctx context.Context, ss *servers) {
 		ss.http.Shutdown(ctx)
 		// then close grpc.Server; cancels all active RPCs
 		ss.grpc.Stop()
+		if ss.grpcGZIP != nil {
+			ss.grpcGZIP.Stop()
+		}
 	}
 
 	// do not grpc.Server.GracefulStop with TLS enabled etcd server
@@ -357,6 +403,9 @@ func stopServers(ctx context.Context, ss *servers) {
 		// close listeners to stop accepting new connections,
 		// will block on any existing transports
 		ss.grpc.GracefulStop()
+		if ss.grpcGZIP != nil {
+			ss.grpcGZIP.GracefulStop()
+		}
 	}()
 
 	// wait until all pending RPCs are finished
@@ -374,7 +423,7 @@ func stopServers(ctx context.Context, ss *servers) {
 
 func (e *Etcd) Err() <-chan error { return e.errc }
 
-func configurePeerListeners(cfg *Config) (peers []*peerListener, err error) {
+func configurePeerListeners(cfg *Config, al *activatedListeners) (peers []*peerListener, err error) {
 	if err = cfg.PeerSelfCert(); err != nil {
 		if cfg.logger != nil {
 			cfg.logger.Fatal("failed to get peer self-signed certs", zap.Error(err))
@@ -431,7 +480,11 @@ func configurePeerListeners(cfg *Config) (peers []*peerListener, err error) {
 			}
 		}
 		peers[i] = &peerListener{close: func(context.Context) error { return nil }}
-		peers[i].Listener, err = rafthttp.NewListener(u, &cfg.PeerTLSInfo)
+		if ln := al.take(u); ln != nil {
+			peers[i].Listener, err = rafthttp.NewListenerFromListener(ln, u, &cfg.PeerTLSInfo)
+		} else {
+			peers[i].Listener, err = rafthttp.NewListener(u, &cfg.PeerTLSInfo)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -504,7 +557,7 @@ func (e *Etcd) servePeers() (err error) {
 	return nil
 }
 
-func configureClientListeners(cfg *Config) (sctxs map[string]*serveCtx, err error) {
+func configureClientListeners(cfg *Config, al *activatedListeners) (sctxs map[string]*serveCtx, err error) {
 	if err = cfg.ClientSelfCert(); err != nil {
 		if cfg.logger != nil {
 			cfg.logger.Fatal("failed to get client self-signed certs", zap.Error(err))
@@ -515,11 +568,22 @@ func configureClientListeners(cfg *Config) (sctxs map[string]*serveCtx, err erro
 	if cfg.EnablePprof {
 		if cfg.logger != nil {
 			cfg.logger.Info("pprof is enabled", zap.String("path", debugutil.HTTPPrefixPProf))
+			cfg.logger.Info("server state dump is enabled", zap.String("path", etcdhttp.PathDebugState))
+			cfg.logger.Info("lease event stream is enabled", zap.String("path", etcdhttp.PathDebugLeaseEvents))
+			cfg.logger.Info("revision lookup by time is enabled", zap.String("path", etcdhttp.PathDebugRevision))
 		} else {
 			plog.Infof("pprof is enabled under %s", debugutil.HTTPPrefixPProf)
+			plog.Infof("server state dump is enabled under %s", etcdhttp.PathDebugState)
+			plog.Infof("lease event stream is enabled under %s", etcdhttp.PathDebugLeaseEvents)
+			plog.Infof("revision lookup by time is enabled under %s", etcdhttp.PathDebugRevision)
 		}
 	}
 
+	compressAddrs, err := parseGRPCCompressionURLs(cfg.ExperimentalGRPCCompressionURLs, cfg.LCUrls)
+	if err != nil {
+		return nil, err
+	}
+
 	sctxs = make(map[string]*serveCtx)
 	for _, u := range cfg.LCUrls {
 		sctx := newServeCtx(cfg.logger)
@@ -539,28 +603,36 @@ func configureClientListeners(cfg *Config) (sctxs map[string]*serveCtx, err erro
 				}
 			}
 		}
-		if (u.Scheme == "https" || u.Scheme == "unixs") && cfg.ClientTLSInfo.Empty() {
+		sctx.tlsinfo = cfg.clientTLSInfo(u)
+		if (u.Scheme == "https" || u.Scheme == "unixs") && sctx.tlsinfo.Empty() {
 			return nil, fmt.Errorf("TLS key/cert (--cert-file, --key-file) must be provided for client url %s with HTTPs scheme", u.String())
 		}
-
-		network := "tcp"
-		addr := u.Host
-		if u.Scheme == "unix" || u.Scheme == "unixs" {
-			network = "unix"
-			addr = u.Host + u.Path
+		if _, ok := cfg.ClientListenerTLSInfo[u.String()]; ok {
+			if cfg.logger != nil {
+				cfg.logger.Info("using listener client TLS", zap.String("client-url", u.String()), zap.String("tls-info", fmt.Sprintf("%+v", *sctx.tlsinfo)))
+			} else {
+				plog.Infof("client TLS for %s: %s", u.String(), *sctx.tlsinfo)
+			}
 		}
+
+		network, addr := clientListenAddr(u)
 		sctx.network = network
 
 		sctx.secure = u.Scheme == "https" || u.Scheme == "unixs"
 		sctx.insecure = !sctx.secure
 		if oldctx := sctxs[addr]; oldctx != nil {
+			if sctx.secure {
+				oldctx.tlsinfo = sctx.tlsinfo
+			}
 			oldctx.secure = oldctx.secure || sctx.secure
 			oldctx.insecure = oldctx.insecure || sctx.insecure
 			continue
 		}
 
-		if sctx.l, err = net.Listen(network, addr); err != nil {
-			return nil, err
+		if sctx.l = al.take(u); sctx.l == nil {
+			if sctx.l, err = net.Listen(network, addr); err != nil {
+				return nil, err
+			}
 		}
 		// net.Listener will rewrite ipv4 0.0.0.0 to ipv6 [::], breaking
 		// hosts that disable ipv6. So, use the address given by the user.
@@ -606,6 +678,9 @@ func configureClientListeners(cfg *Config) (sctxs map[string]*serveCtx, err erro
 			sctx.userHandlers[k] = cfg.UserHandlers[k]
 		}
 		sctx.serviceRegister = cfg.ServiceRegister
+		sctx.grpcWeb = cfg.ExperimentalEnableGRPCWeb
+		_, sctx.grpcCompress = compressAddrs[addr]
+		sctx.ui = cfg.ExperimentalEnableUI
 		if cfg.EnablePprof || cfg.Debug {
 			sctx.registerPprof()
 		}
@@ -659,10 +734,18 @@ func (e *Etcd) serveClients() (err error) {
 		}))
 	}
 
+	if e.cfg.EnablePprof || e.cfg.Debug {
+		for _, sctx := range e.sctxs {
+			sctx.registerUserHandler(etcdhttp.PathDebugState, etcdhttp.NewDebugStateHandler(e.Server))
+			sctx.registerUserHandler(etcdhttp.PathDebugLeaseEvents, etcdhttp.NewDebugLeaseEventsHandler(e.Server))
+			sctx.registerUserHandler(etcdhttp.PathDebugRevision, etcdhttp.NewDebugRevisionHandler(e.Server))
+		}
+	}
+
 	// start client servers in a goroutine
 	for _, sctx := range e.sctxs {
 		go func(s *serveCtx) {
-			e.errHandler(s.serve(e.Server, &e.cfg.ClientTLSInfo, h, e.errHandler, gopts...))
+			e.errHandler(s.serve(e.Server, s.tlsinfo, h, e.errHandler, gopts...))
 		}(sctx)
 	}
 	return nil
@@ -671,6 +754,7 @@ func (e *Etcd) serveClients() (err error) {
 func (e *Etcd) serveMetrics() (err error) {
 	if e.cfg.Metrics == "extensive" {
 		grpc_prometheus.EnableHandlingTimeHistogram()
+		v3rpc.EnableResourceWatchMetrics()
 	}
 
 	if len(e.cfg.ListenMetricsUrls) > 0 {
@@ -700,9 +784,47 @@ func (e *Etcd) serveMetrics() (err error) {
 			}(murl, ml)
 		}
 	}
+
+	if e.cfg.ExperimentalMetricsPushURL != "" {
+		p, err := metricspush.New(e.cfg.ExperimentalMetricsPushURL, e.cfg.Name, e.cfg.ExperimentalMetricsPushInterval)
+		if err != nil {
+			return err
+		}
+		go e.pushMetrics(p)
+	}
 	return nil
 }
 
+// pushMetrics pushes the registered metrics at every push interval until
+// the server is stopped.
+func (e *Etcd) pushMetrics(p metricspush.Pusher) {
+	defer p.Close()
+	t := time.NewTicker(e.cfg.ExperimentalMetricsPushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-e.stopc:
+			return
+		}
+		mfs, err := prometheus.DefaultGatherer.Gather()
+		if err == nil {
+			err = p.Push(mfs)
+		}
+		if err != nil {
+			if e.cfg.logger != nil {
+				e.cfg.logger.Warn(
+					"failed to push metrics",
+					zap.String("url", e.cfg.ExperimentalMetricsPushURL),
+					zap.Error(err),
+				)
+			} else {
+				plog.Warningf("failed to push metrics to %s: %v", e.cfg.ExperimentalMetricsPushURL, err)
+			}
+		}
+	}
+}
+
 func (e *Etcd) errHandler(err error) {
 	select {
 	case <-e.stopc:
@@ -741,3 +863,72 @@ func parseCompactionRetention(mode, retention string) (ret time.Duration, err er
 	}
 	return ret, nil
 }
+
+// clientListenAddr returns the network and address to listen on for the
+// client URL u.
+func clientListenAddr(u url.URL) (network, addr string) {
+	if u.Scheme == "unix" || u.Scheme == "unixs" {
+		return "unix", u.Host + u.Path
+	}
+	return "tcp", u.Host
+}
+
+// parseGRPCCompressionURLs returns the addresses of the comma-separated
+// client URLs in s, which must all be listened on.
+func parseGRPCCompressionURLs(s string, lcurls []url.URL) (map[string]struct{}, error) {
+	addrs := make(map[string]struct{})
+	if s == "" {
+		return addrs, nil
+	}
+	for _, us := range strings.Split(s, ",") {
+		u, err := url.Parse(strings.TrimSpace(us))
+		if err != nil {
+			return nil, fmt.Errorf("invalid gRPC compression URL %q (%v)", us, err)
+		}
+		found := false
+		for _, lu := range lcurls {
+			found = found || lu.String() == u.String()
+		}
+		if !found {
+			return nil, fmt.Errorf("gRPC compression URL %q is not in --listen-client-urls", u.String())
+		}
+		_, addr := clientListenAddr(*u)
+		addrs[addr] = struct{}{}
+	}
+	return addrs, nil
+}
+
+func parseMetricsKeyPrefixes(s string) ([]string, error) {
+	if s == "" {
+		return nil, nil
+	}
+	prefixes := strings.Split(s, ",")
+	if len(prefixes) > mvcc.MaxMetricsKeyPrefixes {
+		return nil, fmt.Errorf("too many metrics key prefixes %d (expected at most %d)", len(prefixes), mvcc.MaxMetricsKeyPrefixes)
+	}
+	for _, p := range prefixes {
+		if p == "" {
+			return nil, fmt.Errorf("invalid metrics key prefixes %q (expected no empty prefix)", s)
+		}
+	}
+	return prefixes, nil
+}
+
+func parseRequestLogSampling(s string) (map[string]float64, error) {
+	if s == "" {
+		return nil, nil
+	}
+	rates := make(map[string]float64)
+	for _, kv := range strings.Split(s, ",") {
+		ss := strings.SplitN(strings.TrimSpace(kv), "=", 2)
+		if len(ss) != 2 || ss[0] == "" {
+			return nil, fmt.Errorf("invalid request log sampling %q (expected 'method=rate')", kv)
+		}
+		rate, err := strconv.ParseFloat(ss[1], 64)
+		if err != nil || rate < 0 || rate > 1 {
+			return nil, fmt.Errorf("invalid request log sampling rate %q (expected a number between 0 and 1)", ss[1])
+		}
+		rates[ss[0]] = rate
+	}
+	return rates, nil
+}
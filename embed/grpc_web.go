@@ -0,0 +1,181 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/http2"
+	"google.golang.org/grpc"
+)
+
+const (
+	grpcWebContentType     = "application/grpc-web"
+	grpcWebTextContentType = "application/grpc-web-text"
+
+	// grpcWebTrailerFlag marks the frame that carries the trailers at the
+	// end of a gRPC-web response body.
+	grpcWebTrailerFlag = 0x80
+)
+
+// isGRPCWebRequest reports whether r is a gRPC-web request, which browsers
+// send over HTTP/1.1 since they cannot use HTTP/2 trailers.
+func isGRPCWebRequest(r *http.Request) bool {
+	return r.Method == http.MethodPost && strings.HasPrefix(r.Header.Get("Content-Type"), grpcWebContentType)
+}
+
+// grpcWebHandler serves gRPC-web requests with a gRPC server, following
+// https://github.com/grpc/grpc/blob/master/doc/PROTOCOL-WEB.md. Requests
+// are translated into gRPC requests, and the gRPC trailers are sent at the
+// end of the response body.
+type grpcWebHandler struct {
+	gs *grpc.Server
+}
+
+func newGRPCWebHandler(gs *grpc.Server) http.Handler {
+	return &grpcWebHandler{gs: gs}
+}
+
+func (h *grpcWebHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ct := r.Header.Get("Content-Type")
+	text := strings.HasPrefix(ct, grpcWebTextContentType)
+
+	req := r.WithContext(r.Context())
+	req.ProtoMajor, req.ProtoMinor, req.Proto = 2, 0, "HTTP/2.0"
+	req.Header = cloneHeader(r.Header)
+	req.Header.Set("Content-Type", "application/grpc"+strings.TrimPrefix(strings.TrimPrefix(ct, grpcWebTextContentType), grpcWebContentType))
+	req.Header.Del("Content-Length")
+	req.ContentLength = -1
+	if text {
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{base64.NewDecoder(base64.StdEncoding, r.Body), r.Body}
+	}
+
+	if text {
+		ct = grpcWebTextContentType + "+proto"
+	} else {
+		ct = grpcWebContentType + "+proto"
+	}
+	ww := &grpcWebResponseWriter{w: w, hdr: make(http.Header), contentType: ct, text: text}
+	h.gs.ServeHTTP(ww, req)
+	ww.finish()
+}
+
+// grpcWebResponseWriter turns a gRPC response into a gRPC-web response.
+type grpcWebResponseWriter struct {
+	w           http.ResponseWriter
+	hdr         http.Header
+	contentType string
+	text        bool
+
+	wroteHeader bool
+	// sent are the header keys sent before the body; keys set later are
+	// trailers.
+	sent map[string]bool
+}
+
+func (ww *grpcWebResponseWriter) Header() http.Header { return ww.hdr }
+
+func (ww *grpcWebResponseWriter) WriteHeader(code int) {
+	if ww.wroteHeader {
+		return
+	}
+	ww.wroteHeader = true
+	ww.sent = make(map[string]bool)
+	h := ww.w.Header()
+	var exposed []string
+	for k, vv := range ww.hdr {
+		if k == "Trailer" || strings.HasPrefix(k, http2.TrailerPrefix) {
+			continue
+		}
+		ww.sent[k] = true
+		h[k] = vv
+		exposed = append(exposed, strings.ToLower(k))
+	}
+	sort.Strings(exposed)
+	h.Set("Content-Type", ww.contentType)
+	h.Set("Access-Control-Expose-Headers", strings.Join(append(exposed, "grpc-status", "grpc-message"), ", "))
+	ww.w.WriteHeader(code)
+}
+
+func (ww *grpcWebResponseWriter) Write(b []byte) (int, error) {
+	if !ww.wroteHeader {
+		ww.WriteHeader(http.StatusOK)
+	}
+	if !ww.text {
+		return ww.w.Write(b)
+	}
+	// each write is encoded on its own, so that streamed messages are not
+	// held back waiting for a full base64 quantum
+	if _, err := ww.w.Write([]byte(base64.StdEncoding.EncodeToString(b))); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (ww *grpcWebResponseWriter) Flush() {
+	if !ww.wroteHeader {
+		ww.WriteHeader(http.StatusOK)
+	}
+	if f, ok := ww.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+func (ww *grpcWebResponseWriter) CloseNotify() <-chan bool {
+	return ww.w.(http.CloseNotifier).CloseNotify()
+}
+
+// finish writes the trailer frame.
+func (ww *grpcWebResponseWriter) finish() {
+	if !ww.wroteHeader {
+		ww.WriteHeader(http.StatusOK)
+	}
+	var keys []string
+	for k := range ww.hdr {
+		if k != "Trailer" && !ww.sent[k] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var tr bytes.Buffer
+	for _, k := range keys {
+		name := strings.ToLower(strings.TrimPrefix(k, http2.TrailerPrefix))
+		for _, v := range ww.hdr[k] {
+			tr.WriteString(name + ": " + v + "\r\n")
+		}
+	}
+	frame := make([]byte, 5, 5+tr.Len())
+	frame[0] = grpcWebTrailerFlag
+	binary.BigEndian.PutUint32(frame[1:], uint32(tr.Len()))
+	ww.Write(append(frame, tr.Bytes()...))
+	ww.Flush()
+}
+
+func cloneHeader(h http.Header) http.Header {
+	h2 := make(http.Header, len(h))
+	for k, vv := range h {
+		h2[k] = append([]string(nil), vv...)
+	}
+	return h2
+}
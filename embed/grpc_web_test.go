@@ -0,0 +1,109 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestGRPCWebHandler(t *testing.T) {
+	gs := grpc.NewServer()
+	healthpb.RegisterHealthServer(gs, health.NewServer())
+	srv := httptest.NewServer(newGRPCWebHandler(gs))
+	defer srv.Close()
+
+	for _, text := range []bool{false, true} {
+		b, err := proto.Marshal(&healthpb.HealthCheckRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		body := append(make([]byte, 5), b...)
+		binary.BigEndian.PutUint32(body[1:], uint32(len(b)))
+		ct := grpcWebContentType + "+proto"
+		if text {
+			body = []byte(base64.StdEncoding.EncodeToString(body))
+			ct = grpcWebTextContentType + "+proto"
+		}
+		resp, err := http.Post(srv.URL+"/grpc.health.v1.Health/Check", ct, bytes.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if g := resp.Header.Get("Content-Type"); g != ct {
+			t.Errorf("text=%v: content type = %q, want %q", text, g, ct)
+		}
+		if text {
+			out = decodeBase64Chunks(t, out)
+		}
+
+		// a message frame followed by the trailer frame
+		if len(out) < 5 || out[0] != 0 {
+			t.Fatalf("text=%v: unexpected response %q", text, out)
+		}
+		n := binary.BigEndian.Uint32(out[1:5])
+		var cr healthpb.HealthCheckResponse
+		if err = proto.Unmarshal(out[5:5+n], &cr); err != nil {
+			t.Fatal(err)
+		}
+		if cr.Status != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("text=%v: status = %v, want SERVING", text, cr.Status)
+		}
+		tr := out[5+n:]
+		if len(tr) < 5 || tr[0] != grpcWebTrailerFlag {
+			t.Fatalf("text=%v: missing trailer frame in %q", text, out)
+		}
+		if !strings.Contains(string(tr[5:]), "grpc-status: 0\r\n") {
+			t.Errorf("text=%v: trailers = %q, want grpc-status 0", text, tr[5:])
+		}
+	}
+}
+
+// decodeBase64Chunks decodes base64 text made of separately padded chunks.
+func decodeBase64Chunks(t *testing.T, b []byte) []byte {
+	var out []byte
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '=')
+		if i < 0 {
+			i = len(b)
+		} else {
+			for i < len(b) && b[i] == '=' {
+				i++
+			}
+		}
+		d, err := base64.StdEncoding.DecodeString(string(b[:i]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, d...)
+		b = b[i:]
+	}
+	return out
+}
@@ -0,0 +1,44 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+)
+
+// RegexpKeyValidator returns a KeyValidator that accepts the keys matching re.
+func RegexpKeyValidator(re *regexp.Regexp) func(key []byte) error {
+	return func(key []byte) error {
+		if !re.Match(key) {
+			return fmt.Errorf("key does not match %q", re.String())
+		}
+		return nil
+	}
+}
+
+// PrefixKeyValidator returns a KeyValidator that accepts the keys under
+// one of the given prefixes.
+func PrefixKeyValidator(prefixes ...string) func(key []byte) error {
+	return func(key []byte) error {
+		for _, p := range prefixes {
+			if bytes.HasPrefix(key, []byte(p)) {
+				return nil
+			}
+		}
+		return fmt.Errorf("key is not under any of the prefixes %q", prefixes)
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestKeyValidators(t *testing.T) {
+	tests := []struct {
+		v   func([]byte) error
+		key string
+		ok  bool
+	}{
+		{RegexpKeyValidator(regexp.MustCompile(`^/[a-z]+/[a-z0-9-]+$`)), "/pods/web-1", true},
+		{RegexpKeyValidator(regexp.MustCompile(`^/[a-z]+/[a-z0-9-]+$`)), "/pods/Web_1", false},
+		{PrefixKeyValidator("/registry/", "/locks/"), "/locks/a", true},
+		{PrefixKeyValidator("/registry/", "/locks/"), "/other/a", false},
+		{PrefixKeyValidator(), "/registry/a", false},
+	}
+	for i, tt := range tests {
+		if err := tt.v([]byte(tt.key)); (err == nil) != tt.ok {
+			t.Errorf("#%d: key %q: err = %v, want ok %v", i, tt.key, err, tt.ok)
+		}
+	}
+}
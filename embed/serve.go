@@ -16,7 +16,9 @@ package embed
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"io"
 	"io/ioutil"
 	defaultLog "log"
 	"net"
@@ -41,6 +43,8 @@ import (
 	"github.com/soheilhy/cmux"
 	"github.com/tmc/grpc-websocket-proxy/wsproxy"
 	"go.uber.org/zap"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
 	"golang.org/x/net/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -53,19 +57,31 @@ type serveCtx struct {
 	network  string
 	secure   bool
 	insecure bool
+	// tlsinfo is the TLS settings of the secure listener.
+	tlsinfo *transport.TLSInfo
 
 	ctx    context.Context
 	cancel context.CancelFunc
 
 	userHandlers    map[string]http.Handler
 	serviceRegister func(*grpc.Server)
-	serversC        chan *servers
+	// grpcWeb is true to serve gRPC-web requests from browsers.
+	grpcWeb bool
+	// ui is true to serve the web UI at uiPath.
+	ui bool
+	// grpcCompress is true to gzip-compress the gRPC responses of the
+	// requests that accept gzip.
+	grpcCompress bool
+	serversC     chan *servers
 }
 
 type servers struct {
 	secure bool
 	grpc   *grpc.Server
-	http   *http.Server
+	// grpcGZIP, if set, serves the gRPC requests accepting
+	// gzip-compressed responses.
+	grpcGZIP *grpc.Server
+	http     *http.Server
 }
 
 func newServeCtx(lg *zap.Logger) *serveCtx {
@@ -95,24 +111,49 @@ func (sctx *serveCtx) serve(
 		plog.Info("ready to serve client requests")
 	}
 
+	if sctx.ui {
+		sctx.registerUI(s)
+	}
+
 	m := cmux.New(sctx.l)
 	v3c := v3client.New(s)
 	servElection := v3election.NewElectionServer(v3c)
 	servLock := v3lock.NewLockServer(v3c)
 
-	var gs *grpc.Server
+	newGRPCServer := func(tlscfg *tls.Config, compress bool) *grpc.Server {
+		opts := gopts
+		if compress {
+			opts = append(append([]grpc.ServerOption{}, gopts...), grpc.RPCCompressor(grpc.NewGZIPCompressor()))
+		}
+		gs := v3rpc.Server(s, tlscfg, opts...)
+		v3electionpb.RegisterElectionServer(gs, servElection)
+		v3lockpb.RegisterLockServer(gs, servLock)
+		if sctx.serviceRegister != nil {
+			sctx.serviceRegister(gs)
+		}
+		return gs
+	}
+
+	// gzs, if set, serves the gRPC requests that accept gzip-compressed
+	// responses, and compresses them
+	var gs, gzs *grpc.Server
 	defer func() {
 		if err != nil && gs != nil {
 			gs.Stop()
 		}
+		if err != nil && gzs != nil {
+			gzs.Stop()
+		}
 	}()
 
 	if sctx.insecure {
-		gs = v3rpc.Server(s, nil, gopts...)
-		v3electionpb.RegisterElectionServer(gs, servElection)
-		v3lockpb.RegisterLockServer(gs, servLock)
-		if sctx.serviceRegister != nil {
-			sctx.serviceRegister(gs)
+		gs = newGRPCServer(nil, false)
+		if sctx.grpcCompress {
+			gzs = newGRPCServer(nil, true)
+			// cmux matches once per connection; clients ask for compression
+			// on every request, so the first one decides
+			gzl := m.MatchWithWriters(http2AcceptsGZIP)
+			go func() { errHandler(gzs.Serve(gzl)) }()
 		}
 		grpcl := m.Match(cmux.HTTP2())
 		go func() { errHandler(gs.Serve(grpcl)) }()
@@ -126,13 +167,13 @@ func (sctx *serveCtx) serve(
 		httpmux := sctx.createMux(gwmux, handler)
 
 		srvhttp := &http.Server{
-			Handler:  createAccessController(sctx.lg, s, httpmux),
+			Handler:  createAccessController(sctx.lg, s, httpmux, sctx.grpcWebHandler(gs)),
 			ErrorLog: logger, // do not log user error
 		}
 		httpl := m.Match(cmux.HTTP1())
 		go func() { errHandler(srvhttp.Serve(httpl)) }()
 
-		sctx.serversC <- &servers{grpc: gs, http: srvhttp}
+		sctx.serversC <- &servers{grpc: gs, grpcGZIP: gzs, http: srvhttp}
 		if sctx.lg != nil {
 			sctx.lg.Info(
 				"serving client traffic insecurely; this is strongly discouraged!",
@@ -148,13 +189,13 @@ func (sctx *serveCtx) serve(
 		if tlsErr != nil {
 			return tlsErr
 		}
-		gs = v3rpc.Server(s, tlscfg, gopts...)
-		v3electionpb.RegisterElectionServer(gs, servElection)
-		v3lockpb.RegisterLockServer(gs, servLock)
-		if sctx.serviceRegister != nil {
-			sctx.serviceRegister(gs)
+		gs = newGRPCServer(tlscfg, false)
+		grpcHandler := http.Handler(gs)
+		if sctx.grpcCompress {
+			gzs = newGRPCServer(tlscfg, true)
+			grpcHandler = grpcGZIPHandler(gs, gzs)
 		}
-		handler = grpcHandlerFunc(gs, handler)
+		handler = grpcHandlerFunc(grpcHandler, handler)
 
 		dtls := tlscfg.Clone()
 		// trust local server
@@ -176,13 +217,13 @@ func (sctx *serveCtx) serve(
 		httpmux := sctx.createMux(gwmux, handler)
 
 		srv := &http.Server{
-			Handler:   createAccessController(sctx.lg, s, httpmux),
+			Handler:   createAccessController(sctx.lg, s, httpmux, sctx.grpcWebHandler(gs)),
 			TLSConfig: tlscfg,
 			ErrorLog:  logger, // do not log user error
 		}
 		go func() { errHandler(srv.Serve(tlsl)) }()
 
-		sctx.serversC <- &servers{secure: true, grpc: gs, http: srv}
+		sctx.serversC <- &servers{secure: true, grpc: gs, grpcGZIP: gzs, http: srv}
 		if sctx.lg != nil {
 			sctx.lg.Info(
 				"serving client traffic insecurely",
@@ -199,7 +240,7 @@ func (sctx *serveCtx) serve(
 
 // grpcHandlerFunc returns an http.Handler that delegates to grpcServer on incoming gRPC
 // connections or otherHandler otherwise. Given in gRPC docs.
-func grpcHandlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Handler {
+func grpcHandlerFunc(grpcServer http.Handler, otherHandler http.Handler) http.Handler {
 	if otherHandler == nil {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			grpcServer.ServeHTTP(w, r)
@@ -214,6 +255,82 @@ func grpcHandlerFunc(grpcServer *grpc.Server, otherHandler http.Handler) http.Ha
 	})
 }
 
+// grpcGZIPHandler returns an http.Handler that serves the gRPC requests
+// accepting gzip-compressed responses with gzs, and the others with gs.
+func grpcGZIPHandler(gs, gzs *grpc.Server) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if acceptsGZIP(r.Header.Get(grpcAcceptEncoding)) {
+			gzs.ServeHTTP(w, r)
+		} else {
+			gs.ServeHTTP(w, r)
+		}
+	})
+}
+
+const grpcAcceptEncoding = "grpc-accept-encoding"
+
+// acceptsGZIP returns true if the grpc-accept-encoding header value v
+// lists gzip.
+func acceptsGZIP(v string) bool {
+	for _, enc := range strings.Split(v, ",") {
+		if strings.TrimSpace(enc) == "gzip" {
+			return true
+		}
+	}
+	return false
+}
+
+// http2AcceptsGZIP matches HTTP/2 connections whose first request accepts
+// gzip-compressed responses. Like cmux.HTTP2MatchHeaderFieldSendSettings,
+// it answers the client SETTINGS, since clients may wait for the server
+// SETTINGS before sending a request.
+func http2AcceptsGZIP(w io.Writer, r io.Reader) bool {
+	var preface [len(http2.ClientPreface)]byte
+	for n := 0; n < len(preface); {
+		m, err := r.Read(preface[n:])
+		if err != nil || string(preface[n:n+m]) != http2.ClientPreface[n:n+m] {
+			return false
+		}
+		n += m
+	}
+
+	accepts := false
+	framer := http2.NewFramer(w, r)
+	hdec := hpack.NewDecoder(4<<10, func(hf hpack.HeaderField) {
+		if hf.Name == grpcAcceptEncoding {
+			accepts = acceptsGZIP(hf.Value)
+		}
+	})
+	for {
+		f, err := framer.ReadFrame()
+		if err != nil {
+			return false
+		}
+		switch f := f.(type) {
+		case *http2.SettingsFrame:
+			if !f.IsAck() {
+				if err = framer.WriteSettings(); err != nil {
+					return false
+				}
+			}
+		case *http2.HeadersFrame:
+			if _, err = hdec.Write(f.HeaderBlockFragment()); err != nil {
+				return false
+			}
+			if f.HeadersEnded() {
+				return accepts
+			}
+		case *http2.ContinuationFrame:
+			if _, err = hdec.Write(f.HeaderBlockFragment()); err != nil {
+				return false
+			}
+			if f.HeadersEnded() {
+				return accepts
+			}
+		}
+	}
+}
+
 type registerHandlerFunc func(context.Context, *gw.ServeMux, *grpc.ClientConn) error
 
 func (sctx *serveCtx) registerGateway(opts []grpc.DialOption) (*gw.ServeMux, error) {
@@ -224,7 +341,6 @@ func (sctx *serveCtx) registerGateway(opts []grpc.DialOption) (*gw.ServeMux, err
 		// explicitly define unix network for gRPC socket support
 		addr = fmt.Sprintf("%s://%s", network, addr)
 	}
-
 	conn, err := grpc.DialContext(ctx, addr, opts...)
 	if err != nil {
 		return nil, err
@@ -289,18 +405,29 @@ func (sctx *serveCtx) createMux(gwmux *gw.ServeMux, handler http.Handler) *http.
 	return httpmux
 }
 
+// grpcWebHandler returns the handler of gRPC-web requests, or nil if
+// gRPC-web is disabled.
+func (sctx *serveCtx) grpcWebHandler(gs *grpc.Server) http.Handler {
+	if !sctx.grpcWeb {
+		return nil
+	}
+	return newGRPCWebHandler(gs)
+}
+
 // createAccessController wraps HTTP multiplexer:
 // - mutate gRPC gateway request paths
 // - check hostname whitelist
+// - serve gRPC-web requests, if enabled
 // client HTTP requests goes here first
-func createAccessController(lg *zap.Logger, s *etcdserver.EtcdServer, mux *http.ServeMux) http.Handler {
-	return &accessController{lg: lg, s: s, mux: mux}
+func createAccessController(lg *zap.Logger, s *etcdserver.EtcdServer, mux *http.ServeMux, grpcWeb http.Handler) http.Handler {
+	return &accessController{lg: lg, s: s, mux: mux, grpcWeb: grpcWeb}
 }
 
 type accessController struct {
-	lg  *zap.Logger
-	s   *etcdserver.EtcdServer
-	mux *http.ServeMux
+	lg      *zap.Logger
+	s       *etcdserver.EtcdServer
+	mux     *http.ServeMux
+	grpcWeb http.Handler
 }
 
 func (ac *accessController) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
@@ -339,6 +466,11 @@ func (ac *accessController) ServeHTTP(rw http.ResponseWriter, req *http.Request)
 		return
 	}
 
+	if ac.grpcWeb != nil && isGRPCWebRequest(req) {
+		ac.grpcWeb.ServeHTTP(rw, req)
+		return
+	}
+
 	ac.mux.ServeHTTP(rw, req)
 }
 
@@ -346,7 +478,7 @@ func (ac *accessController) ServeHTTP(rw http.ResponseWriter, req *http.Request)
 func addCORSHeader(w http.ResponseWriter, origin string) {
 	w.Header().Add("Access-Control-Allow-Methods", "POST, GET, OPTIONS, PUT, DELETE")
 	w.Header().Add("Access-Control-Allow-Origin", origin)
-	w.Header().Add("Access-Control-Allow-Headers", "accept, content-type, authorization")
+	w.Header().Add("Access-Control-Allow-Headers", "accept, content-type, authorization, x-grpc-web, x-user-agent, grpc-timeout")
 }
 
 // https://github.com/transmission/transmission/pull/468
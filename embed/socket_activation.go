@@ -0,0 +1,103 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+
+	"github.com/coreos/etcd/pkg/systemd"
+
+	"go.uber.org/zap"
+)
+
+// activatedListeners holds the listeners passed by systemd socket
+// activation that have not been claimed by a peer or client URL yet.
+type activatedListeners struct {
+	lg  *zap.Logger
+	lns []net.Listener
+}
+
+func newActivatedListeners(lg *zap.Logger) (*activatedListeners, error) {
+	lns, err := systemd.Listeners()
+	if err != nil {
+		return nil, err
+	}
+	for _, ln := range lns {
+		if lg != nil {
+			lg.Info("found socket-activated listener", zap.String("address", ln.Addr().String()))
+		} else {
+			plog.Infof("found socket-activated listener on %s", ln.Addr().String())
+		}
+	}
+	return &activatedListeners{lg: lg, lns: lns}, nil
+}
+
+// take returns and removes the activated listener bound to the address
+// of the given URL, or nil if there is none.
+func (al *activatedListeners) take(u url.URL) net.Listener {
+	if al == nil {
+		return nil
+	}
+	for i, ln := range al.lns {
+		if listenerMatchesURL(ln, u) {
+			al.lns = append(al.lns[:i], al.lns[i+1:]...)
+			return ln
+		}
+	}
+	return nil
+}
+
+// closeUnused closes all activated listeners that no URL claimed.
+func (al *activatedListeners) closeUnused() {
+	if al == nil {
+		return
+	}
+	for _, ln := range al.lns {
+		if al.lg != nil {
+			al.lg.Warn("closing unused socket-activated listener", zap.String("address", ln.Addr().String()))
+		} else {
+			plog.Warningf("closing unused socket-activated listener on %s", ln.Addr().String())
+		}
+		ln.Close()
+	}
+	al.lns = nil
+}
+
+func listenerMatchesURL(ln net.Listener, u url.URL) bool {
+	if u.Scheme == "unix" || u.Scheme == "unixs" {
+		return ln.Addr().Network() == "unix" && ln.Addr().String() == u.Host+u.Path
+	}
+	taddr, ok := ln.Addr().(*net.TCPAddr)
+	if !ok {
+		return false
+	}
+	host, port, err := net.SplitHostPort(u.Host)
+	if err != nil || port != strconv.Itoa(taddr.Port) {
+		return false
+	}
+	if host == "localhost" {
+		return taddr.IP.IsLoopback()
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	if ip.IsUnspecified() {
+		return taddr.IP.IsUnspecified()
+	}
+	return ip.Equal(taddr.IP)
+}
@@ -0,0 +1,58 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"testing"
+)
+
+func TestActivatedListenersTake(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ln.Close()
+	port := strconv.Itoa(ln.Addr().(*net.TCPAddr).Port)
+
+	tests := []struct {
+		u     url.URL
+		match bool
+	}{
+		{url.URL{Scheme: "http", Host: "127.0.0.1:" + port}, true},
+		{url.URL{Scheme: "https", Host: "localhost:" + port}, true},
+		{url.URL{Scheme: "http", Host: "10.0.0.1:" + port}, false},
+		{url.URL{Scheme: "http", Host: "0.0.0.0:" + port}, false},
+		{url.URL{Scheme: "http", Host: "127.0.0.1:1"}, false},
+		{url.URL{Scheme: "unix", Host: "127.0.0.1:" + port}, false},
+	}
+	for i, tt := range tests {
+		al := &activatedListeners{lns: []net.Listener{ln}}
+		got := al.take(tt.u)
+		if (got != nil) != tt.match {
+			t.Errorf("#%d: match = %v, want %v", i, got != nil, tt.match)
+		}
+		if tt.match && len(al.lns) != 0 {
+			t.Errorf("#%d: expected listener to be removed, got %d left", i, len(al.lns))
+		}
+	}
+
+	var al *activatedListeners
+	if got := al.take(tests[0].u); got != nil {
+		t.Fatalf("expected nil listener from nil activatedListeners, got %v", got)
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/coreos/etcd/etcdserver"
+)
+
+const uiPath = "/ui/"
+
+// uiHandler serves a single page that browses keys, shows key history,
+// compacts and watches through the gRPC gateway. The page holds no data of
+// its own; every call it makes carries the token of the user signed in on
+// the page and is checked by the server as usual. Since the page can compact
+// the store, it is only served while auth is enabled.
+func uiHandler(authEnabled func() bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != uiPath {
+			http.NotFound(w, r)
+			return
+		}
+		if !authEnabled() {
+			http.Error(w, "etcd UI requires auth to be enabled", http.StatusForbidden)
+			return
+		}
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("X-Frame-Options", "DENY")
+		io.WriteString(w, uiPage)
+	})
+}
+
+func (sctx *serveCtx) registerUI(s *etcdserver.EtcdServer) {
+	sctx.registerUserHandler(uiPath, uiHandler(s.AuthStore().IsAuthEnabled))
+}
+
+const uiPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>etcd</title>
+<style>
+body { font-family: sans-serif; margin: 1em; }
+section { margin-bottom: 1em; }
+pre { background: #f4f4f4; padding: .5em; max-height: 20em; overflow: auto; }
+li a { cursor: pointer; text-decoration: underline; }
+#error { color: #b00; }
+</style>
+</head>
+<body>
+<h1>etcd</h1>
+<section>
+<input id="user" placeholder="user"> <input id="password" type="password" placeholder="password">
+<button onclick="login()">Sign in</button> <button onclick="logout()">Sign out</button>
+<span id="signedin"></span>
+</section>
+<div id="error"></div>
+<section>
+<h2>Keys</h2>
+<input id="prefix" placeholder="prefix"> <button onclick="browse()">Browse</button>
+<ul id="keys"></ul>
+</section>
+<section>
+<h2>History</h2>
+<pre id="history"></pre>
+</section>
+<section>
+<h2>Compaction</h2>
+<input id="revision" placeholder="revision"> <button onclick="compact()">Compact</button>
+<span id="compacted"></span>
+</section>
+<section>
+<h2>Watch</h2>
+<button onclick="watch()">Watch prefix</button> <button onclick="unwatch()">Stop</button>
+<pre id="events"></pre>
+</section>
+<script>
+var token = sessionStorage.getItem('token') || '';
+var ws = null;
+
+function $(id) { return document.getElementById(id); }
+function enc(s) { return btoa(unescape(encodeURIComponent(s))); }
+function dec(s) { return s ? decodeURIComponent(escape(atob(s))) : ''; }
+
+// prefixEnd returns the base64 range end that covers all keys with prefix p.
+function prefixEnd(p) {
+  var b = unescape(encodeURIComponent(p));
+  for (var i = b.length - 1; i >= 0; i--) {
+    var c = b.charCodeAt(i);
+    if (c < 0xff) {
+      return btoa(b.substring(0, i) + String.fromCharCode(c + 1));
+    }
+  }
+  return btoa('\0');
+}
+
+function call(path, body) {
+  var headers = {'Content-Type': 'application/json'};
+  if (token) { headers['Authorization'] = token; }
+  return fetch('/v3/' + path, {method: 'POST', headers: headers, body: JSON.stringify(body)})
+    .then(function(resp) {
+      return resp.json().then(function(j) {
+        if (!resp.ok || j.error) { throw new Error(j.error || resp.statusText); }
+        $('error').textContent = '';
+        return j;
+      });
+    })
+    .catch(function(err) { $('error').textContent = err.message; throw err; });
+}
+
+function showToken() {
+  $('signedin').textContent = token ? 'signed in' : '';
+  // the WebSocket proxy reads the token from this cookie
+  document.cookie = 'token=' + token + '; path=/v3/; SameSite=Strict' + (token ? '' : '; max-age=0');
+}
+
+function login() {
+  call('auth/authenticate', {name: $('user').value, password: $('password').value}).then(function(j) {
+    token = j.token;
+    sessionStorage.setItem('token', token);
+    $('password').value = '';
+    showToken();
+  });
+}
+
+function logout() {
+  token = '';
+  sessionStorage.removeItem('token');
+  showToken();
+}
+
+function browse() {
+  var p = $('prefix').value;
+  call('kv/range', {key: enc(p), range_end: prefixEnd(p), keys_only: true, limit: 1000}).then(function(j) {
+    var ul = $('keys');
+    ul.innerHTML = '';
+    (j.kvs || []).forEach(function(kv) {
+      var li = document.createElement('li');
+      var a = document.createElement('a');
+      a.textContent = dec(kv.key);
+      a.onclick = function() { history(kv.key); };
+      li.appendChild(a);
+      ul.appendChild(li);
+    });
+    if (j.more) { ul.appendChild(document.createTextNode('(more keys not shown)')); }
+  });
+}
+
+// history walks back through the versions of a key, one range at each
+// earlier revision, until the key did not exist or was compacted.
+function history(key) {
+  var out = $('history');
+  out.textContent = dec(key) + '\n';
+  var step = function(rev, n) {
+    if (n >= 50) { return; }
+    call('kv/range', {key: key, revision: rev}).then(function(j) {
+      if (!j.kvs || !j.kvs.length) { return; }
+      var kv = j.kvs[0];
+      out.textContent += 'mod_revision ' + kv.mod_revision + ' version ' + kv.version + ': ' + dec(kv.value) + '\n';
+      if (kv.mod_revision > 1) { step(kv.mod_revision - 1, n + 1); }
+    });
+  };
+  step(0, 0);
+}
+
+function compact() {
+  var rev = $('revision').value;
+  call('kv/compaction', {revision: rev, physical: true}).then(function() {
+    $('compacted').textContent = 'compacted at revision ' + rev;
+  });
+}
+
+function watch() {
+  unwatch();
+  var p = $('prefix').value;
+  var out = $('events');
+  out.textContent = '';
+  var proto = location.protocol === 'https:' ? 'wss:' : 'ws:';
+  ws = new WebSocket(proto + '//' + location.host + '/v3/watch');
+  ws.onopen = function() { ws.send(JSON.stringify({create_request: {key: enc(p), range_end: prefixEnd(p)}})); };
+  ws.onmessage = function(m) {
+    var j = JSON.parse(m.data);
+    if (j.error) { $('error').textContent = j.error.message || j.error; return; }
+    (j.result.events || []).forEach(function(ev) {
+      out.textContent += (ev.type || 'PUT') + ' ' + dec(ev.kv.key) + ' ' + dec(ev.kv.value) + ' (mod_revision ' + ev.kv.mod_revision + ')\n';
+    });
+  };
+}
+
+function unwatch() {
+  if (ws) { ws.close(); ws = null; }
+}
+
+showToken();
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,51 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package embed
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUIHandler(t *testing.T) {
+	tests := []struct {
+		method string
+		path   string
+		auth   bool
+		code   int
+	}{
+		{http.MethodGet, uiPath, true, http.StatusOK},
+		{http.MethodHead, uiPath, true, http.StatusOK},
+		{http.MethodGet, uiPath, false, http.StatusForbidden},
+		{http.MethodPost, uiPath, true, http.StatusMethodNotAllowed},
+		{http.MethodGet, uiPath + "foo", true, http.StatusNotFound},
+	}
+	for i, tt := range tests {
+		rw := httptest.NewRecorder()
+		authEnabled := func() bool { return tt.auth }
+		uiHandler(authEnabled).ServeHTTP(rw, httptest.NewRequest(tt.method, tt.path, nil))
+		if rw.Code != tt.code {
+			t.Errorf("#%d: code = %d, want %d", i, rw.Code, tt.code)
+		}
+		if tt.code != http.StatusOK {
+			continue
+		}
+		if ct := rw.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/html") {
+			t.Errorf("#%d: content type = %q, want text/html", i, ct)
+		}
+	}
+}
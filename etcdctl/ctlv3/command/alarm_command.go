@@ -30,6 +30,7 @@ func NewAlarmCommand() *cobra.Command {
 
 	ac.AddCommand(NewAlarmDisarmCommand())
 	ac.AddCommand(NewAlarmListCommand())
+	ac.AddCommand(NewAlarmMaintenanceCommand())
 
 	return ac
 }
@@ -79,3 +80,26 @@ func alarmListCommandFunc(cmd *cobra.Command, args []string) {
 	}
 	display.Alarm(*resp)
 }
+
+func NewAlarmMaintenanceCommand() *cobra.Command {
+	cmd := cobra.Command{
+		Use:   "maintenance",
+		Short: "Raises the MAINTENANCE alarm, which rejects writes until it is disarmed or times out",
+		Run:   alarmMaintenanceCommandFunc,
+	}
+	return &cmd
+}
+
+// alarmMaintenanceCommandFunc executes the "alarm maintenance" command.
+func alarmMaintenanceCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) != 0 {
+		ExitWithError(ExitBadArgs, fmt.Errorf("alarm maintenance command accepts no arguments"))
+	}
+	ctx, cancel := commandCtx(cmd)
+	resp, err := mustClientFromCmd(cmd).EnterMaintenanceMode(ctx)
+	cancel()
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	display.Alarm(*resp)
+}
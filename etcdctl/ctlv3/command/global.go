@@ -52,6 +52,7 @@ type GlobalFlags struct {
 
 	OutputFormat string
 	IsHex        bool
+	DecodeK8s    bool
 
 	User     string
 	Password string
@@ -93,6 +94,13 @@ func initDisplayFromCmd(cmd *cobra.Command) {
 	if display = NewPrinter(outputType, isHex); display == nil {
 		ExitWithError(ExitBadFeature, errors.New("unsupported output format"))
 	}
+	decodeK8s, err := cmd.Flags().GetBool("decode-k8s")
+	if err != nil {
+		ExitWithError(ExitError, err)
+	}
+	if dp, simple := display.(*simplePrinter); simple {
+		dp.decodeK8s = decodeK8s
+	}
 }
 
 type clientConfig struct {
@@ -22,31 +22,49 @@ import (
 	v3 "github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/clientv3/snapshot"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/coreos/etcd/pkg/k8sproto"
 	"github.com/coreos/etcd/pkg/types"
 )
 
 type simplePrinter struct {
 	isHex     bool
 	valueOnly bool
+	decodeK8s bool
+}
+
+// decode renders protobuf encoded Kubernetes objects as YAML if enabled.
+// Other values are returned as is.
+func (s *simplePrinter) decode(kv *mvccpb.KeyValue) *mvccpb.KeyValue {
+	if !s.decodeK8s || !k8sproto.IsRegistryKey(kv.Key) || !k8sproto.IsEncoded(kv.Value) {
+		return kv
+	}
+	y, err := k8sproto.ToYAML(kv.Value)
+	if err != nil {
+		return kv
+	}
+	dkv := *kv
+	dkv.Value = y
+	return &dkv
 }
 
 func (s *simplePrinter) Del(resp v3.DeleteResponse) {
 	fmt.Println(resp.Deleted)
 	for _, kv := range resp.PrevKvs {
-		printKV(s.isHex, s.valueOnly, kv)
+		printKV(s.isHex, s.valueOnly, s.decode(kv))
 	}
 }
 
 func (s *simplePrinter) Get(resp v3.GetResponse) {
 	for _, kv := range resp.Kvs {
-		printKV(s.isHex, s.valueOnly, kv)
+		printKV(s.isHex, s.valueOnly, s.decode(kv))
 	}
 }
 
 func (s *simplePrinter) Put(r v3.PutResponse) {
 	fmt.Println("OK")
 	if r.PrevKv != nil {
-		printKV(s.isHex, s.valueOnly, r.PrevKv)
+		printKV(s.isHex, s.valueOnly, s.decode(r.PrevKv))
 	}
 }
 
@@ -76,9 +94,9 @@ func (s *simplePrinter) Watch(resp v3.WatchResponse) {
 	for _, e := range resp.Events {
 		fmt.Println(e.Type)
 		if e.PrevKv != nil {
-			printKV(s.isHex, s.valueOnly, e.PrevKv)
+			printKV(s.isHex, s.valueOnly, s.decode(e.PrevKv))
 		}
-		printKV(s.isHex, s.valueOnly, e.Kv)
+		printKV(s.isHex, s.valueOnly, s.decode(e.Kv))
 	}
 }
 
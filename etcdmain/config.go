@@ -157,9 +157,15 @@ func newConfig() *config {
 	fs.Int64Var(&cfg.ec.QuotaBackendBytes, "quota-backend-bytes", cfg.ec.QuotaBackendBytes, "Raise alarms when backend size exceeds the given quota. 0 means use the default quota.")
 	fs.UintVar(&cfg.ec.MaxTxnOps, "max-txn-ops", cfg.ec.MaxTxnOps, "Maximum number of operations permitted in a transaction.")
 	fs.UintVar(&cfg.ec.MaxRequestBytes, "max-request-bytes", cfg.ec.MaxRequestBytes, "Maximum client request size in bytes the server will accept.")
+	fs.Int64Var(&cfg.ec.MaxRangeLimit, "max-range-limit", cfg.ec.MaxRangeLimit, "Maximum number of keys returned by a range request (0 means no limit).")
+	fs.IntVar(&cfg.ec.MaxWatchers, "max-watchers", cfg.ec.MaxWatchers, "Maximum number of watchers open on the server (0 means no limit).")
+	fs.IntVar(&cfg.ec.MaxWatchersPerConnection, "max-watchers-per-connection", cfg.ec.MaxWatchersPerConnection, "Maximum number of watchers open on a single client connection (0 means no limit).")
+	fs.UintVar(&cfg.ec.GRPCMaxRecvMsgBytes, "grpc-max-recv-msg-bytes", cfg.ec.GRPCMaxRecvMsgBytes, "Maximum gRPC message size in bytes the client server will receive (0 defaults to max-request-bytes plus gRPC overhead).")
+	fs.UintVar(&cfg.ec.GRPCMaxSendMsgBytes, "grpc-max-send-msg-bytes", cfg.ec.GRPCMaxSendMsgBytes, "Maximum gRPC message size in bytes the client server will send (0 defaults to math.MaxInt32).")
 	fs.DurationVar(&cfg.ec.GRPCKeepAliveMinTime, "grpc-keepalive-min-time", cfg.ec.GRPCKeepAliveMinTime, "Minimum interval duration that a client should wait before pinging server.")
 	fs.DurationVar(&cfg.ec.GRPCKeepAliveInterval, "grpc-keepalive-interval", cfg.ec.GRPCKeepAliveInterval, "Frequency duration of server-to-client ping to check if a connection is alive (0 to disable).")
 	fs.DurationVar(&cfg.ec.GRPCKeepAliveTimeout, "grpc-keepalive-timeout", cfg.ec.GRPCKeepAliveTimeout, "Additional duration of wait before closing a non-responsive connection (0 to disable).")
+	fs.BoolVar(&cfg.ec.SocketActivation, "socket-activation", cfg.ec.SocketActivation, "Serve on listeners passed by systemd socket activation whose addresses match the listen URLs.")
 
 	// clustering
 	fs.Var(
@@ -238,11 +244,30 @@ func newConfig() *config {
 	// auth
 	fs.StringVar(&cfg.ec.AuthToken, "auth-token", cfg.ec.AuthToken, "Specify auth token specific options.")
 	fs.UintVar(&cfg.ec.BcryptCost, "bcrypt-cost", cfg.ec.BcryptCost, "Specify bcrypt algorithm cost factor for auth password hashing.")
+	fs.StringVar(&cfg.ec.ClientBearerTokenFile, "client-bearer-token-file", "", "Path to the static and JWT bearer token definitions that client gRPC requests are authorized against.")
 
 	// experimental
 	fs.BoolVar(&cfg.ec.ExperimentalInitialCorruptCheck, "experimental-initial-corrupt-check", cfg.ec.ExperimentalInitialCorruptCheck, "Enable to check data corruption before serving any client/peer traffic.")
 	fs.DurationVar(&cfg.ec.ExperimentalCorruptCheckTime, "experimental-corrupt-check-time", cfg.ec.ExperimentalCorruptCheckTime, "Duration of time between cluster corruption check passes.")
+	fs.DurationVar(&cfg.ec.ExperimentalOrphanLeaseKeyCheckTime, "experimental-orphan-lease-key-check-time", cfg.ec.ExperimentalOrphanLeaseKeyCheckTime, "Duration of time between passes deleting keys attached to missing leases.")
+	fs.BoolVar(&cfg.ec.ExperimentalEnableLeaseCheckpoint, "experimental-enable-lease-checkpoint", false, "Enable to checkpoint lease remaining TTLs so that they survive leader changes and restarts.")
+	fs.DurationVar(&cfg.ec.ExperimentalLeaseCheckpointInterval, "experimental-lease-checkpoint-interval", cfg.ec.ExperimentalLeaseCheckpointInterval, "Duration of time between two lease checkpoints.")
 	fs.StringVar(&cfg.ec.ExperimentalEnableV2V3, "experimental-enable-v2v3", cfg.ec.ExperimentalEnableV2V3, "v3 prefix for serving emulated v2 state.")
+	fs.StringVar(&cfg.ec.ExperimentalRequestLogSampling, "experimental-request-log-sampling", "", "Comma-separated 'method=rate' pairs of the fraction of client requests to log (e.g. 'Range=0.01,*=0.001').")
+	fs.StringVar(&cfg.ec.ExperimentalReportedVersion, "experimental-reported-version", "", "Server version to report on the client /version endpoint instead of the actual version.")
+	fs.StringVar(&cfg.ec.ExperimentalGRPCCompressionURLs, "experimental-grpc-compression-urls", "", "Comma-separated list of listen-client-urls whose gRPC responses are gzip-compressed.")
+	fs.BoolVar(&cfg.ec.ExperimentalEnableGRPCWeb, "experimental-enable-grpc-web", false, "Enable to serve gRPC-web requests from browsers on the client listeners.")
+	fs.IntVar(&cfg.ec.ExperimentalWatchEventHistorySize, "experimental-watch-event-history-size", 0, "Number of recent events kept in memory for watchers catching up on past revisions (0 disables it).")
+	fs.BoolVar(&cfg.ec.ExperimentalRevisionTimes, "experimental-revision-times", false, "Enable to record the commit time of each revision, to look up the revision as of a time.")
+	fs.StringVar(&cfg.ec.ExperimentalMetricsPushURL, "experimental-metrics-push-url", "", "Pushgateway (http://host:port), statsd (statsd://host:port) or DogStatsD (dogstatsd://host:port) URL to push metrics to.")
+	fs.DurationVar(&cfg.ec.ExperimentalMetricsPushInterval, "experimental-metrics-push-interval", cfg.ec.ExperimentalMetricsPushInterval, "Duration of time between metrics pushes.")
+	fs.StringVar(&cfg.ec.ExperimentalMetricsKeyPrefixes, "experimental-metrics-key-prefixes", "", "Comma-separated list of key prefixes that the revisions written, put value sizes and watch event sizes are reported by.")
+	fs.DurationVar(&cfg.ec.ExperimentalQuotaAlertTime, "experimental-quota-alert-time", 0, "Projected time until the backend quota is reached under which a warning is logged (0 disables it).")
+	fs.DurationVar(&cfg.ec.ExperimentalMaintenanceModeTimeout, "experimental-maintenance-mode-timeout", cfg.ec.ExperimentalMaintenanceModeTimeout, "Duration of time the MAINTENANCE alarm rejects writes before it is disarmed (0 keeps it until disarmed).")
+	fs.BoolVar(&cfg.ec.ExperimentalEnableUI, "experimental-enable-ui", false, "Enable to serve a web UI at /ui/ on the client listeners to browse keys, compact and watch, while auth is enabled.")
+	fs.DurationVar(&cfg.ec.ExperimentalCompactionPauseLatency, "experimental-compaction-pause-latency", 0, "Duration of reads and writes above which compaction waits for them to speed up between batches (0 disables it).")
+	fs.DurationVar(&cfg.ec.ExperimentalRangeCacheTTL, "experimental-range-cache-ttl", 0, "Duration of time to cache responses of identical range requests while none of their keys are written (0 disables the cache).")
+	fs.BoolVar(&cfg.ec.ExperimentalClientCertAuthSANUsername, "experimental-client-cert-auth-san-username", false, "Enable to authenticate client certificates without a Common Name as the user named by their first DNS Subject Alternative Name.")
 
 	// unsafe
 	fs.BoolVar(&cfg.ec.ForceNewCluster, "force-new-cluster", false, "Force to create a new one member cluster.")
@@ -73,12 +73,24 @@ Member:
     Maximum number of operations permitted in a transaction.
   --max-request-bytes '1572864'
     Maximum client request size in bytes the server will accept.
+  --max-range-limit '0'
+    Maximum number of keys returned by a range request (0 means no limit).
+  --max-watchers '0'
+    Maximum number of watchers open on the server (0 means no limit).
+  --max-watchers-per-connection '0'
+    Maximum number of watchers open on a single client connection (0 means no limit).
+  --grpc-max-recv-msg-bytes '0'
+    Maximum gRPC message size in bytes the client server will receive (0 defaults to max-request-bytes plus gRPC overhead).
+  --grpc-max-send-msg-bytes '0'
+    Maximum gRPC message size in bytes the client server will send (0 defaults to math.MaxInt32).
   --grpc-keepalive-min-time '5s'
     Minimum duration interval that a client should wait before pinging server.
   --grpc-keepalive-interval '2h'
     Frequency duration of server-to-client ping to check if a connection is alive (0 to disable).
   --grpc-keepalive-timeout '20s'
     Additional duration of wait before closing a non-responsive connection (0 to disable).
+  --socket-activation 'false'
+    Serve on listeners passed by systemd socket activation whose addresses match the listen URLs.
 
 Clustering:
   --initial-advertise-peer-urls 'http://localhost:2380'
@@ -152,6 +164,8 @@ Auth:
     Specify a v3 authentication token type and its options ('simple' or 'jwt').
   --bcrypt-cost ` + fmt.Sprintf("%d", bcrypt.DefaultCost) + `
     Specify the cost / strength of the bcrypt algorithm for hashing auth passwords. Valid values are between ` + fmt.Sprintf("%d", bcrypt.MinCost) + ` and ` + fmt.Sprintf("%d", bcrypt.MaxCost) + `.
+  --client-bearer-token-file ''
+    Path to the static and JWT bearer token definitions that client gRPC requests are authorized against.
 
 Profiling and Monitoring:
   --enable-pprof 'false'
@@ -192,8 +206,44 @@ Experimental feature:
     Enable to check data corruption before serving any client/peer traffic.
   --experimental-corrupt-check-time '0s'
     Duration of time between cluster corruption check passes.
+  --experimental-orphan-lease-key-check-time '0s'
+    Duration of time between passes deleting keys attached to missing leases.
+  --experimental-enable-lease-checkpoint 'false'
+    Enable to checkpoint lease remaining TTLs so that they survive leader changes and restarts.
+  --experimental-lease-checkpoint-interval '5m0s'
+    Duration of time between two lease checkpoints.
   --experimental-enable-v2v3 ''
     Serve v2 requests through the v3 backend under a given prefix.
+  --experimental-request-log-sampling ''
+    Comma-separated 'method=rate' pairs of the fraction of client requests to log (e.g. 'Range=0.01,*=0.001').
+  --experimental-reported-version ''
+    Server version to report on the client /version endpoint instead of the actual version.
+  --experimental-range-cache-ttl '0s'
+    Duration of time to cache responses of identical range requests while none of their keys are written (0 disables the cache).
+  --experimental-grpc-compression-urls ''
+    Comma-separated list of listen-client-urls whose gRPC responses are gzip-compressed for clients that accept gzip.
+  --experimental-enable-grpc-web 'false'
+    Enable to serve gRPC-web requests from browsers on the client listeners.
+  --experimental-enable-ui 'false'
+    Enable to serve a web UI at /ui/ on the client listeners to browse keys, compact and watch, while auth is enabled.
+  --experimental-compaction-pause-latency '0s'
+    Duration of reads and writes above which compaction waits for them to speed up between batches (0 disables it).
+  --experimental-watch-event-history-size '0'
+    Number of recent events kept in memory for watchers catching up on past revisions (0 disables it).
+  --experimental-revision-times 'false'
+    Enable to record the commit time of each revision, to look up the revision as of a time.
+  --experimental-metrics-push-url ''
+    Pushgateway (http://host:port), statsd (statsd://host:port) or DogStatsD (dogstatsd://host:port) URL to push metrics to.
+  --experimental-metrics-push-interval '15s'
+    Duration of time between metrics pushes.
+  --experimental-metrics-key-prefixes ''
+    Comma-separated list of key prefixes that the revisions written, put value sizes and watch event sizes are reported by.
+  --experimental-quota-alert-time '0s'
+    Projected time until the backend quota is reached under which a warning is logged (0 disables it).
+  --experimental-maintenance-mode-timeout '10m0s'
+    Duration of time the MAINTENANCE alarm rejects writes before it is disarmed (0 keeps it until disarmed).
+  --experimental-client-cert-auth-san-username 'false'
+    Enable to authenticate client certificates without a Common Name as the user named by their first DNS Subject Alternative Name.
 
 Unsafe feature:
   --force-new-cluster 'false'
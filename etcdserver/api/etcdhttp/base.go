@@ -52,7 +52,9 @@ func HandleBasic(mux *http.ServeMux, server etcdserver.ServerPeer) {
 	mux.HandleFunc(configPath+"/local/log", logHandleFunc)
 
 	HandleMetricsHealth(mux, server)
-	mux.HandleFunc(versionPath, versionHandler(server.Cluster(), serveVersion))
+	mux.HandleFunc(versionPath, versionHandler(server.Cluster(), func(w http.ResponseWriter, r *http.Request, clusterV string) {
+		serveVersions(w, r, server.ReportedVersion(), clusterV)
+	}))
 }
 
 func versionHandler(c api.Cluster, fn func(http.ResponseWriter, *http.Request, string)) http.HandlerFunc {
@@ -67,11 +69,15 @@ func versionHandler(c api.Cluster, fn func(http.ResponseWriter, *http.Request, s
 }
 
 func serveVersion(w http.ResponseWriter, r *http.Request, clusterV string) {
+	serveVersions(w, r, version.Version, clusterV)
+}
+
+func serveVersions(w http.ResponseWriter, r *http.Request, serverV, clusterV string) {
 	if !allowMethod(w, r, "GET") {
 		return
 	}
 	vs := version.Versions{
-		Server:  version.Version,
+		Server:  serverV,
 		Cluster: clusterV,
 	}
 
@@ -0,0 +1,146 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver"
+	"github.com/coreos/etcd/mvcc"
+)
+
+// PathDebugState is the path of the server state dump. Goroutine stacks
+// are available from pprof under "/debug/pprof/goroutine?debug=2".
+const PathDebugState = "/debug/state"
+
+// PathDebugLeaseEvents is the path of the lease lifecycle event stream.
+const PathDebugLeaseEvents = "/debug/lease/events"
+
+// PathDebugRevision is the path of the lookup of revisions by commit time.
+const PathDebugRevision = "/debug/revision"
+
+// NewDebugStateHandler handles '/debug/state' requests by dumping the
+// current revision, raft indexes, watcher, lease and in-flight txn counts
+// and internal queue depths as JSON.
+func NewDebugStateHandler(s *etcdserver.EtcdServer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		d, err := json.MarshalIndent(s.DebugState(), "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(d)
+	}
+}
+
+// NewDebugLeaseEventsHandler handles '/debug/lease/events' requests by
+// streaming lease grant, renew, expire and revoke events as newline
+// delimited JSON until the client goes away. Events are dropped if the
+// client falls behind.
+func NewDebugLeaseEventsHandler(s *etcdserver.EtcdServer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		evc, cancel := s.LeaseEvents()
+		defer cancel()
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		f, _ := w.(http.Flusher)
+		if f != nil {
+			f.Flush()
+		}
+		enc := json.NewEncoder(w)
+		for {
+			select {
+			case ev := <-evc:
+				if err := enc.Encode(ev); err != nil {
+					return
+				}
+				if f != nil {
+					f.Flush()
+				}
+			case <-r.Context().Done():
+				return
+			case <-s.StopNotify():
+				return
+			}
+		}
+	}
+}
+
+// debugRevision is the response of '/debug/revision' requests.
+type debugRevision struct {
+	Revision int64     `json:"revision"`
+	Time     time.Time `json:"time"`
+}
+
+// NewDebugRevisionHandler handles '/debug/revision' requests, which map
+// between revisions and the times this member committed them at. With
+// '?time=<RFC 3339 time>', it returns the latest revision committed at or
+// before that time, so that the keyspace as of then can be read with a
+// range at that revision; with '?revision=<revision>', it returns the time
+// the revision was committed at.
+func NewDebugRevisionHandler(s *etcdserver.EtcdServer) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !allowMethod(w, r, http.MethodGet) {
+			return
+		}
+		var (
+			dr  debugRevision
+			err error
+		)
+		q := r.URL.Query()
+		switch {
+		case q.Get("revision") != "":
+			if dr.Revision, err = strconv.ParseInt(q.Get("revision"), 10, 64); err != nil {
+				http.Error(w, "invalid revision: "+err.Error(), http.StatusBadRequest)
+				return
+			}
+		case q.Get("time") != "":
+			t, perr := time.Parse(time.RFC3339Nano, q.Get("time"))
+			if perr != nil {
+				http.Error(w, "invalid time: "+perr.Error(), http.StatusBadRequest)
+				return
+			}
+			dr.Revision, err = s.KV().RevisionAt(t)
+		default:
+			http.Error(w, "missing time or revision", http.StatusBadRequest)
+			return
+		}
+		if err == nil {
+			dr.Time, err = s.KV().RevisionTime(dr.Revision)
+		}
+		switch err {
+		case nil:
+		case mvcc.ErrRevisionTimeUnknown, mvcc.ErrRevisionTimesDisabled, mvcc.ErrCompacted, mvcc.ErrFutureRev:
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		default:
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(dr)
+	}
+}
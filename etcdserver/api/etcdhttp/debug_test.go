@@ -0,0 +1,58 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdhttp
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/coreos/etcd/etcdserver"
+)
+
+func TestDebugStateHandler(t *testing.T) {
+	h := NewDebugStateHandler(&etcdserver.EtcdServer{})
+
+	rw := httptest.NewRecorder()
+	h(rw, httptest.NewRequest(http.MethodGet, PathDebugState, nil))
+	if rw.Code != http.StatusOK {
+		t.Fatalf("code = %d, want %d", rw.Code, http.StatusOK)
+	}
+	if ct := rw.HeaderMap.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("content-type header = %s, want %s", ct, "application/json")
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(rw.Body.Bytes(), &state); err != nil {
+		t.Fatal(err)
+	}
+	for _, k := range []string{"revision", "applied-index", "watchers", "in-flight-txns", "channels"} {
+		if _, ok := state[k]; !ok {
+			t.Errorf("missing %q in %s", k, rw.Body.String())
+		}
+	}
+	channels, _ := state["channels"].(map[string]interface{})
+	for _, k := range []string{"apply-pending", "snapshot-messages", "read-states", "expired-leases"} {
+		if _, ok := channels[k]; !ok {
+			t.Errorf("missing channel depth %q in %s", k, rw.Body.String())
+		}
+	}
+
+	rw = httptest.NewRecorder()
+	h(rw, httptest.NewRequest(http.MethodPost, PathDebugState, nil))
+	if rw.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("code = %d, want %d", rw.Code, http.StatusMethodNotAllowed)
+	}
+}
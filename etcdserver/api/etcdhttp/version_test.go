@@ -64,3 +64,19 @@ func TestServeVersionFails(t *testing.T) {
 		}
 	}
 }
+
+func TestServeVersionsReported(t *testing.T) {
+	req, err := http.NewRequest("GET", "", nil)
+	if err != nil {
+		t.Fatalf("error creating request: %v", err)
+	}
+	rw := httptest.NewRecorder()
+	serveVersions(rw, req, "3.5.0", "3.3.0")
+	var vs version.Versions
+	if err = json.Unmarshal(rw.Body.Bytes(), &vs); err != nil {
+		t.Fatal(err)
+	}
+	if vs.Server != "3.5.0" || vs.Cluster != "3.3.0" {
+		t.Fatalf("versions = %+v, want server 3.5.0, cluster 3.3.0", vs)
+	}
+}
@@ -41,6 +41,12 @@ func NewListener(u url.URL, tlsinfo *transport.TLSInfo) (net.Listener, error) {
 	return transport.NewTimeoutListener(u.Host, u.Scheme, tlsinfo, ConnReadTimeout, ConnWriteTimeout)
 }
 
+// NewListenerFromListener returns a listener for raft message transfer
+// that wraps an already opened listener (e.g. from socket activation).
+func NewListenerFromListener(ln net.Listener, u url.URL, tlsinfo *transport.TLSInfo) (net.Listener, error) {
+	return transport.NewTimeoutListenerFromListener(ln, u.Scheme, tlsinfo, ConnReadTimeout, ConnWriteTimeout)
+}
+
 // NewRoundTripper returns a roundTripper used to send requests
 // to rafthttp listener of remote peers.
 func NewRoundTripper(tlsInfo transport.TLSInfo, dialTimeout time.Duration) (http.RoundTripper, error) {
@@ -39,6 +39,7 @@ import (
 	"github.com/coreos/etcd/pkg/testutil"
 	"github.com/coreos/etcd/pkg/types"
 	"github.com/coreos/etcd/raft/raftpb"
+	"github.com/coreos/etcd/version"
 
 	"github.com/coreos/go-semver/semver"
 	"github.com/jonboulle/clockwork"
@@ -104,6 +105,7 @@ func (s *fakeServer) Do(ctx context.Context, r etcdserverpb.Request) (rr etcdser
 	return
 }
 func (s *fakeServer) ClientCertAuthEnabled() bool { return false }
+func (s *fakeServer) ReportedVersion() string     { return version.Version }
 
 type serverRecorder struct {
 	fakeServer
@@ -25,6 +25,7 @@ import (
 	"github.com/coreos/etcd/etcdserver/api/membership"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/pkg/types"
+	"github.com/coreos/etcd/version"
 
 	"github.com/coreos/go-semver/semver"
 	"go.uber.org/zap"
@@ -48,6 +49,7 @@ func NewServer(lg *zap.Logger, c *clientv3.Client, pfx string) etcdserver.Server
 }
 
 func (s *v2v3Server) ClientCertAuthEnabled() bool { return false }
+func (s *v2v3Server) ReportedVersion() string     { return version.Version }
 
 func (s *v2v3Server) LeaseHandler() http.Handler { panic("STUB: lease handler") }
 func (s *v2v3Server) RaftHandler() http.Handler  { panic("STUB: raft handler") }
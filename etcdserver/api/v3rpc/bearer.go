@@ -0,0 +1,289 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/ghodss/yaml"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// BearerTokens authorizes client gRPC requests by a bearer token sent
+// under the "bearer-token" metadata key. It is a lightweight alternative
+// to etcd authentication for deployments that only need to keep unknown
+// clients out. Each token grants access to a set of key prefixes; the
+// empty prefix grants access to the whole keyspace and to the cluster,
+// maintenance and auth APIs, and to the lease APIs that revoke, list or
+// reveal the keys of leases.
+type BearerTokens struct {
+	tokens []bearerToken
+
+	jwtKey    interface{}
+	jwtMethod jwt.SigningMethod
+}
+
+type bearerToken struct {
+	token    []byte
+	prefixes []string
+}
+
+type bearerTokensFile struct {
+	Tokens []struct {
+		Token    string   `json:"token"`
+		Prefixes []string `json:"prefixes"`
+	} `json:"tokens"`
+	JWT *struct {
+		// PublicKeyFile is a PEM encoded RSA or ECDSA public key used
+		// to verify tokens. Tokens carry their allowed key prefixes
+		// in the "prefixes" claim.
+		PublicKeyFile string `json:"public-key-file"`
+		SignMethod    string `json:"sign-method"`
+	} `json:"jwt"`
+}
+
+// LoadBearerTokens reads bearer token definitions from a YAML or JSON file:
+//
+//	tokens:
+//	- token: "s3cr3t"
+//	  prefixes: ["/registry/"]
+//	jwt:
+//	  public-key-file: /etc/etcd/jwt.pub
+//	  sign-method: RS256
+func LoadBearerTokens(path string) (*BearerTokens, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f bearerTokensFile
+	if err = yaml.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("cannot parse bearer token file %q (%v)", path, err)
+	}
+
+	bt := &BearerTokens{}
+	for _, t := range f.Tokens {
+		if t.Token == "" {
+			return nil, fmt.Errorf("empty token in bearer token file %q", path)
+		}
+		bt.tokens = append(bt.tokens, bearerToken{token: []byte(t.Token), prefixes: t.Prefixes})
+	}
+	if f.JWT != nil {
+		if bt.jwtMethod = jwt.GetSigningMethod(f.JWT.SignMethod); bt.jwtMethod == nil {
+			return nil, fmt.Errorf("unknown JWT sign method %q", f.JWT.SignMethod)
+		}
+		kb, kerr := ioutil.ReadFile(f.JWT.PublicKeyFile)
+		if kerr != nil {
+			return nil, kerr
+		}
+		switch bt.jwtMethod.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+			bt.jwtKey, err = jwt.ParseRSAPublicKeyFromPEM(kb)
+		case *jwt.SigningMethodECDSA:
+			bt.jwtKey, err = jwt.ParseECPublicKeyFromPEM(kb)
+		default:
+			err = fmt.Errorf("JWT sign method %q is not asymmetric", f.JWT.SignMethod)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bt, nil
+}
+
+// prefixes returns the key prefixes granted to the token in ctx.
+func (bt *BearerTokens) prefixes(ctx context.Context) ([]string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, rpctypes.ErrGRPCInvalidAuthToken
+	}
+	ts := md[rpctypes.MetadataBearerTokenKey]
+	if len(ts) == 0 {
+		return nil, rpctypes.ErrGRPCInvalidAuthToken
+	}
+	if ps, ok := bt.staticPrefixes([]byte(ts[0])); ok {
+		return ps, nil
+	}
+	if bt.jwtKey == nil {
+		return nil, rpctypes.ErrGRPCInvalidAuthToken
+	}
+
+	parsed, err := jwt.Parse(ts[0], func(t *jwt.Token) (interface{}, error) {
+		if t.Method.Alg() != bt.jwtMethod.Alg() {
+			return nil, fmt.Errorf("unexpected sign method %q", t.Method.Alg())
+		}
+		return bt.jwtKey, nil
+	})
+	if err != nil || !parsed.Valid {
+		return nil, rpctypes.ErrGRPCInvalidAuthToken
+	}
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, rpctypes.ErrGRPCInvalidAuthToken
+	}
+	raw, _ := claims["prefixes"].([]interface{})
+	ps := make([]string, 0, len(raw))
+	for _, p := range raw {
+		if s, ok := p.(string); ok {
+			ps = append(ps, s)
+		}
+	}
+	return ps, nil
+}
+
+// staticPrefixes returns the key prefixes of the static token tok. Every
+// token is compared in constant time, so that response times do not
+// reveal how much of a token matched.
+func (bt *BearerTokens) staticPrefixes(tok []byte) (ps []string, ok bool) {
+	for _, t := range bt.tokens {
+		if subtle.ConstantTimeCompare(t.token, tok) == 1 {
+			ps, ok = t.prefixes, true
+		}
+	}
+	return ps, ok
+}
+
+func (bt *BearerTokens) authorize(ctx context.Context, method string, req interface{}) error {
+	if strings.HasPrefix(method, "/grpc.health.v1.Health/") {
+		return nil
+	}
+	ps, err := bt.prefixes(ctx)
+	if err != nil {
+		return err
+	}
+	switch {
+	case strings.HasPrefix(method, "/etcdserverpb.KV/"), strings.HasPrefix(method, "/etcdserverpb.Watch/"):
+		if method == "/etcdserverpb.KV/Compact" {
+			return checkPrefixes(ps, []byte{0}, []byte{0})
+		}
+		return checkRequestKeys(ps, req)
+	case strings.HasPrefix(method, "/etcdserverpb.Lease/"):
+		return checkLeaseRequest(ps, method, req)
+	}
+	// cluster, maintenance, auth and user registered services
+	return checkPrefixes(ps, []byte{0}, []byte{0})
+}
+
+// checkLeaseRequest allows any token to grant and keep alive leases.
+// Revoking a lease deletes its keys, and listing leases or their keys
+// reveals keys of other prefixes, so these need access to all keys.
+func checkLeaseRequest(ps []string, method string, req interface{}) error {
+	switch method {
+	case "/etcdserverpb.Lease/LeaseGrant", "/etcdserverpb.Lease/LeaseKeepAlive":
+		return nil
+	case "/etcdserverpb.Lease/LeaseTimeToLive":
+		if r, ok := req.(*pb.LeaseTimeToLiveRequest); ok && !r.Keys {
+			return nil
+		}
+	}
+	return checkPrefixes(ps, []byte{0}, []byte{0})
+}
+
+func checkRequestKeys(ps []string, req interface{}) error {
+	switch r := req.(type) {
+	case *pb.RangeRequest:
+		return checkPrefixes(ps, r.Key, r.RangeEnd)
+	case *pb.PutRequest:
+		return checkPrefixes(ps, r.Key, nil)
+	case *pb.DeleteRangeRequest:
+		return checkPrefixes(ps, r.Key, r.RangeEnd)
+	case *pb.TxnRequest:
+		for _, c := range r.Compare {
+			if err := checkPrefixes(ps, c.Key, c.RangeEnd); err != nil {
+				return err
+			}
+		}
+		for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+			for _, op := range ops {
+				var err error
+				switch tv := op.Request.(type) {
+				case *pb.RequestOp_RequestRange:
+					err = checkRequestKeys(ps, tv.RequestRange)
+				case *pb.RequestOp_RequestPut:
+					err = checkRequestKeys(ps, tv.RequestPut)
+				case *pb.RequestOp_RequestDeleteRange:
+					err = checkRequestKeys(ps, tv.RequestDeleteRange)
+				case *pb.RequestOp_RequestTxn:
+					err = checkRequestKeys(ps, tv.RequestTxn)
+				}
+				if err != nil {
+					return err
+				}
+			}
+		}
+	case *pb.WatchRequest:
+		if cr := r.GetCreateRequest(); cr != nil {
+			return checkPrefixes(ps, cr.Key, cr.RangeEnd)
+		}
+	}
+	return nil
+}
+
+// checkPrefixes returns nil if the range [key, end) lies within one of
+// the prefixes. An empty end selects the single key.
+func checkPrefixes(ps []string, key, end []byte) error {
+	for _, p := range ps {
+		if p == "" {
+			return nil
+		}
+		if !bytes.HasPrefix(key, []byte(p)) {
+			continue
+		}
+		if len(end) == 0 {
+			return nil
+		}
+		if pend := prefixEnd([]byte(p)); len(pend) > 0 && !bytes.Equal(end, []byte{0}) && bytes.Compare(end, pend) <= 0 {
+			return nil
+		}
+	}
+	return rpctypes.ErrGRPCPermissionDenied
+}
+
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	// prefix is all 0xff; it extends to the end of the keyspace
+	return nil
+}
+
+// bearerServerStream checks the keys of every message received on a
+// stream, so that watches cannot be created outside granted prefixes.
+type bearerServerStream struct {
+	grpc.ServerStream
+	bt     *BearerTokens
+	method string
+}
+
+func (s *bearerServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return s.bt.authorize(s.Context(), s.method, m)
+}
@@ -0,0 +1,153 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	jwtPubKey  = "../../../integration/fixtures/server.crt"
+	jwtPrivKey = "../../../integration/fixtures/server.key.insecure"
+)
+
+func TestBearerTokensAuthorize(t *testing.T) {
+	f, err := ioutil.TempFile("", "bearer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString(`tokens:
+- token: foo
+  prefixes: ["/foo/"]
+- token: root
+  prefixes: [""]
+`)
+	f.Close()
+
+	bt, err := LoadBearerTokens(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	txn := &pb.TxnRequest{
+		Compare: []*pb.Compare{{Key: []byte("/foo/a")}},
+		Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("/bar/a")}}}},
+	}
+	tests := []struct {
+		token  string
+		method string
+		req    interface{}
+		werr   error
+	}{
+		{"", "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/a")}, rpctypes.ErrGRPCInvalidAuthToken},
+		{"bar", "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/a")}, rpctypes.ErrGRPCInvalidAuthToken},
+		{"", "/grpc.health.v1.Health/Check", nil, nil},
+		{"foo", "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/a")}, nil},
+		{"foo", "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/"), RangeEnd: []byte("/foo0")}, nil},
+		{"foo", "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/"), RangeEnd: []byte("/fop")}, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/"), RangeEnd: []byte{0}}, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.KV/Put", &pb.PutRequest{Key: []byte("/bar")}, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.KV/Txn", txn, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.KV/Compact", &pb.CompactionRequest{Revision: 1}, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.Lease/LeaseGrant", &pb.LeaseGrantRequest{TTL: 5}, nil},
+		{"foo", "/etcdserverpb.Lease/LeaseKeepAlive", &pb.LeaseKeepAliveRequest{ID: 1}, nil},
+		{"foo", "/etcdserverpb.Lease/LeaseTimeToLive", &pb.LeaseTimeToLiveRequest{ID: 1}, nil},
+		{"foo", "/etcdserverpb.Lease/LeaseTimeToLive", &pb.LeaseTimeToLiveRequest{ID: 1, Keys: true}, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.Lease/LeaseRevoke", &pb.LeaseRevokeRequest{ID: 1}, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.Lease/LeaseLeases", &pb.LeaseLeasesRequest{}, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.Maintenance/Status", &pb.StatusRequest{}, rpctypes.ErrGRPCPermissionDenied},
+		{"foo", "/etcdserverpb.Watch/Watch", &pb.WatchRequest{RequestUnion: &pb.WatchRequest_CreateRequest{CreateRequest: &pb.WatchCreateRequest{Key: []byte("/bar")}}}, rpctypes.ErrGRPCPermissionDenied},
+		{"root", "/etcdserverpb.KV/Txn", txn, nil},
+		{"root", "/etcdserverpb.Maintenance/Status", &pb.StatusRequest{}, nil},
+		{"root", "/etcdserverpb.Lease/LeaseRevoke", &pb.LeaseRevokeRequest{ID: 1}, nil},
+		{"root", "/etcdserverpb.Lease/LeaseTimeToLive", &pb.LeaseTimeToLiveRequest{ID: 1, Keys: true}, nil},
+	}
+	for i, tt := range tests {
+		ctx := context.Background()
+		if tt.token != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(rpctypes.MetadataBearerTokenKey, tt.token))
+		}
+		if err := bt.authorize(ctx, tt.method, tt.req); err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+	}
+}
+
+func TestBearerTokensAuthorizeJWT(t *testing.T) {
+	f, err := ioutil.TempFile("", "bearer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("jwt:\n  public-key-file: " + jwtPubKey + "\n  sign-method: RS256\n")
+	f.Close()
+
+	bt, err := LoadBearerTokens(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	kb, err := ioutil.ReadFile(jwtPrivKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := jwt.ParseRSAPrivateKeyFromPEM(kb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sign := func(m jwt.SigningMethod, k interface{}, prefixes ...interface{}) string {
+		tok, serr := jwt.NewWithClaims(m, jwt.MapClaims{"prefixes": prefixes}).SignedString(k)
+		if serr != nil {
+			t.Fatal(serr)
+		}
+		return tok
+	}
+	foo := sign(jwt.SigningMethodRS256, key, "/foo/")
+	root := sign(jwt.SigningMethodRS256, key, "")
+	// a token signed with the public key as an HMAC secret must not
+	// verify against the public key
+	hmac := sign(jwt.SigningMethodHS256, kb, "")
+
+	tests := []struct {
+		token  string
+		method string
+		req    interface{}
+		werr   error
+	}{
+		{foo, "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/a")}, nil},
+		{foo, "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/bar/a")}, rpctypes.ErrGRPCPermissionDenied},
+		{foo, "/etcdserverpb.Lease/LeaseRevoke", &pb.LeaseRevokeRequest{ID: 1}, rpctypes.ErrGRPCPermissionDenied},
+		{root, "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/bar/a")}, nil},
+		{root, "/etcdserverpb.Lease/LeaseRevoke", &pb.LeaseRevokeRequest{ID: 1}, nil},
+		{foo[:len(foo)-2], "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/a")}, rpctypes.ErrGRPCInvalidAuthToken},
+		{hmac, "/etcdserverpb.KV/Range", &pb.RangeRequest{Key: []byte("/foo/a")}, rpctypes.ErrGRPCInvalidAuthToken},
+	}
+	for i, tt := range tests {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(rpctypes.MetadataBearerTokenKey, tt.token))
+		if err := bt.authorize(ctx, tt.method, tt.req); err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+		}
+	}
+}
@@ -25,10 +25,10 @@ import (
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 
 	"github.com/grpc-ecosystem/go-grpc-prometheus"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
-	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
@@ -44,13 +44,27 @@ var grpclogOnce sync.Once
 func Server(s *etcdserver.EtcdServer, tls *tls.Config, gopts ...grpc.ServerOption) *grpc.Server {
 	var opts []grpc.ServerOption
 	opts = append(opts, grpc.CustomCodec(&codec{}))
+	// accept compressed requests; responses are only compressed for the
+	// clients that accept gzip, on the listeners configured to
+	opts = append(opts, grpc.RPCDecompressor(grpc.NewGZIPDecompressor()))
 	if tls != nil {
 		opts = append(opts, grpc.Creds(credentials.NewTLS(tls)))
 	}
-	opts = append(opts, grpc.UnaryInterceptor(newUnaryInterceptor(s)))
-	opts = append(opts, grpc.StreamInterceptor(newStreamInterceptor(s)))
-	opts = append(opts, grpc.MaxRecvMsgSize(int(s.Cfg.MaxRequestBytes+grpcOverheadBytes)))
-	opts = append(opts, grpc.MaxSendMsgSize(maxSendBytes))
+	var bt *BearerTokens
+	if s.Cfg.ClientBearerTokenFile != "" {
+		var err error
+		if bt, err = LoadBearerTokens(s.Cfg.ClientBearerTokenFile); err != nil {
+			if lg := s.Cfg.Logger; lg != nil {
+				lg.Fatal("failed to load bearer tokens", zap.String("path", s.Cfg.ClientBearerTokenFile), zap.Error(err))
+			} else {
+				plog.Fatalf("failed to load bearer tokens from %q (%v)", s.Cfg.ClientBearerTokenFile, err)
+			}
+		}
+	}
+	opts = append(opts, grpc.UnaryInterceptor(newUnaryInterceptor(s, bt)))
+	opts = append(opts, grpc.StreamInterceptor(newStreamInterceptor(s, bt)))
+	opts = append(opts, grpc.MaxRecvMsgSize(maxRecvMsgSize(s.Cfg)))
+	opts = append(opts, grpc.MaxSendMsgSize(maxSendMsgSize(s.Cfg)))
 	opts = append(opts, grpc.MaxConcurrentStreams(maxStreams))
 	grpcServer := grpc.NewServer(append(opts, gopts...)...)
 
@@ -64,9 +78,7 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, gopts ...grpc.ServerOptio
 	// server should register all the services manually
 	// use empty service name for all etcd services' health status,
 	// see https://github.com/grpc/grpc/blob/master/doc/health-checking.md for more
-	hsrv := health.NewServer()
-	hsrv.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
-	healthpb.RegisterHealthServer(grpcServer, hsrv)
+	healthpb.RegisterHealthServer(grpcServer, serverHealth(s))
 
 	// set zero values for metrics registered for this grpc server
 	grpc_prometheus.Register(grpcServer)
@@ -84,3 +96,17 @@ func Server(s *etcdserver.EtcdServer, tls *tls.Config, gopts ...grpc.ServerOptio
 
 	return grpcServer
 }
+
+func maxRecvMsgSize(cfg etcdserver.ServerConfig) int {
+	if cfg.GRPCMaxRecvMsgBytes > 0 {
+		return int(cfg.GRPCMaxRecvMsgBytes)
+	}
+	return int(cfg.MaxRequestBytes + grpcOverheadBytes)
+}
+
+func maxSendMsgSize(cfg etcdserver.ServerConfig) int {
+	if cfg.GRPCMaxSendMsgBytes > 0 {
+		return int(cfg.GRPCMaxSendMsgBytes)
+	}
+	return maxSendBytes
+}
@@ -0,0 +1,140 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/etcd/etcdserver"
+	"github.com/coreos/etcd/raft"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// healthCheckInterval is how often the serving status of the gRPC health
+// service is refreshed.
+var healthCheckInterval = time.Second
+
+var (
+	healthServersMu sync.Mutex
+	// healthServers holds the health server of each etcd server so that
+	// all gRPC servers of one etcd server share a single monitor.
+	healthServers = make(map[*etcdserver.EtcdServer]*healthServer)
+)
+
+// healthServer implements the gRPC health checking protocol. The empty
+// service name reports the health of all etcd services.
+type healthServer struct {
+	mu     sync.RWMutex
+	status healthpb.HealthCheckResponse_ServingStatus
+}
+
+func newHealthServer() *healthServer {
+	return &healthServer{status: healthpb.HealthCheckResponse_SERVING}
+}
+
+// serverHealth returns the health server of s, starting its monitor
+// on the first call.
+func serverHealth(s *etcdserver.EtcdServer) *healthServer {
+	healthServersMu.Lock()
+	defer healthServersMu.Unlock()
+	if hsrv, ok := healthServers[s]; ok {
+		return hsrv
+	}
+	hsrv := newHealthServer()
+	healthServers[s] = hsrv
+	s.GoAttach(func() {
+		monitorHealth(s, hsrv)
+		healthServersMu.Lock()
+		delete(healthServers, s)
+		healthServersMu.Unlock()
+	})
+	return hsrv
+}
+
+func (hs *healthServer) Check(ctx context.Context, r *healthpb.HealthCheckRequest) (*healthpb.HealthCheckResponse, error) {
+	if r.Service != "" {
+		return nil, status.Error(codes.NotFound, "unknown service")
+	}
+	hs.mu.RLock()
+	defer hs.mu.RUnlock()
+	return &healthpb.HealthCheckResponse{Status: hs.status}, nil
+}
+
+func (hs *healthServer) setStatus(st healthpb.HealthCheckResponse_ServingStatus) {
+	hs.mu.Lock()
+	hs.status = st
+	hs.mu.Unlock()
+}
+
+// monitorHealth updates the serving status of hsrv until the server stops.
+// The server is NOT_SERVING while an alarm is raised, there is no leader,
+// or the backend cannot be read.
+func monitorHealth(s *etcdserver.EtcdServer, hsrv *healthServer) {
+	lg := s.Cfg.Logger
+	serving := true
+	t := time.NewTicker(healthCheckInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+		case <-s.StoppingNotify():
+			hsrv.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+			return
+		}
+
+		err := checkHealth(s)
+		if (err == nil) == serving {
+			continue
+		}
+		serving = err == nil
+		if serving {
+			hsrv.setStatus(healthpb.HealthCheckResponse_SERVING)
+			if lg != nil {
+				lg.Info("gRPC health status changed to SERVING")
+			} else {
+				plog.Infof("gRPC health status changed to SERVING")
+			}
+			continue
+		}
+		hsrv.setStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+		if lg != nil {
+			lg.Warn("gRPC health status changed to NOT_SERVING", zap.Error(err))
+		} else {
+			plog.Warningf("gRPC health status changed to NOT_SERVING (%v)", err)
+		}
+	}
+}
+
+func checkHealth(s *etcdserver.EtcdServer) error {
+	if as := s.Alarms(); len(as) > 0 {
+		return fmt.Errorf("alarm %v raised", as[0].Alarm)
+	}
+	if uint64(s.Leader()) == raft.None {
+		return etcdserver.ErrNoLeader
+	}
+	// read through to bolt; a range on the key bucket may be served
+	// from the read buffer without touching the disk
+	tx := s.Backend().ReadTx()
+	tx.Lock()
+	defer tx.Unlock()
+	return tx.UnsafeForEach([]byte("meta"), func(k, v []byte) error { return nil })
+}
@@ -39,12 +39,20 @@ type streamsMap struct {
 	streams map[grpc.ServerStream]struct{}
 }
 
-func newUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
+func newUnaryInterceptor(s *etcdserver.EtcdServer, bt *BearerTokens) grpc.UnaryServerInterceptor {
+	rl := newRequestLogger(s.Cfg.Logger, s.Cfg.RequestLogSampleRates)
+
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		if !api.IsCapabilityEnabled(api.V3rpcCapability) {
 			return nil, rpctypes.ErrGRPCNotCapable
 		}
 
+		if bt != nil {
+			if err = bt.authorize(ctx, info.FullMethod, req); err != nil {
+				return nil, err
+			}
+		}
+
 		md, ok := metadata.FromIncomingContext(ctx)
 		if ok {
 			if ks := md[rpctypes.MetadataRequireLeaderKey]; len(ks) > 0 && ks[0] == rpctypes.MetadataHasLeader {
@@ -54,11 +62,17 @@ func newUnaryInterceptor(s *etcdserver.EtcdServer) grpc.UnaryServerInterceptor {
 			}
 		}
 
+		if rl != nil && rl.sampled(info.FullMethod) {
+			start := time.Now()
+			resp, err = prometheus.UnaryServerInterceptor(ctx, req, info, handler)
+			rl.log(ctx, info, start, req, resp, err)
+			return resp, err
+		}
 		return prometheus.UnaryServerInterceptor(ctx, req, info, handler)
 	}
 }
 
-func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor {
+func newStreamInterceptor(s *etcdserver.EtcdServer, bt *BearerTokens) grpc.StreamServerInterceptor {
 	smap := monitorLeader(s)
 
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
@@ -66,6 +80,13 @@ func newStreamInterceptor(s *etcdserver.EtcdServer) grpc.StreamServerInterceptor
 			return rpctypes.ErrGRPCNotCapable
 		}
 
+		if bt != nil {
+			if err := bt.authorize(ss.Context(), info.FullMethod, nil); err != nil {
+				return err
+			}
+			ss = &bearerServerStream{ServerStream: ss, bt: bt, method: info.FullMethod}
+		}
+
 		md, ok := metadata.FromIncomingContext(ss.Context())
 		if ok {
 			if ks := md[rpctypes.MetadataRequireLeaderKey]; len(ks) > 0 && ks[0] == rpctypes.MetadataHasLeader {
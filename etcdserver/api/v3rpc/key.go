@@ -38,16 +38,20 @@ type kvServer struct {
 	// Txn.Success can have at most 128 operations,
 	// and Txn.Failure can have at most 128 operations.
 	maxTxnOps uint
+	// maxRangeLimit caps the number of keys returned by a range; 0 means
+	// no cap.
+	maxRangeLimit int64
 }
 
 func NewKVServer(s *etcdserver.EtcdServer) pb.KVServer {
-	return &kvServer{hdr: newHeader(s), kv: s, maxTxnOps: s.Cfg.MaxTxnOps}
+	return &kvServer{hdr: newHeader(s), kv: s, maxTxnOps: s.Cfg.MaxTxnOps, maxRangeLimit: s.Cfg.MaxRangeLimit}
 }
 
 func (s *kvServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeResponse, error) {
 	if err := checkRangeRequest(r); err != nil {
 		return nil, err
 	}
+	limitRange(r, s.maxRangeLimit)
 
 	resp, err := s.kv.Range(ctx, r)
 	if err != nil {
@@ -97,6 +101,7 @@ func (s *kvServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse,
 	if _, _, err := checkIntervals(r.Failure); err != nil {
 		return nil, err
 	}
+	limitTxnRanges(r, s.maxRangeLimit)
 
 	resp, err := s.kv.Txn(ctx, r)
 	if err != nil {
@@ -124,6 +129,37 @@ func checkRangeRequest(r *pb.RangeRequest) error {
 	return nil
 }
 
+// limitRange caps the limit of a range request at max, so the response is
+// truncated with "more" set instead of scanning the whole range.
+func limitRange(r *pb.RangeRequest, max int64) {
+	if max <= 0 || r.CountOnly {
+		return
+	}
+	if r.Limit <= 0 || r.Limit > max {
+		r.Limit = max
+	}
+}
+
+func limitTxnRanges(r *pb.TxnRequest, max int64) {
+	if max <= 0 {
+		return
+	}
+	for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+		for _, op := range ops {
+			switch tv := op.Request.(type) {
+			case *pb.RequestOp_RequestRange:
+				if tv.RequestRange != nil {
+					limitRange(tv.RequestRange, max)
+				}
+			case *pb.RequestOp_RequestTxn:
+				if tv.RequestTxn != nil {
+					limitTxnRanges(tv.RequestTxn, max)
+				}
+			}
+		}
+	}
+}
+
 func checkPutRequest(r *pb.PutRequest) error {
 	if len(r.Key) == 0 {
 		return rpctypes.ErrGRPCEmptyKey
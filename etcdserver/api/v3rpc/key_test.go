@@ -0,0 +1,56 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"testing"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+func TestLimitRange(t *testing.T) {
+	tests := []struct {
+		r   pb.RangeRequest
+		max int64
+		w   int64
+	}{
+		{pb.RangeRequest{}, 0, 0},
+		{pb.RangeRequest{}, 10, 10},
+		{pb.RangeRequest{Limit: 5}, 10, 5},
+		{pb.RangeRequest{Limit: 50}, 10, 10},
+		{pb.RangeRequest{CountOnly: true}, 10, 0},
+	}
+	for i, tt := range tests {
+		limitRange(&tt.r, tt.max)
+		if tt.r.Limit != tt.w {
+			t.Errorf("#%d: limit = %d, want %d", i, tt.r.Limit, tt.w)
+		}
+	}
+}
+
+func TestLimitTxnRanges(t *testing.T) {
+	inner := &pb.RangeRequest{Key: []byte("b")}
+	outer := &pb.RangeRequest{Key: []byte("a"), Limit: 100}
+	r := &pb.TxnRequest{
+		Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: outer}}},
+		Failure: []*pb.RequestOp{{Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{
+			Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: inner}}},
+		}}}},
+	}
+	limitTxnRanges(r, 10)
+	if outer.Limit != 10 || inner.Limit != 10 {
+		t.Errorf("limits = %d, %d, want 10, 10", outer.Limit, inner.Limit)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"io"
+	"time"
 
 	"github.com/coreos/etcd/auth"
 	"github.com/coreos/etcd/etcdserver"
@@ -50,6 +51,10 @@ type LeaderTransferrer interface {
 	MoveLeader(ctx context.Context, lead, target uint64) error
 }
 
+type CompactionConfigurer interface {
+	CompactionConfig(ctx context.Context, r *pb.CompactionConfigRequest) (*pb.CompactionConfigResponse, error)
+}
+
 type AuthGetter interface {
 	AuthInfoFromCtx(ctx context.Context) (*auth.AuthInfo, error)
 	AuthStore() auth.AuthStore
@@ -62,11 +67,12 @@ type maintenanceServer struct {
 	bg  BackendGetter
 	a   Alarmer
 	lt  LeaderTransferrer
+	cc  CompactionConfigurer
 	hdr header
 }
 
 func NewMaintenanceServer(s *etcdserver.EtcdServer) pb.MaintenanceServer {
-	srv := &maintenanceServer{lg: s.Cfg.Logger, rg: s, kg: s, bg: s, a: s, lt: s, hdr: newHeader(s)}
+	srv := &maintenanceServer{lg: s.Cfg.Logger, rg: s, kg: s, bg: s, a: s, lt: s, cc: s, hdr: newHeader(s)}
 	return &authMaintenanceServer{srv, s}
 }
 
@@ -163,10 +169,37 @@ func (ms *maintenanceServer) HashKV(ctx context.Context, r *pb.HashKVRequest) (*
 	return resp, nil
 }
 
+func (ms *maintenanceServer) RevisionTime(ctx context.Context, r *pb.RevisionTimeRequest) (*pb.RevisionTimeResponse, error) {
+	kv := ms.kg.KV()
+	rev := r.Revision
+	if rev == 0 {
+		var err error
+		if rev, err = kv.RevisionAt(time.Unix(0, r.Time)); err != nil {
+			return nil, togRPCError(err)
+		}
+	}
+	t, err := kv.RevisionTime(rev)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+
+	resp := &pb.RevisionTimeResponse{Header: &pb.ResponseHeader{}, Revision: rev, Time: t.UnixNano()}
+	ms.hdr.fill(resp.Header)
+	return resp, nil
+}
+
 func (ms *maintenanceServer) Alarm(ctx context.Context, ar *pb.AlarmRequest) (*pb.AlarmResponse, error) {
 	return ms.a.Alarm(ctx, ar)
 }
 
+func (ms *maintenanceServer) CompactionConfig(ctx context.Context, r *pb.CompactionConfigRequest) (*pb.CompactionConfigResponse, error) {
+	resp, err := ms.cc.CompactionConfig(ctx, r)
+	if err != nil {
+		return nil, togRPCError(err)
+	}
+	return resp, nil
+}
+
 func (ms *maintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (*pb.StatusResponse, error) {
 	hdr := &pb.ResponseHeader{}
 	ms.hdr.fill(hdr)
@@ -245,6 +278,30 @@ func (ams *authMaintenanceServer) HashKV(ctx context.Context, r *pb.HashKVReques
 	return ams.maintenanceServer.HashKV(ctx, r)
 }
 
+func (ams *authMaintenanceServer) Alarm(ctx context.Context, ar *pb.AlarmRequest) (*pb.AlarmResponse, error) {
+	// maintenance mode rejects writes cluster-wide, so only admins may enter it
+	if ar.Action == pb.AlarmRequest_ACTIVATE && ar.Alarm == pb.AlarmType_MAINTENANCE {
+		if err := ams.isAuthenticated(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return ams.maintenanceServer.Alarm(ctx, ar)
+}
+
+func (ams *authMaintenanceServer) CompactionConfig(ctx context.Context, r *pb.CompactionConfigRequest) (*pb.CompactionConfigResponse, error) {
+	// reading the configuration is allowed to everyone, like Status
+	if r.Mode != "" {
+		if err := ams.isAuthenticated(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return ams.maintenanceServer.CompactionConfig(ctx, r)
+}
+
+func (ams *authMaintenanceServer) RevisionTime(ctx context.Context, r *pb.RevisionTimeRequest) (*pb.RevisionTimeResponse, error) {
+	return ams.maintenanceServer.RevisionTime(ctx, r)
+}
+
 func (ams *authMaintenanceServer) Status(ctx context.Context, ar *pb.StatusRequest) (*pb.StatusResponse, error) {
 	return ams.maintenanceServer.Status(ctx, ar)
 }
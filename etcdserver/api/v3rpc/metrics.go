@@ -14,7 +14,14 @@
 
 package v3rpc
 
-import "github.com/prometheus/client_golang/prometheus"
+import (
+	"sync/atomic"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/coreos/etcd/pkg/k8sproto"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
 
 var (
 	sentBytes = prometheus.NewCounter(prometheus.CounterOpts{
@@ -39,10 +46,54 @@ var (
 	},
 		[]string{"Type", "API"},
 	)
+
+	watchResourceEventsSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "grpc",
+		Name:      "watch_resource_events_sent_total",
+		Help:      "The total number of watch events sent on keys under /registry/, by Kubernetes resource type.",
+	},
+		[]string{"resource"},
+	)
+
+	watchResourceBytesSent = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "grpc",
+		Name:      "watch_resource_event_bytes_sent_total",
+		Help:      "The total size of watch events sent on keys under /registry/, by Kubernetes resource type.",
+	},
+		[]string{"resource"},
+	)
+
+	// resourceWatchMetrics is non-zero if watch traffic is reported
+	// per Kubernetes resource type.
+	resourceWatchMetrics int32
 )
 
+// EnableResourceWatchMetrics reports the watch events sent on keys under
+// the Kubernetes registry prefix by resource type.
+func EnableResourceWatchMetrics() {
+	atomic.StoreInt32(&resourceWatchMetrics, 1)
+}
+
+func reportResourceWatchEvents(evs []*mvccpb.Event) {
+	if atomic.LoadInt32(&resourceWatchMetrics) == 0 {
+		return
+	}
+	for _, ev := range evs {
+		res, ok := k8sproto.Resource(ev.Kv.Key)
+		if !ok {
+			continue
+		}
+		watchResourceEventsSent.WithLabelValues(res).Inc()
+		watchResourceBytesSent.WithLabelValues(res).Add(float64(ev.Size()))
+	}
+}
+
 func init() {
 	prometheus.MustRegister(sentBytes)
 	prometheus.MustRegister(receivedBytes)
 	prometheus.MustRegister(streamFailures)
+	prometheus.MustRegister(watchResourceEventsSent)
+	prometheus.MustRegister(watchResourceBytesSent)
 }
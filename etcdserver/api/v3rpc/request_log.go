@@ -0,0 +1,131 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"math/rand"
+	"path"
+	"strings"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// requestLogger logs a sample of unary client requests. Sampling rates
+// are keyed by the short method name (e.g. "Range"); "*" is the rate of
+// methods without their own entry.
+type requestLogger struct {
+	lg    *zap.Logger
+	rates map[string]float64
+}
+
+func newRequestLogger(lg *zap.Logger, rates map[string]float64) *requestLogger {
+	if len(rates) == 0 {
+		return nil
+	}
+	return &requestLogger{lg: lg, rates: rates}
+}
+
+func (rl *requestLogger) sampled(fullMethod string) bool {
+	rate, ok := rl.rates[path.Base(fullMethod)]
+	if !ok {
+		rate = rl.rates["*"]
+	}
+	return rate >= 1 || (rate > 0 && rand.Float64() < rate)
+}
+
+func (rl *requestLogger) log(ctx context.Context, info *grpc.UnaryServerInfo, start time.Time, req, resp interface{}, err error) {
+	remote := ""
+	if p, ok := peer.FromContext(ctx); ok {
+		remote = p.Addr.String()
+	}
+	key, count, size := keyPrefix(requestKey(req)), int64(0), 0
+	// a failed handler returns a typed nil response
+	if err == nil {
+		if r, ok := resp.(*pb.RangeResponse); ok && r != nil {
+			count = r.Count
+		}
+		if m, ok := resp.(interface{ Size() int }); ok {
+			size = m.Size()
+		}
+	}
+	took := time.Since(start)
+
+	if rl.lg != nil {
+		rl.lg.Info(
+			"request",
+			zap.String("method", info.FullMethod),
+			zap.String("remote", remote),
+			zap.String("key-prefix", key),
+			zap.Duration("took", took),
+			zap.Int64("count", count),
+			zap.Int("response-size", size),
+			zap.Error(err),
+		)
+	} else {
+		plog.Infof("request %s from %s (key prefix %q, took %v, count %d, response size %d, error %v)", info.FullMethod, remote, key, took, count, size, err)
+	}
+}
+
+// maxKeyPrefixLen bounds the length of the logged key prefixes.
+const maxKeyPrefixLen = 64
+
+// keyPrefix returns the key up to and including its last '/', at most
+// maxKeyPrefixLen bytes long, so that the logs carry where a request went
+// but not the full key.
+func keyPrefix(key string) string {
+	key = key[:strings.LastIndex(key, "/")+1]
+	if len(key) > maxKeyPrefixLen {
+		key = key[:maxKeyPrefixLen]
+	}
+	return key
+}
+
+// requestKey returns the key a request starts at, or the first key of a
+// transaction's comparisons or operations.
+func requestKey(req interface{}) string {
+	switch r := req.(type) {
+	case *pb.RangeRequest:
+		return string(r.Key)
+	case *pb.PutRequest:
+		return string(r.Key)
+	case *pb.DeleteRangeRequest:
+		return string(r.Key)
+	case *pb.TxnRequest:
+		if len(r.Compare) > 0 {
+			return string(r.Compare[0].Key)
+		}
+		for _, ops := range [][]*pb.RequestOp{r.Success, r.Failure} {
+			for _, op := range ops {
+				switch tv := op.Request.(type) {
+				case *pb.RequestOp_RequestRange:
+					return requestKey(tv.RequestRange)
+				case *pb.RequestOp_RequestPut:
+					return requestKey(tv.RequestPut)
+				case *pb.RequestOp_RequestDeleteRange:
+					return requestKey(tv.RequestDeleteRange)
+				case *pb.RequestOp_RequestTxn:
+					return requestKey(tv.RequestTxn)
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,116 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v3rpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+)
+
+func TestRequestLoggerSampled(t *testing.T) {
+	if rl := newRequestLogger(nil, nil); rl != nil {
+		t.Fatalf("expected no request logger without rates, got %+v", rl)
+	}
+
+	rl := newRequestLogger(nil, map[string]float64{"Range": 1, "Put": 0, "Txn": 0.5, "*": 0})
+	tests := []struct {
+		method string
+		wmin   int
+		wmax   int
+	}{
+		{"/etcdserverpb.KV/Range", 1000, 1000},
+		{"/etcdserverpb.KV/Put", 0, 0},
+		// unlisted methods take the rate of "*"
+		{"/etcdserverpb.KV/DeleteRange", 0, 0},
+		{"/etcdserverpb.KV/Txn", 350, 650},
+	}
+	for i, tt := range tests {
+		n := 0
+		for j := 0; j < 1000; j++ {
+			if rl.sampled(tt.method) {
+				n++
+			}
+		}
+		if n < tt.wmin || n > tt.wmax {
+			t.Errorf("#%d: sampled %d of 1000 %s requests, want between %d and %d", i, n, tt.method, tt.wmin, tt.wmax)
+		}
+	}
+
+	rl = newRequestLogger(nil, map[string]float64{"*": 1})
+	if !rl.sampled("/etcdserverpb.Lease/LeaseGrant") {
+		t.Errorf("expected request sampled by the \"*\" rate")
+	}
+}
+
+func TestRequestKey(t *testing.T) {
+	put := func(k string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte(k)}}}
+	}
+	tests := []struct {
+		req  interface{}
+		wkey string
+	}{
+		{&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("b")}, "a"},
+		{&pb.PutRequest{Key: []byte("a")}, "a"},
+		{&pb.DeleteRangeRequest{Key: []byte("a")}, "a"},
+		{&pb.TxnRequest{Compare: []*pb.Compare{{Key: []byte("a")}}, Success: []*pb.RequestOp{put("b")}}, "a"},
+		{&pb.TxnRequest{Success: []*pb.RequestOp{put("b")}, Failure: []*pb.RequestOp{put("c")}}, "b"},
+		{&pb.TxnRequest{Failure: []*pb.RequestOp{put("c")}}, "c"},
+		{&pb.TxnRequest{Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{Success: []*pb.RequestOp{put("d")}}}}}}, "d"},
+		{&pb.TxnRequest{}, ""},
+		{&pb.LeaseGrantRequest{TTL: 5}, ""},
+	}
+	for i, tt := range tests {
+		if key := requestKey(tt.req); key != tt.wkey {
+			t.Errorf("#%d: key = %q, want %q", i, key, tt.wkey)
+		}
+	}
+}
+
+func TestRequestLoggerFailedRequest(t *testing.T) {
+	rl := newRequestLogger(zap.NewNop(), map[string]float64{"*": 1})
+	info := &grpc.UnaryServerInfo{FullMethod: "/etcdserverpb.KV/Range"}
+	req := &pb.RangeRequest{Key: []byte("a")}
+
+	// a failed handler returns a typed nil response, which must not be read
+	var resp *pb.RangeResponse
+	rl.log(context.TODO(), info, time.Now(), req, resp, errors.New("failed"))
+}
+
+func TestKeyPrefix(t *testing.T) {
+	long := "/" + string(make([]byte, maxKeyPrefixLen)) + "/a"
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"", ""},
+		{"foo", ""},
+		{"/registry/pods/default/nginx", "/registry/pods/default/"},
+		{"a/b/", "a/b/"},
+		{long, long[:maxKeyPrefixLen]},
+	}
+	for i, tt := range tests {
+		if got := keyPrefix(tt.key); got != tt.want {
+			t.Errorf("#%d: prefix = %q, want %q", i, got, tt.want)
+		}
+	}
+}
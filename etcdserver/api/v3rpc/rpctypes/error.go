@@ -31,6 +31,11 @@ var (
 	ErrGRPCFutureRev     = status.New(codes.OutOfRange, "etcdserver: mvcc: required revision is a future revision").Err()
 	ErrGRPCNoSpace       = status.New(codes.ResourceExhausted, "etcdserver: mvcc: database space exceeded").Err()
 
+	ErrGRPCRevisionTimesDisabled = status.New(codes.FailedPrecondition, "etcdserver: mvcc: revision commit times are not recorded").Err()
+	ErrGRPCRevisionTimeUnknown   = status.New(codes.NotFound, "etcdserver: mvcc: no revision committed time recorded at or before the given time").Err()
+
+	ErrGRPCInvalidCompactionConfig = status.New(codes.InvalidArgument, "etcdserver: invalid auto-compaction mode or retention").Err()
+
 	ErrGRPCLeaseNotFound    = status.New(codes.NotFound, "etcdserver: requested lease not found").Err()
 	ErrGRPCLeaseExist       = status.New(codes.FailedPrecondition, "etcdserver: lease already exists").Err()
 	ErrGRPCLeaseTTLTooLarge = status.New(codes.OutOfRange, "etcdserver: too large lease TTL").Err()
@@ -43,6 +48,8 @@ var (
 
 	ErrGRPCRequestTooLarge        = status.New(codes.InvalidArgument, "etcdserver: request is too large").Err()
 	ErrGRPCRequestTooManyRequests = status.New(codes.ResourceExhausted, "etcdserver: too many requests").Err()
+	ErrGRPCTooManyWatchers        = status.New(codes.ResourceExhausted, "etcdserver: too many watchers").Err()
+	ErrGRPCTooManyConnWatchers    = status.New(codes.ResourceExhausted, "etcdserver: too many watchers on connection").Err()
 
 	ErrGRPCRootUserNotExist     = status.New(codes.FailedPrecondition, "etcdserver: root user does not exist").Err()
 	ErrGRPCRootRoleNotExist     = status.New(codes.FailedPrecondition, "etcdserver: root user does not have root role").Err()
@@ -68,6 +75,7 @@ var (
 	ErrGRPCTimeoutDueToConnectionLost = status.New(codes.Unavailable, "etcdserver: request timed out, possibly due to connection lost").Err()
 	ErrGRPCUnhealthy                  = status.New(codes.Unavailable, "etcdserver: unhealthy cluster").Err()
 	ErrGRPCCorrupt                    = status.New(codes.DataLoss, "etcdserver: corrupt cluster").Err()
+	ErrGRPCMaintenanceMode            = status.New(codes.Unavailable, "etcdserver: cluster is in maintenance mode").Err()
 
 	errStringToError = map[string]error{
 		ErrorDesc(ErrGRPCEmptyKey):      ErrGRPCEmptyKey,
@@ -81,6 +89,11 @@ var (
 		ErrorDesc(ErrGRPCFutureRev):    ErrGRPCFutureRev,
 		ErrorDesc(ErrGRPCNoSpace):      ErrGRPCNoSpace,
 
+		ErrorDesc(ErrGRPCRevisionTimesDisabled): ErrGRPCRevisionTimesDisabled,
+		ErrorDesc(ErrGRPCRevisionTimeUnknown):   ErrGRPCRevisionTimeUnknown,
+
+		ErrorDesc(ErrGRPCInvalidCompactionConfig): ErrGRPCInvalidCompactionConfig,
+
 		ErrorDesc(ErrGRPCLeaseNotFound):    ErrGRPCLeaseNotFound,
 		ErrorDesc(ErrGRPCLeaseExist):       ErrGRPCLeaseExist,
 		ErrorDesc(ErrGRPCLeaseTTLTooLarge): ErrGRPCLeaseTTLTooLarge,
@@ -93,6 +106,8 @@ var (
 
 		ErrorDesc(ErrGRPCRequestTooLarge):        ErrGRPCRequestTooLarge,
 		ErrorDesc(ErrGRPCRequestTooManyRequests): ErrGRPCRequestTooManyRequests,
+		ErrorDesc(ErrGRPCTooManyWatchers):        ErrGRPCTooManyWatchers,
+		ErrorDesc(ErrGRPCTooManyConnWatchers):    ErrGRPCTooManyConnWatchers,
 
 		ErrorDesc(ErrGRPCRootUserNotExist):     ErrGRPCRootUserNotExist,
 		ErrorDesc(ErrGRPCRootRoleNotExist):     ErrGRPCRootRoleNotExist,
@@ -118,6 +133,7 @@ var (
 		ErrorDesc(ErrGRPCTimeoutDueToConnectionLost): ErrGRPCTimeoutDueToConnectionLost,
 		ErrorDesc(ErrGRPCUnhealthy):                  ErrGRPCUnhealthy,
 		ErrorDesc(ErrGRPCCorrupt):                    ErrGRPCCorrupt,
+		ErrorDesc(ErrGRPCMaintenanceMode):            ErrGRPCMaintenanceMode,
 	}
 )
 
@@ -133,6 +149,11 @@ var (
 	ErrFutureRev     = Error(ErrGRPCFutureRev)
 	ErrNoSpace       = Error(ErrGRPCNoSpace)
 
+	ErrRevisionTimesDisabled = Error(ErrGRPCRevisionTimesDisabled)
+	ErrRevisionTimeUnknown   = Error(ErrGRPCRevisionTimeUnknown)
+
+	ErrInvalidCompactionConfig = Error(ErrGRPCInvalidCompactionConfig)
+
 	ErrLeaseNotFound    = Error(ErrGRPCLeaseNotFound)
 	ErrLeaseExist       = Error(ErrGRPCLeaseExist)
 	ErrLeaseTTLTooLarge = Error(ErrGRPCLeaseTTLTooLarge)
@@ -143,8 +164,10 @@ var (
 	ErrMemberBadURLs          = Error(ErrGRPCMemberBadURLs)
 	ErrMemberNotFound         = Error(ErrGRPCMemberNotFound)
 
-	ErrRequestTooLarge = Error(ErrGRPCRequestTooLarge)
-	ErrTooManyRequests = Error(ErrGRPCRequestTooManyRequests)
+	ErrRequestTooLarge     = Error(ErrGRPCRequestTooLarge)
+	ErrTooManyRequests     = Error(ErrGRPCRequestTooManyRequests)
+	ErrTooManyWatchers     = Error(ErrGRPCTooManyWatchers)
+	ErrTooManyConnWatchers = Error(ErrGRPCTooManyConnWatchers)
 
 	ErrRootUserNotExist     = Error(ErrGRPCRootUserNotExist)
 	ErrRootRoleNotExist     = Error(ErrGRPCRootRoleNotExist)
@@ -170,6 +193,7 @@ var (
 	ErrTimeoutDueToConnectionLost = Error(ErrGRPCTimeoutDueToConnectionLost)
 	ErrUnhealthy                  = Error(ErrGRPCUnhealthy)
 	ErrCorrupt                    = Error(ErrGRPCCorrupt)
+	ErrMaintenanceMode            = Error(ErrGRPCMaintenanceMode)
 )
 
 // EtcdError defines gRPC server errors.
@@ -17,4 +17,8 @@ package rpctypes
 var (
 	MetadataRequireLeaderKey = "hasleader"
 	MetadataHasLeader        = "true"
+
+	// MetadataBearerTokenKey is the metadata key of the token checked
+	// against "--client-bearer-token-file".
+	MetadataBearerTokenKey = "bearer-token"
 )
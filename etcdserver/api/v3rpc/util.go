@@ -38,6 +38,8 @@ var toGRPCErrorMap = map[error]error{
 
 	mvcc.ErrCompacted:             rpctypes.ErrGRPCCompacted,
 	mvcc.ErrFutureRev:             rpctypes.ErrGRPCFutureRev,
+	mvcc.ErrRevisionTimesDisabled: rpctypes.ErrGRPCRevisionTimesDisabled,
+	mvcc.ErrRevisionTimeUnknown:   rpctypes.ErrGRPCRevisionTimeUnknown,
 	etcdserver.ErrRequestTooLarge: rpctypes.ErrGRPCRequestTooLarge,
 	etcdserver.ErrNoSpace:         rpctypes.ErrGRPCNoSpace,
 	etcdserver.ErrTooManyRequests: rpctypes.ErrTooManyRequests,
@@ -51,6 +53,9 @@ var toGRPCErrorMap = map[error]error{
 	etcdserver.ErrUnhealthy:                  rpctypes.ErrGRPCUnhealthy,
 	etcdserver.ErrKeyNotFound:                rpctypes.ErrGRPCKeyNotFound,
 	etcdserver.ErrCorrupt:                    rpctypes.ErrGRPCCorrupt,
+	etcdserver.ErrMaintenanceMode:            rpctypes.ErrGRPCMaintenanceMode,
+	etcdserver.ErrInvalidCompactionConfig:    rpctypes.ErrGRPCInvalidCompactionConfig,
+	etcdserver.ErrNotCapable:                 rpctypes.ErrGRPCNotCapable,
 
 	lease.ErrLeaseNotFound:    rpctypes.ErrGRPCLeaseNotFound,
 	lease.ErrLeaseExists:      rpctypes.ErrGRPCLeaseExist,
@@ -77,6 +82,9 @@ func togRPCError(err error) error {
 	if err == context.Canceled || err == context.DeadlineExceeded {
 		return err
 	}
+	if kerr, ok := err.(etcdserver.KeyRejectedError); ok {
+		return status.Error(codes.InvalidArgument, kerr.Error())
+	}
 	grpcErr, ok := toGRPCErrorMap[err]
 	if !ok {
 		return status.Error(codes.Unknown, err.Error())
@@ -29,6 +29,7 @@ import (
 	"github.com/coreos/etcd/mvcc/mvccpb"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/peer"
 )
 
 type watchServer struct {
@@ -42,6 +43,8 @@ type watchServer struct {
 	sg        etcdserver.RaftStatusGetter
 	watchable mvcc.WatchableKV
 	ag        AuthGetter
+
+	limits *watchLimits
 }
 
 // NewWatchServer returns a new watch server.
@@ -57,6 +60,81 @@ func NewWatchServer(s *etcdserver.EtcdServer) pb.WatchServer {
 		sg:        s,
 		watchable: s.Watchable(),
 		ag:        s,
+
+		limits: serverWatchLimits(s),
+	}
+}
+
+var (
+	watchLimitsMu sync.Mutex
+	// watchLimitsByServer holds the watch limits of each etcd server so
+	// that the watchers of all its gRPC servers count against one cap.
+	watchLimitsByServer = make(map[*etcdserver.EtcdServer]*watchLimits)
+)
+
+// serverWatchLimits returns the watch limits of s, creating them on the
+// first call.
+func serverWatchLimits(s *etcdserver.EtcdServer) *watchLimits {
+	watchLimitsMu.Lock()
+	defer watchLimitsMu.Unlock()
+	if wl, ok := watchLimitsByServer[s]; ok {
+		return wl
+	}
+	wl := newWatchLimits(s.Cfg.MaxWatchers, s.Cfg.MaxWatchersPerConnection)
+	watchLimitsByServer[s] = wl
+	go func() {
+		<-s.StopNotify()
+		watchLimitsMu.Lock()
+		delete(watchLimitsByServer, s)
+		watchLimitsMu.Unlock()
+	}()
+	return wl
+}
+
+// watchLimits counts the watchers of the server, in total and per client
+// connection, and refuses new ones over the configured caps. Connections
+// are told apart by their remote address, so all the clients of a gRPC
+// proxy or gateway share the quota of its one connection.
+type watchLimits struct {
+	max, maxPerConn int
+
+	mu    sync.Mutex
+	total int
+	conns map[string]int
+}
+
+// newWatchLimits returns limits of max watchers in total and maxPerConn
+// watchers per connection; 0 means no cap.
+func newWatchLimits(max, maxPerConn int) *watchLimits {
+	return &watchLimits{max: max, maxPerConn: maxPerConn, conns: make(map[string]int)}
+}
+
+// acquire counts a new watcher on conn, or returns the error to cancel it
+// with if a cap is reached.
+func (wl *watchLimits) acquire(conn string) error {
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+	if wl.max > 0 && wl.total >= wl.max {
+		return rpctypes.ErrGRPCTooManyWatchers
+	}
+	if wl.maxPerConn > 0 && wl.conns[conn] >= wl.maxPerConn {
+		return rpctypes.ErrGRPCTooManyConnWatchers
+	}
+	wl.total++
+	wl.conns[conn]++
+	return nil
+}
+
+// release uncounts n watchers on conn.
+func (wl *watchLimits) release(conn string, n int) {
+	if n == 0 {
+		return
+	}
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+	wl.total -= n
+	if wl.conns[conn] -= n; wl.conns[conn] <= 0 {
+		delete(wl.conns, conn)
 	}
 }
 
@@ -116,8 +194,15 @@ type serverWatchStream struct {
 	watchStream mvcc.WatchStream
 	ctrlStream  chan *pb.WatchResponse
 
-	// mu protects progress, prevKV, fragment
+	// limits counts the watchers of the stream against the server caps,
+	// under the address of the client connection in conn.
+	limits *watchLimits
+	conn   string
+
+	// mu protects watchers, progress, prevKV, fragment, coalesce
 	mu sync.RWMutex
+	// records watch IDs counted in limits
+	watchers map[mvcc.WatchID]struct{}
 	// tracks the watchID that stream might need to send progress to
 	// TODO: combine progress and prevKV into a single struct?
 	progress map[mvcc.WatchID]bool
@@ -125,6 +210,8 @@ type serverWatchStream struct {
 	prevKV map[mvcc.WatchID]bool
 	// records fragmented watch IDs
 	fragment map[mvcc.WatchID]bool
+	// records watch IDs that only want the latest event of each key
+	coalesce map[mvcc.WatchID]bool
 
 	// closec indicates the stream is closed.
 	closec chan struct{}
@@ -151,12 +238,19 @@ func (ws *watchServer) Watch(stream pb.Watch_WatchServer) (err error) {
 		// chan for sending control response like watcher created and canceled.
 		ctrlStream: make(chan *pb.WatchResponse, ctrlStreamBufLen),
 
+		limits: ws.limits,
+
+		watchers: make(map[mvcc.WatchID]struct{}),
 		progress: make(map[mvcc.WatchID]bool),
 		prevKV:   make(map[mvcc.WatchID]bool),
 		fragment: make(map[mvcc.WatchID]bool),
+		coalesce: make(map[mvcc.WatchID]bool),
 
 		closec: make(chan struct{}),
 	}
+	if p, ok := peer.FromContext(stream.Context()); ok && p.Addr != nil {
+		sws.conn = p.Addr.String()
+	}
 
 	sws.wg.Add(1)
 	go func() {
@@ -271,9 +365,22 @@ func (sws *serverWatchStream) recvLoop() error {
 			if rev == 0 {
 				rev = wsrev + 1
 			}
-			id, err := sws.watchStream.Watch(mvcc.WatchID(creq.WatchId), creq.Key, creq.RangeEnd, rev, filters...)
+			id := mvcc.WatchID(creq.WatchId)
+			err := sws.limits.acquire(sws.conn)
+			if err == nil {
+				id, err = sws.watchStream.Watch(id, creq.Key, creq.RangeEnd, rev, filters...)
+				if err != nil {
+					sws.limits.release(sws.conn, 1)
+				}
+			}
 			if err == nil {
 				sws.mu.Lock()
+				if sws.watchers != nil {
+					sws.watchers[id] = struct{}{}
+				} else {
+					// the stream closed and released its watchers already
+					sws.limits.release(sws.conn, 1)
+				}
 				if creq.ProgressNotify {
 					sws.progress[id] = true
 				}
@@ -283,6 +390,9 @@ func (sws *serverWatchStream) recvLoop() error {
 				if creq.Fragment {
 					sws.fragment[id] = true
 				}
+				if creq.Coalesce {
+					sws.coalesce[id] = true
+				}
 				sws.mu.Unlock()
 			}
 			wr := &pb.WatchResponse{
@@ -314,7 +424,9 @@ func (sws *serverWatchStream) recvLoop() error {
 					delete(sws.progress, mvcc.WatchID(id))
 					delete(sws.prevKV, mvcc.WatchID(id))
 					delete(sws.fragment, mvcc.WatchID(id))
+					delete(sws.coalesce, mvcc.WatchID(id))
 					sws.mu.Unlock()
+					sws.releaseWatcher(mvcc.WatchID(id))
 				}
 			}
 
@@ -333,12 +445,20 @@ func (sws *serverWatchStream) sendLoop() {
 	// watch responses pending on a watch id creation message
 	pending := make(map[mvcc.WatchID][]*pb.WatchResponse)
 
+	// watch responses already read from the watch stream, merged for
+	// coalescing watchers; they are sent before reading more
+	wch := sws.watchStream.Chan()
+	readahead := make(chan mvcc.WatchResponse, cap(wch))
+
 	interval := GetProgressReportInterval()
 	progressTicker := time.NewTicker(interval)
 
 	defer func() {
 		progressTicker.Stop()
 		// drain the chan to clean up pending events
+		for len(readahead) > 0 {
+			mvcc.ReportEventReceived(len((<-readahead).Events))
+		}
 		for ws := range sws.watchStream.Chan() {
 			mvcc.ReportEventReceived(len(ws.Events))
 		}
@@ -350,20 +470,50 @@ func (sws *serverWatchStream) sendLoop() {
 	}()
 
 	for {
+		src := wch
+		if len(readahead) > 0 {
+			src = readahead
+		}
 		select {
-		case wresp, ok := <-sws.watchStream.Chan():
+		case wresp, ok := <-src:
 			if !ok {
 				return
 			}
 
+			sws.mu.RLock()
+			coalesce := sws.coalesce[wresp.WatchID]
+			sws.mu.RUnlock()
+			if coalesce && src == wch && len(wch) > 0 {
+				// the client is behind; merge the queued responses of
+				// each coalescing watcher so that only their latest
+				// events are sent
+				wrs := []mvcc.WatchResponse{wresp}
+				for n := len(wch); n > 0; n-- {
+					wrs = append(wrs, <-wch)
+				}
+				sws.mu.RLock()
+				wrs = coalesceResponses(wrs, sws.coalesce)
+				sws.mu.RUnlock()
+				wresp = wrs[0]
+				for _, wr := range wrs[1:] {
+					readahead <- wr
+				}
+			}
+
 			// TODO: evs is []mvccpb.Event type
 			// either return []*mvccpb.Event from the mvcc package
 			// or define protocol buffer with []mvccpb.Event.
 			evs := wresp.Events
-			events := make([]*mvccpb.Event, len(evs))
 			sws.mu.RLock()
 			needPrevKV := sws.prevKV[wresp.WatchID]
 			sws.mu.RUnlock()
+			coalesced := false
+			if coalesce {
+				evs, coalesced = coalesceEvents(evs)
+				// dropped events are never sent
+				mvcc.ReportEventReceived(len(wresp.Events) - len(evs))
+			}
+			events := make([]*mvccpb.Event, len(evs))
 			for i := range evs {
 				events[i] = &evs[i]
 				if needPrevKV {
@@ -376,12 +526,17 @@ func (sws *serverWatchStream) sendLoop() {
 			}
 
 			canceled := wresp.CompactRevision != 0
+			if canceled {
+				// the watcher is gone; stop counting it against the limits
+				sws.releaseWatcher(wresp.WatchID)
+			}
 			wr := &pb.WatchResponse{
 				Header:          sws.newResponseHeader(wresp.Revision),
 				WatchId:         int64(wresp.WatchID),
 				Events:          events,
 				CompactRevision: wresp.CompactRevision,
 				Canceled:        canceled,
+				Coalesced:       coalesced,
 			}
 
 			if _, okID := ids[wresp.WatchID]; !okID {
@@ -392,6 +547,8 @@ func (sws *serverWatchStream) sendLoop() {
 			}
 
 			mvcc.ReportEventReceived(len(evs))
+			mvcc.ReportWatchEventSizes(events)
+			reportResourceWatchEvents(events)
 
 			sws.mu.RLock()
 			fragmented, ok := sws.fragment[wresp.WatchID]
@@ -500,6 +657,51 @@ func (sws *serverWatchStream) sendLoop() {
 	}
 }
 
+// coalesceResponses appends the events of the responses of each
+// coalescing watcher to its first response in wrs. Other responses, and
+// those canceling a watcher on compaction, are kept in order.
+func coalesceResponses(wrs []mvcc.WatchResponse, coalesce map[mvcc.WatchID]bool) []mvcc.WatchResponse {
+	merged := make([]mvcc.WatchResponse, 0, len(wrs))
+	first := make(map[mvcc.WatchID]int)
+	for _, wr := range wrs {
+		if wr.CompactRevision != 0 || !coalesce[wr.WatchID] {
+			delete(first, wr.WatchID)
+			merged = append(merged, wr)
+			continue
+		}
+		if i, ok := first[wr.WatchID]; ok {
+			merged[i].Events = append(merged[i].Events, wr.Events...)
+			merged[i].Revision = wr.Revision
+			continue
+		}
+		first[wr.WatchID] = len(merged)
+		merged = append(merged, wr)
+	}
+	return merged
+}
+
+// coalesceEvents keeps only the last event of each key, preserving
+// revision order. It reports whether any event was dropped.
+func coalesceEvents(evs []mvccpb.Event) ([]mvccpb.Event, bool) {
+	if len(evs) < 2 {
+		return evs, false
+	}
+	last := make(map[string]int, len(evs))
+	for i := range evs {
+		last[string(evs[i].Kv.Key)] = i
+	}
+	if len(last) == len(evs) {
+		return evs, false
+	}
+	ret := make([]mvccpb.Event, 0, len(last))
+	for i := range evs {
+		if last[string(evs[i].Kv.Key)] == i {
+			ret = append(ret, evs[i])
+		}
+	}
+	return ret, true
+}
+
 func sendFragments(
 	wr *pb.WatchResponse,
 	maxRequestBytes int,
@@ -543,6 +745,22 @@ func (sws *serverWatchStream) close() {
 	sws.watchStream.Close()
 	close(sws.closec)
 	sws.wg.Wait()
+	sws.mu.Lock()
+	n := len(sws.watchers)
+	sws.watchers = nil
+	sws.mu.Unlock()
+	sws.limits.release(sws.conn, n)
+}
+
+// releaseWatcher uncounts the watcher id from the limits, once.
+func (sws *serverWatchStream) releaseWatcher(id mvcc.WatchID) {
+	sws.mu.Lock()
+	_, ok := sws.watchers[id]
+	delete(sws.watchers, id)
+	sws.mu.Unlock()
+	if ok {
+		sws.limits.release(sws.conn, 1)
+	}
 }
 
 func (sws *serverWatchStream) newResponseHeader(rev int64) *pb.ResponseHeader {
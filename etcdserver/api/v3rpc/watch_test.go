@@ -16,11 +16,22 @@ package v3rpc
 
 import (
 	"bytes"
+	"fmt"
 	"math"
+	"os"
 	"testing"
+	"time"
 
+	"github.com/coreos/etcd/etcdserver"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/backend"
 	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/coreos/etcd/pkg/types"
+
+	"go.uber.org/zap"
 )
 
 func TestSendFragment(t *testing.T) {
@@ -93,3 +104,200 @@ func createResponse(dataSize, events int) (resp *pb.WatchResponse) {
 	}
 	return resp
 }
+
+func TestCoalesceEvents(t *testing.T) {
+	ev := func(key string, rev int64) mvccpb.Event {
+		return mvccpb.Event{Kv: &mvccpb.KeyValue{Key: []byte(key), ModRevision: rev}}
+	}
+	tt := []struct {
+		evs        []mvccpb.Event
+		wrevs      []int64
+		wcoalesced bool
+	}{
+		{nil, nil, false},
+		{[]mvccpb.Event{ev("a", 1), ev("b", 2)}, []int64{1, 2}, false},
+		{[]mvccpb.Event{ev("a", 1), ev("b", 2), ev("a", 3)}, []int64{2, 3}, true},
+		{[]mvccpb.Event{ev("a", 1), ev("a", 2), ev("a", 3), ev("b", 4)}, []int64{3, 4}, true},
+	}
+	for i, v := range tt {
+		evs, coalesced := coalesceEvents(v.evs)
+		if coalesced != v.wcoalesced {
+			t.Errorf("#%d: coalesced = %v, want %v", i, coalesced, v.wcoalesced)
+		}
+		if len(evs) != len(v.wrevs) {
+			t.Fatalf("#%d: len(evs) = %d, want %d", i, len(evs), len(v.wrevs))
+		}
+		for j := range evs {
+			if evs[j].Kv.ModRevision != v.wrevs[j] {
+				t.Errorf("#%d.%d: revision = %d, want %d", i, j, evs[j].Kv.ModRevision, v.wrevs[j])
+			}
+		}
+	}
+}
+
+func TestCoalesceResponses(t *testing.T) {
+	wr := func(id mvcc.WatchID, rev, compactRev int64) mvcc.WatchResponse {
+		return mvcc.WatchResponse{
+			WatchID:         id,
+			Revision:        rev,
+			CompactRevision: compactRev,
+			Events:          []mvccpb.Event{{Kv: &mvccpb.KeyValue{Key: []byte("a"), ModRevision: rev}}},
+		}
+	}
+	wrs := []mvcc.WatchResponse{wr(1, 2, 0), wr(2, 2, 0), wr(1, 3, 0), wr(2, 3, 0), wr(1, 4, 0), wr(1, 0, 4), wr(1, 5, 0)}
+	merged := coalesceResponses(wrs, map[mvcc.WatchID]bool{1: true})
+
+	// watcher 2 does not coalesce; the response canceling watcher 1
+	// stays after its merged events
+	wids := []mvcc.WatchID{1, 2, 2, 1, 1}
+	wrevs := [][]int64{{2, 3, 4}, {2}, {3}, {0}, {5}}
+	if len(merged) != len(wids) {
+		t.Fatalf("len(merged) = %d, want %d", len(merged), len(wids))
+	}
+	for i := range merged {
+		if merged[i].WatchID != wids[i] {
+			t.Errorf("#%d: watch ID = %d, want %d", i, merged[i].WatchID, wids[i])
+		}
+		var revs []int64
+		for _, ev := range merged[i].Events {
+			revs = append(revs, ev.Kv.ModRevision)
+		}
+		if fmt.Sprint(revs) != fmt.Sprint(wrevs[i]) {
+			t.Errorf("#%d: event revisions = %v, want %v", i, revs, wrevs[i])
+		}
+	}
+	if merged[0].Revision != 4 {
+		t.Errorf("merged revision = %d, want 4", merged[0].Revision)
+	}
+}
+
+type fakeRaftStatusGetter struct{}
+
+func (fakeRaftStatusGetter) ID() types.ID           { return 1 }
+func (fakeRaftStatusGetter) Leader() types.ID       { return 1 }
+func (fakeRaftStatusGetter) CommittedIndex() uint64 { return 0 }
+func (fakeRaftStatusGetter) AppliedIndex() uint64   { return 0 }
+func (fakeRaftStatusGetter) Term() uint64           { return 1 }
+
+type fakeWatchServer struct {
+	pb.Watch_WatchServer
+	sendc chan *pb.WatchResponse
+}
+
+func (s *fakeWatchServer) Send(wr *pb.WatchResponse) error {
+	s.sendc <- wr
+	return nil
+}
+
+// TestSendLoopCoalesce ensures a coalescing watcher that fell behind
+// receives only the latest of the puts to a key made in separate txns.
+func TestSendLoopCoalesce(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := mvcc.New(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer func() {
+		s.Close()
+		b.Close()
+		os.Remove(tmpPath)
+	}()
+
+	ws := s.NewWatchStream()
+	id, err := ws.Watch(0, []byte("foo"), nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// each put notifies the watcher with its own response, which queue
+	// up while the send loop is not running
+	for i := 0; i < 10; i++ {
+		s.Put([]byte("foo"), []byte(fmt.Sprintf("bar%d", i)), lease.NoLease)
+	}
+	if n := len(ws.Chan()); n != 10 {
+		t.Fatalf("len(ws.Chan()) = %d, want 10", n)
+	}
+
+	gs := &fakeWatchServer{sendc: make(chan *pb.WatchResponse, 10)}
+	sws := &serverWatchStream{
+		sg:          fakeRaftStatusGetter{},
+		watchable:   s,
+		gRPCStream:  gs,
+		watchStream: ws,
+		ctrlStream:  make(chan *pb.WatchResponse, ctrlStreamBufLen),
+		progress:    make(map[mvcc.WatchID]bool),
+		prevKV:      make(map[mvcc.WatchID]bool),
+		fragment:    make(map[mvcc.WatchID]bool),
+		coalesce:    map[mvcc.WatchID]bool{id: true},
+		closec:      make(chan struct{}),
+	}
+	sws.ctrlStream <- &pb.WatchResponse{WatchId: int64(id), Created: true}
+	donec := make(chan struct{})
+	go func() {
+		sws.sendLoop()
+		close(donec)
+	}()
+	defer func() {
+		close(sws.closec)
+		ws.Close()
+		<-donec
+	}()
+
+	var evs []*mvccpb.Event
+	for len(evs) == 0 || evs[len(evs)-1].Kv.ModRevision != 11 {
+		select {
+		case wr := <-gs.sendc:
+			evs = append(evs, wr.Events...)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for the last put, got %d events", len(evs))
+		}
+	}
+	if len(evs) != 1 || string(evs[0].Kv.Value) != "bar9" {
+		t.Fatalf("expected only the last put, got %+v", evs)
+	}
+}
+
+func TestWatchLimits(t *testing.T) {
+	wl := newWatchLimits(3, 2)
+	for i := 0; i < 2; i++ {
+		if err := wl.acquire("a"); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+	}
+	if err := wl.acquire("a"); err != rpctypes.ErrGRPCTooManyConnWatchers {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCTooManyConnWatchers)
+	}
+	if err := wl.acquire("b"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if err := wl.acquire("c"); err != rpctypes.ErrGRPCTooManyWatchers {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCTooManyWatchers)
+	}
+
+	wl.release("a", 2)
+	if _, ok := wl.conns["a"]; ok {
+		t.Fatalf("expected released connection to be removed")
+	}
+	if err := wl.acquire("c"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if wl.total != 2 {
+		t.Fatalf("total = %d, want 2", wl.total)
+	}
+
+	// 0 means no cap
+	wl = newWatchLimits(0, 0)
+	for i := 0; i < 100; i++ {
+		if err := wl.acquire("a"); err != nil {
+			t.Fatalf("#%d: unexpected error %v", i, err)
+		}
+	}
+}
+
+func TestServerWatchLimitsShared(t *testing.T) {
+	s := &etcdserver.EtcdServer{Cfg: etcdserver.ServerConfig{MaxWatchers: 1}}
+	wl := serverWatchLimits(s)
+	if err := wl.acquire("a"); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// a second gRPC server of the same etcd server shares the cap
+	if err := serverWatchLimits(s).acquire("b"); err != rpctypes.ErrGRPCTooManyWatchers {
+		t.Fatalf("err = %v, want %v", err, rpctypes.ErrGRPCTooManyWatchers)
+	}
+}
@@ -58,6 +58,10 @@ type applierV3 interface {
 	LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error)
 	LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error)
 
+	LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.EmptyResponse, error)
+
+	CompactionConfig(cc *pb.CompactionConfigRequest) (*pb.CompactionConfigResponse, error)
+
 	Alarm(*pb.AlarmRequest) (*pb.AlarmResponse, error)
 
 	Authenticate(r *pb.InternalAuthenticateRequest) (*pb.AuthenticateResponse, error)
@@ -108,6 +112,22 @@ func (s *EtcdServer) newApplierV3() applierV3 {
 	)
 }
 
+// newAlarmApplierV3 returns the applier that rejects the requests the
+// active alarms forbid.
+func (s *EtcdServer) newAlarmApplierV3() applierV3 {
+	a := s.newApplierV3()
+	if len(s.alarmStore.Get(pb.AlarmType_NOSPACE)) > 0 {
+		a = newApplierV3Capped(a)
+	}
+	if len(s.alarmStore.Get(pb.AlarmType_CORRUPT)) > 0 {
+		a = newApplierV3Corrupt(a)
+	}
+	if len(s.alarmStore.Get(pb.AlarmType_MAINTENANCE)) > 0 {
+		a = newApplierV3Maintenance(a)
+	}
+	return a
+}
+
 func (a *applierV3backend) Apply(r *pb.InternalRaftRequest) *applyResult {
 	defer warnOfExpensiveRequest(a.s.getLogger(), time.Now(), &pb.InternalRaftStringer{Request: r})
 
@@ -129,6 +149,10 @@ func (a *applierV3backend) Apply(r *pb.InternalRaftRequest) *applyResult {
 		ar.resp, ar.err = a.s.applyV3.LeaseGrant(r.LeaseGrant)
 	case r.LeaseRevoke != nil:
 		ar.resp, ar.err = a.s.applyV3.LeaseRevoke(r.LeaseRevoke)
+	case r.LeaseCheckpoint != nil:
+		ar.resp, ar.err = a.s.applyV3.LeaseCheckpoint(r.LeaseCheckpoint)
+	case r.CompactionConfig != nil:
+		ar.resp, ar.err = a.s.applyV3.CompactionConfig(r.CompactionConfig)
 	case r.Alarm != nil:
 		ar.resp, ar.err = a.s.applyV3.Alarm(r.Alarm)
 	case r.Authenticate != nil:
@@ -581,6 +605,36 @@ func (a *applierV3backend) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevo
 	return &pb.LeaseRevokeResponse{Header: newHeader(a.s)}, err
 }
 
+func (a *applierV3backend) LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.EmptyResponse, error) {
+	for _, c := range lc.Checkpoints {
+		err := a.s.lessor.Checkpoint(lease.LeaseID(c.ID), c.Remaining_TTL)
+		// the lease may have been revoked after the checkpoint was proposed
+		if err != nil && err != lease.ErrLeaseNotFound {
+			return nil, err
+		}
+	}
+	return &pb.EmptyResponse{}, nil
+}
+
+func (a *applierV3backend) CompactionConfig(cc *pb.CompactionConfigRequest) (*pb.CompactionConfigResponse, error) {
+	if err := a.s.setCompactor(cc.Mode, time.Duration(cc.Retention)); err != nil {
+		return nil, err
+	}
+	writeCompactionConfig(a.s.be, cc)
+
+	lg := a.s.getLogger()
+	if lg != nil {
+		lg.Info(
+			"changed auto-compaction configuration",
+			zap.String("auto-compaction-mode", cc.Mode),
+			zap.Duration("auto-compaction-retention", time.Duration(cc.Retention)),
+		)
+	} else {
+		plog.Infof("changed auto-compaction configuration (mode %q, retention %v)", cc.Mode, time.Duration(cc.Retention))
+	}
+	return &pb.CompactionConfigResponse{Header: newHeader(a.s), Mode: cc.Mode, Retention: cc.Retention}, nil
+}
+
 func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error) {
 	resp := &pb.AlarmResponse{}
 	oldCount := len(a.s.alarmStore.Get(ar.Alarm))
@@ -606,10 +660,10 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 			plog.Warningf("alarm %v raised by peer %s", m.Alarm, types.ID(m.MemberID))
 		}
 		switch m.Alarm {
-		case pb.AlarmType_CORRUPT:
-			a.s.applyV3 = newApplierV3Corrupt(a)
-		case pb.AlarmType_NOSPACE:
-			a.s.applyV3 = newApplierV3Capped(a)
+		case pb.AlarmType_CORRUPT, pb.AlarmType_NOSPACE:
+			a.s.applyV3 = a.s.newAlarmApplierV3()
+		case pb.AlarmType_MAINTENANCE:
+			a.s.enterMaintenanceMode()
 		default:
 			if lg != nil {
 				lg.Warn("unimplemented alarm activation", zap.String("alarm", fmt.Sprintf("%+v", m)))
@@ -636,7 +690,14 @@ func (a *applierV3backend) Alarm(ar *pb.AlarmRequest) (*pb.AlarmResponse, error)
 			} else {
 				plog.Infof("alarm disarmed %+v", ar)
 			}
-			a.s.applyV3 = a.s.newApplierV3()
+			a.s.applyV3 = a.s.newAlarmApplierV3()
+		case pb.AlarmType_MAINTENANCE:
+			if lg != nil {
+				lg.Warn("alarm disarmed", zap.String("alarm", m.Alarm.String()), zap.String("from", types.ID(m.MemberID).String()))
+			} else {
+				plog.Infof("alarm disarmed %+v", ar)
+			}
+			a.s.exitMaintenanceMode()
 		default:
 			if lg != nil {
 				lg.Warn("unimplemented alarm deactivation", zap.String("alarm", fmt.Sprintf("%+v", m)))
@@ -0,0 +1,120 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"time"
+
+	"github.com/coreos/etcd/etcdserver/api/v3compactor"
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/backend"
+
+	"github.com/coreos/go-semver/semver"
+	"go.uber.org/zap"
+)
+
+var (
+	compactionBucketName    = []byte("compaction")
+	compactionConfigKeyName = []byte("config")
+
+	// compactionConfigClusterVersion is the cluster version from which
+	// every member applies compaction config requests; older members
+	// panic on them.
+	compactionConfigClusterVersion = semver.Version{Major: 3, Minor: 4}
+)
+
+// readCompactionConfig reads the auto-compaction configuration last set with
+// the CompactionConfig RPC from the backend. It returns nil if the
+// configuration was never set, in which case the configuration of the member
+// is used.
+func readCompactionConfig(be backend.Backend) *pb.CompactionConfigRequest {
+	tx := be.BatchTx()
+	tx.Lock()
+	tx.UnsafeCreateBucket(compactionBucketName)
+	_, vs := tx.UnsafeRange(compactionBucketName, compactionConfigKeyName, nil, 0)
+	tx.Unlock()
+	if len(vs) == 0 {
+		return nil
+	}
+	cc := &pb.CompactionConfigRequest{}
+	if err := cc.Unmarshal(vs[0]); err != nil {
+		plog.Panicf("cannot unmarshal compaction config: %v", err)
+	}
+	return cc
+}
+
+func writeCompactionConfig(be backend.Backend, cc *pb.CompactionConfigRequest) {
+	v, err := cc.Marshal()
+	if err != nil {
+		plog.Panicf("cannot marshal compaction config: %v", err)
+	}
+	tx := be.BatchTx()
+	tx.Lock()
+	tx.UnsafePut(compactionBucketName, compactionConfigKeyName, v)
+	tx.Unlock()
+}
+
+// restoreCompactionConfig starts the auto-compactor with the configuration
+// persisted in the backend, if any, in place of the one in Cfg.
+func (s *EtcdServer) restoreCompactionConfig() error {
+	cc := readCompactionConfig(s.be)
+	if cc == nil {
+		return s.setCompactor(s.Cfg.AutoCompactionMode, s.Cfg.AutoCompactionRetention)
+	}
+	if lg := s.getLogger(); lg != nil {
+		lg.Info(
+			"restored auto-compaction configuration from backend",
+			zap.String("auto-compaction-mode", cc.Mode),
+			zap.Duration("auto-compaction-retention", time.Duration(cc.Retention)),
+		)
+	} else {
+		plog.Infof("restored auto-compaction configuration from backend (mode %q, retention %v)", cc.Mode, time.Duration(cc.Retention))
+	}
+	return s.setCompactor(cc.Mode, time.Duration(cc.Retention))
+}
+
+// compactionConfig returns the auto-compaction mode and retention in effect.
+func (s *EtcdServer) compactionConfig() (string, time.Duration) {
+	s.compactorMu.Lock()
+	defer s.compactorMu.Unlock()
+	return s.compactionMode, s.compactionRetention
+}
+
+// setCompactor replaces the auto-compactor with one for the given mode and
+// retention. A retention of 0 disables auto-compaction. The new compactor
+// only compacts while the member is leader.
+func (s *EtcdServer) setCompactor(mode string, retention time.Duration) error {
+	var c v3compactor.Compactor
+	if retention != 0 {
+		var err error
+		if c, err = v3compactor.New(s.getLogger(), mode, retention, s.kv, s); err != nil {
+			return err
+		}
+	}
+
+	s.compactorMu.Lock()
+	defer s.compactorMu.Unlock()
+	if s.compactor != nil {
+		s.compactor.Stop()
+	}
+	s.compactor, s.compactionMode, s.compactionRetention = c, mode, retention
+	if c != nil {
+		if !s.isLeader() {
+			c.Pause()
+		}
+		c.Run()
+	}
+	return nil
+}
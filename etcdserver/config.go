@@ -106,8 +106,40 @@ type ServerConfig struct {
 	QuotaBackendBytes       int64
 	MaxTxnOps               uint
 
+	// CompactionPauseLatency is the duration of mvcc txns above which
+	// compaction waits longer between batches. 0 disables it.
+	CompactionPauseLatency time.Duration
+
+	// WatchEventHistorySize is the number of recent events kept in memory
+	// for watchers catching up on past revisions. 0 disables it.
+	WatchEventHistorySize int
+
+	// RevisionTimes records the commit time of each revision in the
+	// backend, for looking up the revision as of a time.
+	RevisionTimes bool
+
 	// MaxRequestBytes is the maximum request size to send over raft.
 	MaxRequestBytes uint
+	// MaxRangeLimit caps the number of keys a range request returns,
+	// including requests without a limit. 0 means no cap.
+	MaxRangeLimit int64
+	// MaxWatchers caps the number of watchers open on the server, and
+	// MaxWatchersPerConnection the number open on one client connection,
+	// as told apart by remote address. 0 means no cap.
+	MaxWatchers              int
+	MaxWatchersPerConnection int
+
+	// GRPCMaxRecvMsgBytes is the maximum gRPC message size the client
+	// server accepts. 0 defaults to MaxRequestBytes plus gRPC overhead.
+	GRPCMaxRecvMsgBytes uint
+	// GRPCMaxSendMsgBytes is the maximum gRPC message size the client
+	// server sends, which bounds the size of Range responses.
+	// 0 defaults to math.MaxInt32.
+	GRPCMaxSendMsgBytes uint
+
+	// ClientBearerTokenFile is the path to the bearer token definitions
+	// that client requests are authorized against. Empty disables it.
+	ClientBearerTokenFile string
 
 	StrictReconfigCheck bool
 
@@ -122,6 +154,30 @@ type ServerConfig struct {
 	InitialCorruptCheck bool
 	CorruptCheckTime    time.Duration
 
+	// OrphanLeaseKeyCheckTime is the interval at which the leader deletes
+	// keys attached to leases that no longer exist. 0 disables it.
+	OrphanLeaseKeyCheckTime time.Duration
+
+	// EnableLeaseCheckpoint is true to have the leader checkpoint the
+	// remaining TTLs of leases through raft, so that they are kept
+	// across leader changes and restarts instead of being reset.
+	EnableLeaseCheckpoint bool
+	// LeaseCheckpointInterval is the wait duration between lease checkpoints.
+	LeaseCheckpointInterval time.Duration
+
+	// RangeCacheTTL is how long responses of identical range requests are
+	// cached while none of their keys are written. 0 disables the cache.
+	RangeCacheTTL time.Duration
+
+	// ReportedVersion overrides the server version served on the client
+	// "/version" endpoint. Empty reports the actual version.
+	ReportedVersion string
+
+	// RequestLogSampleRates maps gRPC method names (e.g. "Range") to the
+	// fraction of their unary requests that are logged; "*" applies to
+	// the other methods. Nil disables request logging.
+	RequestLogSampleRates map[string]float64
+
 	// PreVote is true to enable Raft Pre-Vote.
 	PreVote bool
 
@@ -140,6 +196,25 @@ type ServerConfig struct {
 	Debug bool
 
 	ForceNewCluster bool
+
+	// KeyValidator, if not nil, rejects puts to the keys it returns an
+	// error for, before they are proposed.
+	KeyValidator func(key []byte) error
+
+	// QuotaAlertTime is the projected time until the backend quota is
+	// reached under which a warning is logged and QuotaAlert is called.
+	QuotaAlertTime time.Duration
+	// QuotaAlert, if not nil, is called with the backend size, the quota
+	// and the projected time left whenever the alert fires.
+	QuotaAlert func(size, quota int64, left time.Duration)
+
+	// MaintenanceModeTimeout is how long the MAINTENANCE alarm stays
+	// active before the leader disarms it. 0 keeps it until disarmed.
+	MaintenanceModeTimeout time.Duration
+
+	// ClientCertAuthSANUsername is true to take the first DNS SAN of client
+	// certificates without a Common Name as their user name.
+	ClientCertAuthSANUsername bool
 }
 
 // VerifyBootstrap sanity-checks the initial config for bootstrap case
@@ -409,3 +409,11 @@ func (a *applierV3Corrupt) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantR
 func (a *applierV3Corrupt) LeaseRevoke(lc *pb.LeaseRevokeRequest) (*pb.LeaseRevokeResponse, error) {
 	return nil, ErrCorrupt
 }
+
+func (a *applierV3Corrupt) LeaseCheckpoint(lc *pb.LeaseCheckpointRequest) (*pb.EmptyResponse, error) {
+	return nil, ErrCorrupt
+}
+
+func (a *applierV3Corrupt) CompactionConfig(cc *pb.CompactionConfigRequest) (*pb.CompactionConfigResponse, error) {
+	return nil, ErrCorrupt
+}
@@ -0,0 +1,106 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"runtime"
+	"sync/atomic"
+
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+)
+
+// DebugState is a point-in-time dump of the server internals that are
+// most useful when troubleshooting a stuck member.
+type DebugState struct {
+	MemberID string `json:"member-id"`
+	Leader   string `json:"leader"`
+
+	Term           uint64 `json:"term"`
+	CommittedIndex uint64 `json:"committed-index"`
+	AppliedIndex   uint64 `json:"applied-index"`
+	// ApplyBacklog is the number of committed entries not yet applied.
+	ApplyBacklog uint64 `json:"apply-backlog"`
+
+	Revision        int64                 `json:"revision"`
+	CompactRevision int64                 `json:"compact-revision"`
+	Compaction      mvcc.CompactionStatus `json:"compaction"`
+
+	Watchers mvcc.WatcherStats `json:"watchers"`
+	Leases   int               `json:"leases"`
+	// InflightTxns is the number of Txn requests being served, including
+	// the ones waiting to be committed and applied.
+	InflightTxns int64 `json:"in-flight-txns"`
+
+	Channels DebugChannels `json:"channels"`
+
+	Goroutines int `json:"goroutines"`
+}
+
+// DebugChannels is the number of items queued on the internal channels
+// of the server. Queues that stay full point at the stage that is stuck.
+type DebugChannels struct {
+	// ApplyPending is the number of batches of committed entries waiting
+	// for the apply loop.
+	ApplyPending int `json:"apply-pending"`
+	// SnapshotMessages is the number of snapshot messages waiting to be
+	// sent to followers.
+	SnapshotMessages int `json:"snapshot-messages"`
+	// ReadStates is the number of linearizable read states waiting for
+	// the read loop.
+	ReadStates int `json:"read-states"`
+	// ExpiredLeases is the number of batches of expired leases waiting to
+	// be revoked.
+	ExpiredLeases int `json:"expired-leases"`
+}
+
+// DebugState returns the current internal state of the server.
+func (s *EtcdServer) DebugState() DebugState {
+	ds := DebugState{
+		MemberID:       s.ID().String(),
+		Leader:         s.Leader().String(),
+		Term:           s.Term(),
+		CommittedIndex: s.CommittedIndex(),
+		AppliedIndex:   s.AppliedIndex(),
+		InflightTxns:   atomic.LoadInt64(&s.inflightTxns),
+		Goroutines:     runtime.NumGoroutine(),
+	}
+	if ds.CommittedIndex > ds.AppliedIndex {
+		ds.ApplyBacklog = ds.CommittedIndex - ds.AppliedIndex
+	}
+	if s.kv != nil {
+		txn := s.kv.Read()
+		ds.Revision, ds.CompactRevision = txn.Rev(), txn.FirstRev()
+		txn.End()
+		ds.Compaction = s.kv.CompactionStatus()
+		ds.Watchers = s.kv.WatcherStats()
+	}
+	if s.lessor != nil {
+		ds.Leases = len(s.lessor.Leases())
+		ds.Channels.ExpiredLeases = len(s.lessor.ExpiredLeasesC())
+	}
+	if s.sched != nil {
+		ds.Channels.ApplyPending = s.sched.Pending()
+	}
+	ds.Channels.SnapshotMessages = len(s.r.msgSnapC)
+	ds.Channels.ReadStates = len(s.r.readStateC)
+	return ds
+}
+
+// LeaseEvents subscribes to the lease lifecycle events of this member.
+// The returned function cancels the subscription.
+func (s *EtcdServer) LeaseEvents() (<-chan lease.Event, func()) {
+	return s.lessor.Events()
+}
@@ -36,6 +36,9 @@ var (
 	ErrUnhealthy                  = errors.New("etcdserver: unhealthy cluster")
 	ErrKeyNotFound                = errors.New("etcdserver: key not found")
 	ErrCorrupt                    = errors.New("etcdserver: corrupt cluster")
+	ErrMaintenanceMode            = errors.New("etcdserver: cluster is in maintenance mode")
+	ErrInvalidCompactionConfig    = errors.New("etcdserver: invalid auto-compaction mode or retention")
+	ErrNotCapable                 = errors.New("etcdserver: not capable")
 )
 
 type DiscoveryError struct {
@@ -46,3 +49,14 @@ type DiscoveryError struct {
 func (e DiscoveryError) Error() string {
 	return fmt.Sprintf("failed to %s discovery cluster (%v)", e.Op, e.Err)
 }
+
+// KeyRejectedError is returned for puts to keys that the configured
+// KeyValidator rejects.
+type KeyRejectedError struct {
+	Key []byte
+	Err error
+}
+
+func (e KeyRejectedError) Error() string {
+	return fmt.Sprintf("etcdserver: key %q rejected (%v)", e.Key, e.Err)
+}
@@ -16,6 +16,8 @@
 		InternalRaftRequest
 		EmptyResponse
 		InternalAuthenticateRequest
+		LeaseCheckpoint
+		LeaseCheckpointRequest
 		ResponseHeader
 		RangeRequest
 		RangeResponse
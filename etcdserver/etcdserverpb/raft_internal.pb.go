@@ -47,6 +47,8 @@ type InternalRaftRequest struct {
 	LeaseGrant               *LeaseGrantRequest               `protobuf:"bytes,8,opt,name=lease_grant,json=leaseGrant" json:"lease_grant,omitempty"`
 	LeaseRevoke              *LeaseRevokeRequest              `protobuf:"bytes,9,opt,name=lease_revoke,json=leaseRevoke" json:"lease_revoke,omitempty"`
 	Alarm                    *AlarmRequest                    `protobuf:"bytes,10,opt,name=alarm" json:"alarm,omitempty"`
+	LeaseCheckpoint          *LeaseCheckpointRequest          `protobuf:"bytes,11,opt,name=lease_checkpoint,json=leaseCheckpoint" json:"lease_checkpoint,omitempty"`
+	CompactionConfig         *CompactionConfigRequest         `protobuf:"bytes,12,opt,name=compaction_config,json=compactionConfig" json:"compaction_config,omitempty"`
 	AuthEnable               *AuthEnableRequest               `protobuf:"bytes,1000,opt,name=auth_enable,json=authEnable" json:"auth_enable,omitempty"`
 	AuthDisable              *AuthDisableRequest              `protobuf:"bytes,1011,opt,name=auth_disable,json=authDisable" json:"auth_disable,omitempty"`
 	Authenticate             *InternalAuthenticateRequest     `protobuf:"bytes,1012,opt,name=authenticate" json:"authenticate,omitempty"`
@@ -95,11 +97,38 @@ func (*InternalAuthenticateRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptorRaftInternal, []int{3}
 }
 
+// LeaseCheckpoint is the remaining TTL of a lease as observed by the
+// leader, so that it survives leader changes and restarts.
+type LeaseCheckpoint struct {
+	// ID is the lease ID to checkpoint.
+	ID int64 `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	// Remaining_TTL is the remaining time until expiry of the lease.
+	Remaining_TTL int64 `protobuf:"varint,2,opt,name=remaining_TTL,json=remainingTTL,proto3" json:"remaining_TTL,omitempty"`
+}
+
+func (m *LeaseCheckpoint) Reset()                    { *m = LeaseCheckpoint{} }
+func (m *LeaseCheckpoint) String() string            { return proto.CompactTextString(m) }
+func (*LeaseCheckpoint) ProtoMessage()               {}
+func (*LeaseCheckpoint) Descriptor() ([]byte, []int) { return fileDescriptorRaftInternal, []int{4} }
+
+type LeaseCheckpointRequest struct {
+	Checkpoints []*LeaseCheckpoint `protobuf:"bytes,1,rep,name=checkpoints" json:"checkpoints,omitempty"`
+}
+
+func (m *LeaseCheckpointRequest) Reset()         { *m = LeaseCheckpointRequest{} }
+func (m *LeaseCheckpointRequest) String() string { return proto.CompactTextString(m) }
+func (*LeaseCheckpointRequest) ProtoMessage()    {}
+func (*LeaseCheckpointRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptorRaftInternal, []int{5}
+}
+
 func init() {
 	proto.RegisterType((*RequestHeader)(nil), "etcdserverpb.RequestHeader")
 	proto.RegisterType((*InternalRaftRequest)(nil), "etcdserverpb.InternalRaftRequest")
 	proto.RegisterType((*EmptyResponse)(nil), "etcdserverpb.EmptyResponse")
 	proto.RegisterType((*InternalAuthenticateRequest)(nil), "etcdserverpb.InternalAuthenticateRequest")
+	proto.RegisterType((*LeaseCheckpoint)(nil), "etcdserverpb.LeaseCheckpoint")
+	proto.RegisterType((*LeaseCheckpointRequest)(nil), "etcdserverpb.LeaseCheckpointRequest")
 }
 func (m *RequestHeader) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
@@ -245,17 +274,37 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i += n9
 	}
+	if m.LeaseCheckpoint != nil {
+		dAtA[i] = 0x5a
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.LeaseCheckpoint.Size()))
+		n10, err := m.LeaseCheckpoint.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n10
+	}
+	if m.CompactionConfig != nil {
+		dAtA[i] = 0x62
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.CompactionConfig.Size()))
+		n11, err := m.CompactionConfig.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n11
+	}
 	if m.Header != nil {
 		dAtA[i] = 0xa2
 		i++
 		dAtA[i] = 0x6
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Header.Size()))
-		n10, err := m.Header.MarshalTo(dAtA[i:])
+		n12, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n10
+		i += n12
 	}
 	if m.AuthEnable != nil {
 		dAtA[i] = 0xc2
@@ -263,11 +312,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3e
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthEnable.Size()))
-		n11, err := m.AuthEnable.MarshalTo(dAtA[i:])
+		n13, err := m.AuthEnable.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n13
 	}
 	if m.AuthDisable != nil {
 		dAtA[i] = 0x9a
@@ -275,11 +324,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3f
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthDisable.Size()))
-		n12, err := m.AuthDisable.MarshalTo(dAtA[i:])
+		n14, err := m.AuthDisable.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n14
 	}
 	if m.Authenticate != nil {
 		dAtA[i] = 0xa2
@@ -287,11 +336,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x3f
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Authenticate.Size()))
-		n13, err := m.Authenticate.MarshalTo(dAtA[i:])
+		n15, err := m.Authenticate.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n13
+		i += n15
 	}
 	if m.AuthUserAdd != nil {
 		dAtA[i] = 0xe2
@@ -299,11 +348,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x44
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserAdd.Size()))
-		n14, err := m.AuthUserAdd.MarshalTo(dAtA[i:])
+		n16, err := m.AuthUserAdd.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n16
 	}
 	if m.AuthUserDelete != nil {
 		dAtA[i] = 0xea
@@ -311,11 +360,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x44
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserDelete.Size()))
-		n15, err := m.AuthUserDelete.MarshalTo(dAtA[i:])
+		n17, err := m.AuthUserDelete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n17
 	}
 	if m.AuthUserGet != nil {
 		dAtA[i] = 0xf2
@@ -323,11 +372,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x44
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserGet.Size()))
-		n16, err := m.AuthUserGet.MarshalTo(dAtA[i:])
+		n18, err := m.AuthUserGet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n16
+		i += n18
 	}
 	if m.AuthUserChangePassword != nil {
 		dAtA[i] = 0xfa
@@ -335,11 +384,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x44
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserChangePassword.Size()))
-		n17, err := m.AuthUserChangePassword.MarshalTo(dAtA[i:])
+		n19, err := m.AuthUserChangePassword.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n17
+		i += n19
 	}
 	if m.AuthUserGrantRole != nil {
 		dAtA[i] = 0x82
@@ -347,11 +396,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x45
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserGrantRole.Size()))
-		n18, err := m.AuthUserGrantRole.MarshalTo(dAtA[i:])
+		n20, err := m.AuthUserGrantRole.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n18
+		i += n20
 	}
 	if m.AuthUserRevokeRole != nil {
 		dAtA[i] = 0x8a
@@ -359,11 +408,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x45
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserRevokeRole.Size()))
-		n19, err := m.AuthUserRevokeRole.MarshalTo(dAtA[i:])
+		n21, err := m.AuthUserRevokeRole.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n19
+		i += n21
 	}
 	if m.AuthUserList != nil {
 		dAtA[i] = 0x92
@@ -371,11 +420,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x45
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthUserList.Size()))
-		n20, err := m.AuthUserList.MarshalTo(dAtA[i:])
+		n22, err := m.AuthUserList.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n20
+		i += n22
 	}
 	if m.AuthRoleList != nil {
 		dAtA[i] = 0x9a
@@ -383,11 +432,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x45
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleList.Size()))
-		n21, err := m.AuthRoleList.MarshalTo(dAtA[i:])
+		n23, err := m.AuthRoleList.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n21
+		i += n23
 	}
 	if m.AuthRoleAdd != nil {
 		dAtA[i] = 0x82
@@ -395,11 +444,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleAdd.Size()))
-		n22, err := m.AuthRoleAdd.MarshalTo(dAtA[i:])
+		n24, err := m.AuthRoleAdd.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n22
+		i += n24
 	}
 	if m.AuthRoleDelete != nil {
 		dAtA[i] = 0x8a
@@ -407,11 +456,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleDelete.Size()))
-		n23, err := m.AuthRoleDelete.MarshalTo(dAtA[i:])
+		n25, err := m.AuthRoleDelete.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n23
+		i += n25
 	}
 	if m.AuthRoleGet != nil {
 		dAtA[i] = 0x92
@@ -419,11 +468,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleGet.Size()))
-		n24, err := m.AuthRoleGet.MarshalTo(dAtA[i:])
+		n26, err := m.AuthRoleGet.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n24
+		i += n26
 	}
 	if m.AuthRoleGrantPermission != nil {
 		dAtA[i] = 0x9a
@@ -431,11 +480,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleGrantPermission.Size()))
-		n25, err := m.AuthRoleGrantPermission.MarshalTo(dAtA[i:])
+		n27, err := m.AuthRoleGrantPermission.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n25
+		i += n27
 	}
 	if m.AuthRoleRevokePermission != nil {
 		dAtA[i] = 0xa2
@@ -443,11 +492,11 @@ func (m *InternalRaftRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x4b
 		i++
 		i = encodeVarintRaftInternal(dAtA, i, uint64(m.AuthRoleRevokePermission.Size()))
-		n26, err := m.AuthRoleRevokePermission.MarshalTo(dAtA[i:])
+		n28, err := m.AuthRoleRevokePermission.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n26
+		i += n28
 	}
 	return i, nil
 }
@@ -506,6 +555,64 @@ func (m *InternalAuthenticateRequest) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *LeaseCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.ID != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.ID))
+	}
+	if m.Remaining_TTL != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRaftInternal(dAtA, i, uint64(m.Remaining_TTL))
+	}
+	return i, nil
+}
+
+func (m *LeaseCheckpointRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseCheckpointRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, msg := range m.Checkpoints {
+			dAtA[i] = 0xa
+			i++
+			i = encodeVarintRaftInternal(dAtA, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(dAtA[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	return i, nil
+}
+
 func encodeVarintRaftInternal(dAtA []byte, offset int, v uint64) int {
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -573,6 +680,14 @@ func (m *InternalRaftRequest) Size() (n int) {
 		l = m.Alarm.Size()
 		n += 1 + l + sovRaftInternal(uint64(l))
 	}
+	if m.LeaseCheckpoint != nil {
+		l = m.LeaseCheckpoint.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
+	if m.CompactionConfig != nil {
+		l = m.CompactionConfig.Size()
+		n += 1 + l + sovRaftInternal(uint64(l))
+	}
 	if m.Header != nil {
 		l = m.Header.Size()
 		n += 2 + l + sovRaftInternal(uint64(l))
@@ -668,6 +783,30 @@ func (m *InternalAuthenticateRequest) Size() (n int) {
 	return n
 }
 
+func (m *LeaseCheckpoint) Size() (n int) {
+	var l int
+	_ = l
+	if m.ID != 0 {
+		n += 1 + sovRaftInternal(uint64(m.ID))
+	}
+	if m.Remaining_TTL != 0 {
+		n += 1 + sovRaftInternal(uint64(m.Remaining_TTL))
+	}
+	return n
+}
+
+func (m *LeaseCheckpointRequest) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovRaftInternal(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovRaftInternal(x uint64) (n int) {
 	for {
 		n++
@@ -1143,6 +1282,72 @@ func (m *InternalRaftRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeaseCheckpoint == nil {
+				m.LeaseCheckpoint = &LeaseCheckpointRequest{}
+			}
+			if err := m.LeaseCheckpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactionConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompactionConfig == nil {
+				m.CompactionConfig = &CompactionConfigRequest{}
+			}
+			if err := m.CompactionConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 100:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
@@ -1912,6 +2117,175 @@ func (m *InternalAuthenticateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LeaseCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			m.ID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ID |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining_TTL", wireType)
+			}
+			m.Remaining_TTL = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining_TTL |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LeaseCheckpointRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRaftInternal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LeaseCheckpointRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LeaseCheckpointRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRaftInternal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, &LeaseCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRaftInternal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRaftInternal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRaftInternal(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2020,58 +2394,65 @@ var (
 func init() { proto.RegisterFile("raft_internal.proto", fileDescriptorRaftInternal) }
 
 var fileDescriptorRaftInternal = []byte{
-	// 837 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0xdd, 0x52, 0xd3, 0x40,
-	0x14, 0xc7, 0x69, 0xf9, 0xec, 0xb6, 0x54, 0x5c, 0x40, 0xd7, 0x32, 0x53, 0xa1, 0x8c, 0x8a, 0x5f,
-	0xe8, 0x94, 0x07, 0xd0, 0x4a, 0x19, 0x60, 0x86, 0x61, 0x98, 0x0c, 0xce, 0x38, 0xe3, 0x45, 0x5c,
-	0x9a, 0x43, 0x1b, 0x49, 0x93, 0xb8, 0xd9, 0x56, 0x7c, 0x13, 0x1f, 0xc3, 0xaf, 0x87, 0xe0, 0xc2,
-	0x0f, 0xd4, 0x17, 0x50, 0xbc, 0xf1, 0xca, 0x1b, 0x7d, 0x00, 0x67, 0x3f, 0x92, 0x34, 0x6d, 0xca,
-	0x5d, 0x72, 0xce, 0xff, 0xfc, 0xce, 0xd9, 0xec, 0x7f, 0xbb, 0x45, 0xb3, 0x8c, 0x1e, 0x72, 0xd3,
-	0x76, 0x39, 0x30, 0x97, 0x3a, 0xab, 0x3e, 0xf3, 0xb8, 0x87, 0x0b, 0xc0, 0x1b, 0x56, 0x00, 0xac,
-	0x0b, 0xcc, 0x3f, 0x28, 0xcd, 0x35, 0xbd, 0xa6, 0x27, 0x13, 0xf7, 0xc4, 0x93, 0xd2, 0x94, 0x66,
-	0x62, 0x8d, 0x8e, 0xe4, 0x98, 0xdf, 0x50, 0x8f, 0x95, 0x67, 0x68, 0xda, 0x80, 0x17, 0x1d, 0x08,
-	0xf8, 0x16, 0x50, 0x0b, 0x18, 0x2e, 0xa2, 0xec, 0x76, 0x9d, 0x64, 0x16, 0x33, 0x2b, 0x63, 0x46,
-	0x76, 0xbb, 0x8e, 0x4b, 0x68, 0xaa, 0x13, 0x88, 0x96, 0x6d, 0x20, 0xd9, 0xc5, 0xcc, 0x4a, 0xce,
-	0x88, 0xde, 0xf1, 0x32, 0x9a, 0xa6, 0x1d, 0xde, 0x32, 0x19, 0x74, 0xed, 0xc0, 0xf6, 0x5c, 0x32,
-	0x2a, 0xcb, 0x0a, 0x22, 0x68, 0xe8, 0x58, 0xe5, 0x4f, 0x11, 0xcd, 0x6e, 0xeb, 0xa9, 0x0d, 0x7a,
-	0xc8, 0x75, 0xbb, 0x81, 0x46, 0xd7, 0x50, 0xb6, 0x5b, 0x95, 0x2d, 0xf2, 0xd5, 0xf9, 0xd5, 0xde,
-	0x75, 0xad, 0xea, 0x12, 0x23, 0xdb, 0xad, 0xe2, 0xfb, 0x68, 0x9c, 0x51, 0xb7, 0x09, 0xb2, 0x57,
-	0xbe, 0x5a, 0xea, 0x53, 0x8a, 0x54, 0x28, 0x57, 0x42, 0x7c, 0x0b, 0x8d, 0xfa, 0x1d, 0x4e, 0xc6,
-	0xa4, 0x9e, 0x24, 0xf5, 0x7b, 0x9d, 0x70, 0x1e, 0x43, 0x88, 0xf0, 0x3a, 0x2a, 0x58, 0xe0, 0x00,
-	0x07, 0x53, 0x35, 0x19, 0x97, 0x45, 0x8b, 0xc9, 0xa2, 0xba, 0x54, 0x24, 0x5a, 0xe5, 0xad, 0x38,
-	0x26, 0x1a, 0xf2, 0x63, 0x97, 0x4c, 0xa4, 0x35, 0xdc, 0x3f, 0x76, 0xa3, 0x86, 0xfc, 0xd8, 0xc5,
-	0x0f, 0x10, 0x6a, 0x78, 0x6d, 0x9f, 0x36, 0xb8, 0xf8, 0x7e, 0x93, 0xb2, 0xe4, 0x6a, 0xb2, 0x64,
-	0x3d, 0xca, 0x87, 0x95, 0x3d, 0x25, 0xf8, 0x21, 0xca, 0x3b, 0x40, 0x03, 0x30, 0x9b, 0x8c, 0xba,
-	0x9c, 0x4c, 0xa5, 0x11, 0x76, 0x84, 0x60, 0x53, 0xe4, 0x23, 0x82, 0x13, 0x85, 0xc4, 0x9a, 0x15,
-	0x81, 0x41, 0xd7, 0x3b, 0x02, 0x92, 0x4b, 0x5b, 0xb3, 0x44, 0x18, 0x52, 0x10, 0xad, 0xd9, 0x89,
-	0x63, 0x62, 0x5b, 0xa8, 0x43, 0x59, 0x9b, 0xa0, 0xb4, 0x6d, 0xa9, 0x89, 0x54, 0xb4, 0x2d, 0x52,
-	0x88, 0xd7, 0xd0, 0x44, 0x4b, 0x5a, 0x8e, 0x58, 0xb2, 0x64, 0x21, 0x75, 0xcf, 0x95, 0x2b, 0x0d,
-	0x2d, 0xc5, 0x35, 0x94, 0x97, 0x8e, 0x03, 0x97, 0x1e, 0x38, 0x40, 0x7e, 0xa7, 0x7e, 0xb0, 0x5a,
-	0x87, 0xb7, 0x36, 0xa4, 0x20, 0x5a, 0x2e, 0x8d, 0x42, 0xb8, 0x8e, 0xa4, 0x3f, 0x4d, 0xcb, 0x0e,
-	0x24, 0xe3, 0xef, 0x64, 0xda, 0x7a, 0x05, 0xa3, 0xae, 0x14, 0xd1, 0x7a, 0x69, 0x1c, 0xc3, 0xbb,
-	0x8a, 0x02, 0x2e, 0xb7, 0x1b, 0x94, 0x03, 0xf9, 0xa7, 0x28, 0x37, 0x93, 0x94, 0xd0, 0xf7, 0xb5,
-	0x1e, 0x69, 0x88, 0x4b, 0xd4, 0xe3, 0x0d, 0x7d, 0x94, 0xc4, 0xd9, 0x32, 0xa9, 0x65, 0x91, 0x8f,
-	0x53, 0xc3, 0xc6, 0x7a, 0x1c, 0x00, 0xab, 0x59, 0x56, 0x62, 0x2c, 0x1d, 0xc3, 0xbb, 0x68, 0x26,
-	0xc6, 0x28, 0x4f, 0x92, 0x4f, 0x8a, 0xb4, 0x9c, 0x4e, 0xd2, 0x66, 0xd6, 0xb0, 0x22, 0x4d, 0x84,
-	0x93, 0x63, 0x35, 0x81, 0x93, 0xcf, 0xe7, 0x8e, 0xb5, 0x09, 0x7c, 0x60, 0xac, 0x4d, 0xe0, 0xb8,
-	0x89, 0xae, 0xc4, 0x98, 0x46, 0x4b, 0x9c, 0x12, 0xd3, 0xa7, 0x41, 0xf0, 0xd2, 0x63, 0x16, 0xf9,
-	0xa2, 0x90, 0xb7, 0xd3, 0x91, 0xeb, 0x52, 0xbd, 0xa7, 0xc5, 0x21, 0xfd, 0x12, 0x4d, 0x4d, 0xe3,
-	0x27, 0x68, 0xae, 0x67, 0x5e, 0x61, 0x6f, 0x93, 0x79, 0x0e, 0x90, 0x53, 0xd5, 0xe3, 0xfa, 0x90,
-	0xb1, 0xe5, 0xd1, 0xf0, 0xe2, 0xad, 0xbe, 0x48, 0xfb, 0x33, 0xf8, 0x29, 0x9a, 0x8f, 0xc9, 0xea,
-	0xa4, 0x28, 0xf4, 0x57, 0x85, 0xbe, 0x91, 0x8e, 0xd6, 0x47, 0xa6, 0x87, 0x8d, 0xe9, 0x40, 0x0a,
-	0x6f, 0xa1, 0x62, 0x0c, 0x77, 0xec, 0x80, 0x93, 0x6f, 0x8a, 0xba, 0x94, 0x4e, 0xdd, 0xb1, 0x03,
-	0x9e, 0xf0, 0x51, 0x18, 0x8c, 0x48, 0x62, 0x34, 0x45, 0xfa, 0x3e, 0x94, 0x24, 0x5a, 0x0f, 0x90,
-	0xc2, 0x60, 0xb4, 0xf5, 0x92, 0x24, 0x1c, 0xf9, 0x26, 0x37, 0x6c, 0xeb, 0x45, 0x4d, 0xbf, 0x23,
-	0x75, 0x2c, 0x72, 0xa4, 0xc4, 0x68, 0x47, 0xbe, 0xcd, 0x0d, 0x73, 0xa4, 0xa8, 0x4a, 0x71, 0x64,
-	0x1c, 0x4e, 0x8e, 0x25, 0x1c, 0xf9, 0xee, 0xdc, 0xb1, 0xfa, 0x1d, 0xa9, 0x63, 0xf8, 0x39, 0x2a,
-	0xf5, 0x60, 0xa4, 0x51, 0x7c, 0x60, 0x6d, 0x3b, 0x90, 0xf7, 0xd8, 0x7b, 0xc5, 0xbc, 0x33, 0x84,
-	0x29, 0xe4, 0x7b, 0x91, 0x3a, 0xe4, 0x5f, 0xa6, 0xe9, 0x79, 0xdc, 0x46, 0x0b, 0x71, 0x2f, 0x6d,
-	0x9d, 0x9e, 0x66, 0x1f, 0x54, 0xb3, 0xbb, 0xe9, 0xcd, 0x94, 0x4b, 0x06, 0xbb, 0x11, 0x3a, 0x44,
-	0x50, 0xb9, 0x80, 0xa6, 0x37, 0xda, 0x3e, 0x7f, 0x65, 0x40, 0xe0, 0x7b, 0x6e, 0x00, 0x15, 0x1f,
-	0x2d, 0x9c, 0xf3, 0x43, 0x84, 0x31, 0x1a, 0x93, 0xb7, 0x7b, 0x46, 0xde, 0xee, 0xf2, 0x59, 0xdc,
-	0xfa, 0xd1, 0xf9, 0xd4, 0xb7, 0x7e, 0xf8, 0x8e, 0x97, 0x50, 0x21, 0xb0, 0xdb, 0xbe, 0x03, 0x26,
-	0xf7, 0x8e, 0x40, 0x5d, 0xfa, 0x39, 0x23, 0xaf, 0x62, 0xfb, 0x22, 0xf4, 0x68, 0xee, 0xe4, 0x67,
-	0x79, 0xe4, 0xe4, 0xac, 0x9c, 0x39, 0x3d, 0x2b, 0x67, 0x7e, 0x9c, 0x95, 0x33, 0xaf, 0x7f, 0x95,
-	0x47, 0x0e, 0x26, 0xe4, 0x5f, 0x8e, 0xb5, 0xff, 0x01, 0x00, 0x00, 0xff, 0xff, 0xff, 0xc9, 0xfc,
-	0x0e, 0xca, 0x08, 0x00, 0x00,
+	// 953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x96, 0xdd, 0x72, 0x1b, 0x35,
+	0x14, 0xc7, 0x6b, 0x3b, 0x4d, 0x63, 0xad, 0x93, 0xb8, 0x6a, 0x5a, 0x84, 0x33, 0x98, 0xd4, 0xa1,
+	0x10, 0xbe, 0x02, 0xe3, 0x3e, 0x40, 0x31, 0x76, 0x48, 0x33, 0x93, 0x29, 0x99, 0x1d, 0x33, 0x03,
+	0xc3, 0xc5, 0xa2, 0xec, 0x9e, 0xd8, 0x4b, 0xd6, 0xbb, 0x8b, 0x24, 0x9b, 0xf0, 0x1c, 0xdc, 0xf0,
+	0x18, 0x7c, 0x3d, 0x44, 0x2f, 0xf8, 0x08, 0xf0, 0x02, 0x10, 0x6e, 0xb8, 0x87, 0x07, 0x60, 0x24,
+	0xed, 0xa7, 0x2d, 0xfb, 0x6e, 0x7d, 0xf4, 0x3f, 0xbf, 0xff, 0xd9, 0xd5, 0x39, 0x96, 0xd0, 0x3d,
+	0x46, 0x2f, 0x84, 0xe3, 0x87, 0x02, 0x58, 0x48, 0x83, 0xc3, 0x98, 0x45, 0x22, 0xc2, 0x0d, 0x10,
+	0xae, 0xc7, 0x81, 0xcd, 0x80, 0xc5, 0xe7, 0xad, 0x9d, 0x51, 0x34, 0x8a, 0xd4, 0xc2, 0x3b, 0xf2,
+	0x49, 0x6b, 0x5a, 0xcd, 0x5c, 0x93, 0x44, 0xea, 0x2c, 0x76, 0xf5, 0x63, 0xe7, 0x33, 0xb4, 0x69,
+	0xc3, 0x17, 0x53, 0xe0, 0xe2, 0x29, 0x50, 0x0f, 0x18, 0xde, 0x42, 0xd5, 0x93, 0x01, 0xa9, 0xec,
+	0x55, 0x0e, 0xd6, 0xec, 0xea, 0xc9, 0x00, 0xb7, 0xd0, 0xc6, 0x94, 0x4b, 0xcb, 0x09, 0x90, 0xea,
+	0x5e, 0xe5, 0xa0, 0x6e, 0x67, 0xbf, 0xf1, 0x3e, 0xda, 0xa4, 0x53, 0x31, 0x76, 0x18, 0xcc, 0x7c,
+	0xee, 0x47, 0x21, 0xa9, 0xa9, 0xb4, 0x86, 0x0c, 0xda, 0x49, 0xac, 0xf3, 0x75, 0x13, 0xdd, 0x3b,
+	0x49, 0xaa, 0xb6, 0xe9, 0x85, 0x48, 0xec, 0x16, 0x8c, 0x1e, 0xa1, 0xea, 0xac, 0xab, 0x2c, 0xac,
+	0xee, 0xfd, 0xc3, 0xe2, 0x7b, 0x1d, 0x26, 0x29, 0x76, 0x75, 0xd6, 0xc5, 0xef, 0xa2, 0xdb, 0x8c,
+	0x86, 0x23, 0x50, 0x5e, 0x56, 0xb7, 0x35, 0xa7, 0x94, 0x4b, 0xa9, 0x5c, 0x0b, 0xf1, 0x1b, 0xa8,
+	0x16, 0x4f, 0x05, 0x59, 0x53, 0x7a, 0x52, 0xd6, 0x9f, 0x4d, 0xd3, 0x7a, 0x6c, 0x29, 0xc2, 0x7d,
+	0xd4, 0xf0, 0x20, 0x00, 0x01, 0x8e, 0x36, 0xb9, 0xad, 0x92, 0xf6, 0xca, 0x49, 0x03, 0xa5, 0x28,
+	0x59, 0x59, 0x5e, 0x1e, 0x93, 0x86, 0xe2, 0x2a, 0x24, 0xeb, 0x26, 0xc3, 0xe1, 0x55, 0x98, 0x19,
+	0x8a, 0xab, 0x10, 0x3f, 0x41, 0xc8, 0x8d, 0x26, 0x31, 0x75, 0x85, 0xfc, 0x7e, 0x77, 0x54, 0xca,
+	0xcb, 0xe5, 0x94, 0x7e, 0xb6, 0x9e, 0x66, 0x16, 0x52, 0xf0, 0x7b, 0xc8, 0x0a, 0x80, 0x72, 0x70,
+	0x46, 0x8c, 0x86, 0x82, 0x6c, 0x98, 0x08, 0xa7, 0x52, 0x70, 0x2c, 0xd7, 0x33, 0x42, 0x90, 0x85,
+	0xe4, 0x3b, 0x6b, 0x02, 0x83, 0x59, 0x74, 0x09, 0xa4, 0x6e, 0x7a, 0x67, 0x85, 0xb0, 0x95, 0x20,
+	0x7b, 0xe7, 0x20, 0x8f, 0xc9, 0x6d, 0xa1, 0x01, 0x65, 0x13, 0x82, 0x4c, 0xdb, 0xd2, 0x93, 0x4b,
+	0xd9, 0xb6, 0x28, 0x21, 0xfe, 0x10, 0x35, 0xb5, 0xad, 0x3b, 0x06, 0xf7, 0x32, 0x8e, 0xfc, 0x50,
+	0x10, 0x4b, 0x25, 0xbf, 0x62, 0xb0, 0xee, 0x67, 0xa2, 0x14, 0xb3, 0x1d, 0x94, 0xe3, 0xd8, 0x46,
+	0x77, 0xf3, 0xef, 0xe2, 0xb8, 0x51, 0x78, 0xe1, 0x8f, 0x48, 0x43, 0x11, 0x1f, 0x2d, 0xfb, 0xa2,
+	0x7d, 0xa5, 0x4a, 0x91, 0x4d, 0x77, 0x6e, 0x01, 0x3f, 0x46, 0xeb, 0x63, 0x35, 0x17, 0xc4, 0x53,
+	0xa0, 0x5d, 0x63, 0x63, 0xea, 0xd1, 0xb1, 0x13, 0x29, 0xee, 0x21, 0x4b, 0x8d, 0x05, 0x84, 0xf4,
+	0x3c, 0x00, 0xf2, 0x8f, 0x71, 0x57, 0x7b, 0x53, 0x31, 0x3e, 0x52, 0x82, 0x6c, 0x4f, 0x68, 0x16,
+	0xc2, 0x03, 0xa4, 0x86, 0xc8, 0xf1, 0x7c, 0xae, 0x18, 0xff, 0xde, 0x31, 0x6d, 0x8a, 0x64, 0x0c,
+	0x7c, 0x5e, 0x84, 0x58, 0x34, 0x8f, 0xe1, 0x67, 0x9a, 0x02, 0xa1, 0xf0, 0x5d, 0x2a, 0x80, 0xfc,
+	0xa7, 0x29, 0xaf, 0x97, 0x29, 0xe9, 0x70, 0xf6, 0x0a, 0xd2, 0x14, 0x57, 0xca, 0xc7, 0x47, 0xc9,
+	0xbc, 0x4f, 0x39, 0x30, 0x87, 0x7a, 0x1e, 0xf9, 0x69, 0x63, 0x59, 0x59, 0x1f, 0x71, 0x60, 0x3d,
+	0xcf, 0x2b, 0x95, 0x95, 0xc4, 0xf0, 0x33, 0xd4, 0xcc, 0x31, 0x7a, 0x70, 0xc8, 0xcf, 0x9a, 0xb4,
+	0x6f, 0x26, 0x25, 0x13, 0x97, 0xc0, 0xb6, 0x68, 0x29, 0x5c, 0x2e, 0x6b, 0x04, 0x82, 0xfc, 0xb2,
+	0xb2, 0xac, 0x63, 0x10, 0x0b, 0x65, 0x1d, 0x83, 0xc0, 0x23, 0xf4, 0x62, 0x8e, 0x71, 0xc7, 0x72,
+	0x94, 0x9d, 0x98, 0x72, 0xfe, 0x65, 0xc4, 0x3c, 0xf2, 0xab, 0x46, 0xbe, 0x69, 0x46, 0xf6, 0x95,
+	0xfa, 0x2c, 0x11, 0xa7, 0xf4, 0x07, 0xd4, 0xb8, 0x8c, 0x3f, 0x46, 0x3b, 0x85, 0x7a, 0xe5, 0x0c,
+	0x3a, 0x2c, 0x0a, 0x80, 0x5c, 0x6b, 0x8f, 0x57, 0x97, 0x94, 0xad, 0xe6, 0x37, 0xca, 0xb7, 0xfa,
+	0x2e, 0x9d, 0x5f, 0xc1, 0x9f, 0xa2, 0xfb, 0x39, 0x59, 0x8f, 0xb3, 0x46, 0xff, 0xa6, 0xd1, 0xaf,
+	0x99, 0xd1, 0xc9, 0x5c, 0x17, 0xd8, 0x98, 0x2e, 0x2c, 0xe1, 0xa7, 0x68, 0x2b, 0x87, 0x07, 0x3e,
+	0x17, 0xe4, 0x77, 0x4d, 0x7d, 0x68, 0xa6, 0x9e, 0xfa, 0x5c, 0x94, 0xfa, 0x28, 0x0d, 0x66, 0x24,
+	0x59, 0x9a, 0x26, 0xfd, 0xb1, 0x94, 0x24, 0xad, 0x17, 0x48, 0x69, 0x30, 0xdb, 0x7a, 0x45, 0x92,
+	0x1d, 0xf9, 0x6d, 0x7d, 0xd9, 0xd6, 0xcb, 0x9c, 0xf9, 0x8e, 0x4c, 0x62, 0x59, 0x47, 0x2a, 0x4c,
+	0xd2, 0x91, 0xdf, 0xd5, 0x97, 0x75, 0xa4, 0xcc, 0x32, 0x74, 0x64, 0x1e, 0x2e, 0x97, 0x25, 0x3b,
+	0xf2, 0xfb, 0x95, 0x65, 0xcd, 0x77, 0x64, 0x12, 0xc3, 0x9f, 0xa3, 0x56, 0x01, 0xa3, 0x1a, 0x25,
+	0x06, 0x36, 0xf1, 0xb9, 0x3a, 0x6c, 0x7f, 0xd0, 0xcc, 0xb7, 0x96, 0x30, 0xa5, 0xfc, 0x2c, 0x53,
+	0xa7, 0xfc, 0x17, 0xa8, 0x79, 0x1d, 0x4f, 0xd0, 0x6e, 0xee, 0x95, 0xb4, 0x4e, 0xc1, 0xec, 0x47,
+	0x6d, 0xf6, 0xb6, 0xd9, 0x4c, 0x77, 0xc9, 0xa2, 0x1b, 0xa1, 0x4b, 0x04, 0x9d, 0x6d, 0xb4, 0x79,
+	0x34, 0x89, 0xc5, 0x57, 0x36, 0xf0, 0x38, 0x0a, 0x39, 0x74, 0x62, 0xb4, 0xbb, 0xe2, 0x8f, 0x08,
+	0x63, 0xb4, 0xa6, 0xae, 0x20, 0x15, 0x75, 0x05, 0x51, 0xcf, 0xf2, 0x6a, 0x92, 0xcd, 0x67, 0x72,
+	0x35, 0x49, 0x7f, 0xe3, 0x87, 0xa8, 0xc1, 0xfd, 0x49, 0x1c, 0x80, 0x23, 0xa2, 0x4b, 0xd0, 0x37,
+	0x93, 0xba, 0x6d, 0xe9, 0xd8, 0x50, 0x86, 0x3a, 0x1f, 0xa0, 0xed, 0xb9, 0xa3, 0xa5, 0x70, 0x27,
+	0xa9, 0xa9, 0x3b, 0xc9, 0x3e, 0xda, 0x64, 0x30, 0xa1, 0x7e, 0xe8, 0x87, 0x23, 0x67, 0x38, 0x3c,
+	0x55, 0x36, 0x35, 0xbb, 0x91, 0x05, 0x87, 0xc3, 0xd3, 0xce, 0x27, 0xe8, 0x81, 0xf9, 0x88, 0xc2,
+	0x4f, 0x90, 0x95, 0x1f, 0x6e, 0x9c, 0x54, 0xf6, 0x6a, 0x07, 0x56, 0xf7, 0xa5, 0xd5, 0xa7, 0x5b,
+	0x31, 0xe3, 0xfd, 0x9d, 0xe7, 0x7f, 0xb5, 0x6f, 0x3d, 0xbf, 0x69, 0x57, 0xae, 0x6f, 0xda, 0x95,
+	0x3f, 0x6f, 0xda, 0x95, 0x6f, 0xfe, 0x6e, 0xdf, 0x3a, 0x5f, 0x57, 0x57, 0xb7, 0xc7, 0xff, 0x0f,
+	0x00, 0xee, 0x86, 0xe5, 0xc5, 0x12, 0x0a, 0x00, 0x00,
 }
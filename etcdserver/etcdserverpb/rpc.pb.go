@@ -31,20 +31,23 @@ var _ = math.Inf
 type AlarmType int32
 
 const (
-	AlarmType_NONE    AlarmType = 0
-	AlarmType_NOSPACE AlarmType = 1
-	AlarmType_CORRUPT AlarmType = 2
+	AlarmType_NONE        AlarmType = 0
+	AlarmType_NOSPACE     AlarmType = 1
+	AlarmType_CORRUPT     AlarmType = 2
+	AlarmType_MAINTENANCE AlarmType = 3
 )
 
 var AlarmType_name = map[int32]string{
 	0: "NONE",
 	1: "NOSPACE",
 	2: "CORRUPT",
+	3: "MAINTENANCE",
 }
 var AlarmType_value = map[string]int32{
-	"NONE":    0,
-	"NOSPACE": 1,
-	"CORRUPT": 2,
+	"NONE":        0,
+	"NOSPACE":     1,
+	"CORRUPT":     2,
+	"MAINTENANCE": 3,
 }
 
 func (x AlarmType) String() string {
@@ -211,7 +214,7 @@ func (x AlarmRequest_AlarmAction) String() string {
 	return proto.EnumName(AlarmRequest_AlarmAction_name, int32(x))
 }
 func (AlarmRequest_AlarmAction) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{48, 0}
+	return fileDescriptorRpc, []int{52, 0}
 }
 
 type ResponseHeader struct {
@@ -1628,6 +1631,10 @@ type WatchCreateRequest struct {
 	WatchId int64 `protobuf:"varint,7,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
 	// fragment enables splitting large revisions into multiple watch responses.
 	Fragment bool `protobuf:"varint,8,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	// coalesce is set so that the etcd server only sends the latest event of
+	// each key in a watch response, dropping intermediate revisions. Responses
+	// queued while the client is behind are merged before they are sent.
+	Coalesce bool `protobuf:"varint,9,opt,name=coalesce,proto3" json:"coalesce,omitempty"`
 }
 
 func (m *WatchCreateRequest) Reset()                    { *m = WatchCreateRequest{} }
@@ -1691,6 +1698,13 @@ func (m *WatchCreateRequest) GetFragment() bool {
 	return false
 }
 
+func (m *WatchCreateRequest) GetCoalesce() bool {
+	if m != nil {
+		return m.Coalesce
+	}
+	return false
+}
+
 type WatchCancelRequest struct {
 	// watch_id is the watcher id to cancel so that no more events are transmitted.
 	WatchId int64 `protobuf:"varint,1,opt,name=watch_id,json=watchId,proto3" json:"watch_id,omitempty"`
@@ -1732,8 +1746,11 @@ type WatchResponse struct {
 	// cancel_reason indicates the reason for canceling the watcher.
 	CancelReason string `protobuf:"bytes,6,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"`
 	// framgment is true if large watch response was split over multiple responses.
-	Fragment bool            `protobuf:"varint,7,opt,name=fragment,proto3" json:"fragment,omitempty"`
-	Events   []*mvccpb.Event `protobuf:"bytes,11,rep,name=events" json:"events,omitempty"`
+	Fragment bool `protobuf:"varint,7,opt,name=fragment,proto3" json:"fragment,omitempty"`
+	// coalesced is true if events were dropped from a coalescing watch
+	// response because a later event on the same key superseded them.
+	Coalesced bool            `protobuf:"varint,8,opt,name=coalesced,proto3" json:"coalesced,omitempty"`
+	Events    []*mvccpb.Event `protobuf:"bytes,11,rep,name=events" json:"events,omitempty"`
 }
 
 func (m *WatchResponse) Reset()                    { *m = WatchResponse{} }
@@ -1790,6 +1807,13 @@ func (m *WatchResponse) GetFragment() bool {
 	return false
 }
 
+func (m *WatchResponse) GetCoalesced() bool {
+	if m != nil {
+		return m.Coalesced
+	}
+	return false
+}
+
 func (m *WatchResponse) GetEvents() []*mvccpb.Event {
 	if m != nil {
 		return m.Events
@@ -2353,6 +2377,131 @@ func (m *MoveLeaderResponse) GetHeader() *ResponseHeader {
 	return nil
 }
 
+type RevisionTimeRequest struct {
+	// revision is the revision to look up the commit time of. If revision is 0,
+	// the latest revision committed at or before time is looked up instead.
+	Revision int64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	// time is the time, in Unix nanoseconds, to look up the latest revision
+	// committed at or before.
+	Time int64 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *RevisionTimeRequest) Reset()                    { *m = RevisionTimeRequest{} }
+func (m *RevisionTimeRequest) String() string            { return proto.CompactTextString(m) }
+func (*RevisionTimeRequest) ProtoMessage()               {}
+func (*RevisionTimeRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
+
+func (m *RevisionTimeRequest) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *RevisionTimeRequest) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type RevisionTimeResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// revision is the revision looked up.
+	Revision int64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// time is the time, in Unix nanoseconds, the member committed the revision at.
+	Time int64 `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *RevisionTimeResponse) Reset()                    { *m = RevisionTimeResponse{} }
+func (m *RevisionTimeResponse) String() string            { return proto.CompactTextString(m) }
+func (*RevisionTimeResponse) ProtoMessage()               {}
+func (*RevisionTimeResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
+
+func (m *RevisionTimeResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *RevisionTimeResponse) GetRevision() int64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *RevisionTimeResponse) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+type CompactionConfigRequest struct {
+	// mode is the auto-compaction mode, either "periodic" or "revision". If mode
+	// is empty, the configuration is left unchanged and only returned.
+	Mode string `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	// retention is the duration, in nanoseconds, of history to keep in "periodic"
+	// mode, or the number of revisions to keep in "revision" mode. A retention of
+	// 0 disables auto-compaction.
+	Retention int64 `protobuf:"varint,2,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (m *CompactionConfigRequest) Reset()                    { *m = CompactionConfigRequest{} }
+func (m *CompactionConfigRequest) String() string            { return proto.CompactTextString(m) }
+func (*CompactionConfigRequest) ProtoMessage()               {}
+func (*CompactionConfigRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
+
+func (m *CompactionConfigRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *CompactionConfigRequest) GetRetention() int64 {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
+type CompactionConfigResponse struct {
+	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
+	// mode is the auto-compaction mode in effect.
+	Mode string `protobuf:"bytes,2,opt,name=mode,proto3" json:"mode,omitempty"`
+	// retention is the auto-compaction retention in effect.
+	Retention int64 `protobuf:"varint,3,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (m *CompactionConfigResponse) Reset()                    { *m = CompactionConfigResponse{} }
+func (m *CompactionConfigResponse) String() string            { return proto.CompactTextString(m) }
+func (*CompactionConfigResponse) ProtoMessage()               {}
+func (*CompactionConfigResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
+
+func (m *CompactionConfigResponse) GetHeader() *ResponseHeader {
+	if m != nil {
+		return m.Header
+	}
+	return nil
+}
+
+func (m *CompactionConfigResponse) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *CompactionConfigResponse) GetRetention() int64 {
+	if m != nil {
+		return m.Retention
+	}
+	return 0
+}
+
 type AlarmRequest struct {
 	// action is the kind of alarm request to issue. The action
 	// may GET alarm statuses, ACTIVATE an alarm, or DEACTIVATE a
@@ -2368,7 +2517,7 @@ type AlarmRequest struct {
 func (m *AlarmRequest) Reset()                    { *m = AlarmRequest{} }
 func (m *AlarmRequest) String() string            { return proto.CompactTextString(m) }
 func (*AlarmRequest) ProtoMessage()               {}
-func (*AlarmRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{48} }
+func (*AlarmRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
 
 func (m *AlarmRequest) GetAction() AlarmRequest_AlarmAction {
 	if m != nil {
@@ -2401,7 +2550,7 @@ type AlarmMember struct {
 func (m *AlarmMember) Reset()                    { *m = AlarmMember{} }
 func (m *AlarmMember) String() string            { return proto.CompactTextString(m) }
 func (*AlarmMember) ProtoMessage()               {}
-func (*AlarmMember) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{49} }
+func (*AlarmMember) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
 
 func (m *AlarmMember) GetMemberID() uint64 {
 	if m != nil {
@@ -2426,7 +2575,7 @@ type AlarmResponse struct {
 func (m *AlarmResponse) Reset()                    { *m = AlarmResponse{} }
 func (m *AlarmResponse) String() string            { return proto.CompactTextString(m) }
 func (*AlarmResponse) ProtoMessage()               {}
-func (*AlarmResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{50} }
+func (*AlarmResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
 
 func (m *AlarmResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2448,7 +2597,7 @@ type StatusRequest struct {
 func (m *StatusRequest) Reset()                    { *m = StatusRequest{} }
 func (m *StatusRequest) String() string            { return proto.CompactTextString(m) }
 func (*StatusRequest) ProtoMessage()               {}
-func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{51} }
+func (*StatusRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
 
 type StatusResponse struct {
 	Header *ResponseHeader `protobuf:"bytes,1,opt,name=header" json:"header,omitempty"`
@@ -2473,7 +2622,7 @@ type StatusResponse struct {
 func (m *StatusResponse) Reset()                    { *m = StatusResponse{} }
 func (m *StatusResponse) String() string            { return proto.CompactTextString(m) }
 func (*StatusResponse) ProtoMessage()               {}
-func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{52} }
+func (*StatusResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
 
 func (m *StatusResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2544,7 +2693,7 @@ type AuthEnableRequest struct {
 func (m *AuthEnableRequest) Reset()                    { *m = AuthEnableRequest{} }
 func (m *AuthEnableRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthEnableRequest) ProtoMessage()               {}
-func (*AuthEnableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{53} }
+func (*AuthEnableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
 
 type AuthDisableRequest struct {
 }
@@ -2552,7 +2701,7 @@ type AuthDisableRequest struct {
 func (m *AuthDisableRequest) Reset()                    { *m = AuthDisableRequest{} }
 func (m *AuthDisableRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthDisableRequest) ProtoMessage()               {}
-func (*AuthDisableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{54} }
+func (*AuthDisableRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{58} }
 
 type AuthenticateRequest struct {
 	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -2562,7 +2711,7 @@ type AuthenticateRequest struct {
 func (m *AuthenticateRequest) Reset()                    { *m = AuthenticateRequest{} }
 func (m *AuthenticateRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthenticateRequest) ProtoMessage()               {}
-func (*AuthenticateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{55} }
+func (*AuthenticateRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{59} }
 
 func (m *AuthenticateRequest) GetName() string {
 	if m != nil {
@@ -2586,7 +2735,7 @@ type AuthUserAddRequest struct {
 func (m *AuthUserAddRequest) Reset()                    { *m = AuthUserAddRequest{} }
 func (m *AuthUserAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserAddRequest) ProtoMessage()               {}
-func (*AuthUserAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{56} }
+func (*AuthUserAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
 
 func (m *AuthUserAddRequest) GetName() string {
 	if m != nil {
@@ -2609,7 +2758,7 @@ type AuthUserGetRequest struct {
 func (m *AuthUserGetRequest) Reset()                    { *m = AuthUserGetRequest{} }
 func (m *AuthUserGetRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGetRequest) ProtoMessage()               {}
-func (*AuthUserGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{57} }
+func (*AuthUserGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
 
 func (m *AuthUserGetRequest) GetName() string {
 	if m != nil {
@@ -2626,7 +2775,7 @@ type AuthUserDeleteRequest struct {
 func (m *AuthUserDeleteRequest) Reset()                    { *m = AuthUserDeleteRequest{} }
 func (m *AuthUserDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserDeleteRequest) ProtoMessage()               {}
-func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{58} }
+func (*AuthUserDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
 
 func (m *AuthUserDeleteRequest) GetName() string {
 	if m != nil {
@@ -2646,7 +2795,7 @@ func (m *AuthUserChangePasswordRequest) Reset()         { *m = AuthUserChangePas
 func (m *AuthUserChangePasswordRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthUserChangePasswordRequest) ProtoMessage()    {}
 func (*AuthUserChangePasswordRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{59}
+	return fileDescriptorRpc, []int{63}
 }
 
 func (m *AuthUserChangePasswordRequest) GetName() string {
@@ -2673,7 +2822,7 @@ type AuthUserGrantRoleRequest struct {
 func (m *AuthUserGrantRoleRequest) Reset()                    { *m = AuthUserGrantRoleRequest{} }
 func (m *AuthUserGrantRoleRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGrantRoleRequest) ProtoMessage()               {}
-func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{60} }
+func (*AuthUserGrantRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{64} }
 
 func (m *AuthUserGrantRoleRequest) GetUser() string {
 	if m != nil {
@@ -2697,7 +2846,7 @@ type AuthUserRevokeRoleRequest struct {
 func (m *AuthUserRevokeRoleRequest) Reset()                    { *m = AuthUserRevokeRoleRequest{} }
 func (m *AuthUserRevokeRoleRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserRevokeRoleRequest) ProtoMessage()               {}
-func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{61} }
+func (*AuthUserRevokeRoleRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
 
 func (m *AuthUserRevokeRoleRequest) GetName() string {
 	if m != nil {
@@ -2721,7 +2870,7 @@ type AuthRoleAddRequest struct {
 func (m *AuthRoleAddRequest) Reset()                    { *m = AuthRoleAddRequest{} }
 func (m *AuthRoleAddRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleAddRequest) ProtoMessage()               {}
-func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{62} }
+func (*AuthRoleAddRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{66} }
 
 func (m *AuthRoleAddRequest) GetName() string {
 	if m != nil {
@@ -2737,7 +2886,7 @@ type AuthRoleGetRequest struct {
 func (m *AuthRoleGetRequest) Reset()                    { *m = AuthRoleGetRequest{} }
 func (m *AuthRoleGetRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleGetRequest) ProtoMessage()               {}
-func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{63} }
+func (*AuthRoleGetRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{67} }
 
 func (m *AuthRoleGetRequest) GetRole() string {
 	if m != nil {
@@ -2752,7 +2901,7 @@ type AuthUserListRequest struct {
 func (m *AuthUserListRequest) Reset()                    { *m = AuthUserListRequest{} }
 func (m *AuthUserListRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserListRequest) ProtoMessage()               {}
-func (*AuthUserListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{64} }
+func (*AuthUserListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{68} }
 
 type AuthRoleListRequest struct {
 }
@@ -2760,7 +2909,7 @@ type AuthRoleListRequest struct {
 func (m *AuthRoleListRequest) Reset()                    { *m = AuthRoleListRequest{} }
 func (m *AuthRoleListRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleListRequest) ProtoMessage()               {}
-func (*AuthRoleListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{65} }
+func (*AuthRoleListRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
 
 type AuthRoleDeleteRequest struct {
 	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
@@ -2769,7 +2918,7 @@ type AuthRoleDeleteRequest struct {
 func (m *AuthRoleDeleteRequest) Reset()                    { *m = AuthRoleDeleteRequest{} }
 func (m *AuthRoleDeleteRequest) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleDeleteRequest) ProtoMessage()               {}
-func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{66} }
+func (*AuthRoleDeleteRequest) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
 
 func (m *AuthRoleDeleteRequest) GetRole() string {
 	if m != nil {
@@ -2789,7 +2938,7 @@ func (m *AuthRoleGrantPermissionRequest) Reset()         { *m = AuthRoleGrantPer
 func (m *AuthRoleGrantPermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleGrantPermissionRequest) ProtoMessage()    {}
 func (*AuthRoleGrantPermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{67}
+	return fileDescriptorRpc, []int{71}
 }
 
 func (m *AuthRoleGrantPermissionRequest) GetName() string {
@@ -2816,7 +2965,7 @@ func (m *AuthRoleRevokePermissionRequest) Reset()         { *m = AuthRoleRevokeP
 func (m *AuthRoleRevokePermissionRequest) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleRevokePermissionRequest) ProtoMessage()    {}
 func (*AuthRoleRevokePermissionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{68}
+	return fileDescriptorRpc, []int{72}
 }
 
 func (m *AuthRoleRevokePermissionRequest) GetRole() string {
@@ -2847,7 +2996,7 @@ type AuthEnableResponse struct {
 func (m *AuthEnableResponse) Reset()                    { *m = AuthEnableResponse{} }
 func (m *AuthEnableResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthEnableResponse) ProtoMessage()               {}
-func (*AuthEnableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{69} }
+func (*AuthEnableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{73} }
 
 func (m *AuthEnableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2863,7 +3012,7 @@ type AuthDisableResponse struct {
 func (m *AuthDisableResponse) Reset()                    { *m = AuthDisableResponse{} }
 func (m *AuthDisableResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthDisableResponse) ProtoMessage()               {}
-func (*AuthDisableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{70} }
+func (*AuthDisableResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{74} }
 
 func (m *AuthDisableResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2881,7 +3030,7 @@ type AuthenticateResponse struct {
 func (m *AuthenticateResponse) Reset()                    { *m = AuthenticateResponse{} }
 func (m *AuthenticateResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthenticateResponse) ProtoMessage()               {}
-func (*AuthenticateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{71} }
+func (*AuthenticateResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{75} }
 
 func (m *AuthenticateResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2904,7 +3053,7 @@ type AuthUserAddResponse struct {
 func (m *AuthUserAddResponse) Reset()                    { *m = AuthUserAddResponse{} }
 func (m *AuthUserAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserAddResponse) ProtoMessage()               {}
-func (*AuthUserAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{72} }
+func (*AuthUserAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{76} }
 
 func (m *AuthUserAddResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2921,7 +3070,7 @@ type AuthUserGetResponse struct {
 func (m *AuthUserGetResponse) Reset()                    { *m = AuthUserGetResponse{} }
 func (m *AuthUserGetResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGetResponse) ProtoMessage()               {}
-func (*AuthUserGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{73} }
+func (*AuthUserGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{77} }
 
 func (m *AuthUserGetResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2944,7 +3093,7 @@ type AuthUserDeleteResponse struct {
 func (m *AuthUserDeleteResponse) Reset()                    { *m = AuthUserDeleteResponse{} }
 func (m *AuthUserDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserDeleteResponse) ProtoMessage()               {}
-func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{74} }
+func (*AuthUserDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{78} }
 
 func (m *AuthUserDeleteResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2961,7 +3110,7 @@ func (m *AuthUserChangePasswordResponse) Reset()         { *m = AuthUserChangePa
 func (m *AuthUserChangePasswordResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthUserChangePasswordResponse) ProtoMessage()    {}
 func (*AuthUserChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{75}
+	return fileDescriptorRpc, []int{79}
 }
 
 func (m *AuthUserChangePasswordResponse) GetHeader() *ResponseHeader {
@@ -2978,7 +3127,7 @@ type AuthUserGrantRoleResponse struct {
 func (m *AuthUserGrantRoleResponse) Reset()                    { *m = AuthUserGrantRoleResponse{} }
 func (m *AuthUserGrantRoleResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserGrantRoleResponse) ProtoMessage()               {}
-func (*AuthUserGrantRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{76} }
+func (*AuthUserGrantRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{80} }
 
 func (m *AuthUserGrantRoleResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -2994,7 +3143,7 @@ type AuthUserRevokeRoleResponse struct {
 func (m *AuthUserRevokeRoleResponse) Reset()                    { *m = AuthUserRevokeRoleResponse{} }
 func (m *AuthUserRevokeRoleResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserRevokeRoleResponse) ProtoMessage()               {}
-func (*AuthUserRevokeRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{77} }
+func (*AuthUserRevokeRoleResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{81} }
 
 func (m *AuthUserRevokeRoleResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3010,7 +3159,7 @@ type AuthRoleAddResponse struct {
 func (m *AuthRoleAddResponse) Reset()                    { *m = AuthRoleAddResponse{} }
 func (m *AuthRoleAddResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleAddResponse) ProtoMessage()               {}
-func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{78} }
+func (*AuthRoleAddResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{82} }
 
 func (m *AuthRoleAddResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3027,7 +3176,7 @@ type AuthRoleGetResponse struct {
 func (m *AuthRoleGetResponse) Reset()                    { *m = AuthRoleGetResponse{} }
 func (m *AuthRoleGetResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleGetResponse) ProtoMessage()               {}
-func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{79} }
+func (*AuthRoleGetResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{83} }
 
 func (m *AuthRoleGetResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3051,7 +3200,7 @@ type AuthRoleListResponse struct {
 func (m *AuthRoleListResponse) Reset()                    { *m = AuthRoleListResponse{} }
 func (m *AuthRoleListResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleListResponse) ProtoMessage()               {}
-func (*AuthRoleListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{80} }
+func (*AuthRoleListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{84} }
 
 func (m *AuthRoleListResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3075,7 +3224,7 @@ type AuthUserListResponse struct {
 func (m *AuthUserListResponse) Reset()                    { *m = AuthUserListResponse{} }
 func (m *AuthUserListResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthUserListResponse) ProtoMessage()               {}
-func (*AuthUserListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{81} }
+func (*AuthUserListResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{85} }
 
 func (m *AuthUserListResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3098,7 +3247,7 @@ type AuthRoleDeleteResponse struct {
 func (m *AuthRoleDeleteResponse) Reset()                    { *m = AuthRoleDeleteResponse{} }
 func (m *AuthRoleDeleteResponse) String() string            { return proto.CompactTextString(m) }
 func (*AuthRoleDeleteResponse) ProtoMessage()               {}
-func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{82} }
+func (*AuthRoleDeleteResponse) Descriptor() ([]byte, []int) { return fileDescriptorRpc, []int{86} }
 
 func (m *AuthRoleDeleteResponse) GetHeader() *ResponseHeader {
 	if m != nil {
@@ -3115,7 +3264,7 @@ func (m *AuthRoleGrantPermissionResponse) Reset()         { *m = AuthRoleGrantPe
 func (m *AuthRoleGrantPermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleGrantPermissionResponse) ProtoMessage()    {}
 func (*AuthRoleGrantPermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{83}
+	return fileDescriptorRpc, []int{87}
 }
 
 func (m *AuthRoleGrantPermissionResponse) GetHeader() *ResponseHeader {
@@ -3133,7 +3282,7 @@ func (m *AuthRoleRevokePermissionResponse) Reset()         { *m = AuthRoleRevoke
 func (m *AuthRoleRevokePermissionResponse) String() string { return proto.CompactTextString(m) }
 func (*AuthRoleRevokePermissionResponse) ProtoMessage()    {}
 func (*AuthRoleRevokePermissionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptorRpc, []int{84}
+	return fileDescriptorRpc, []int{88}
 }
 
 func (m *AuthRoleRevokePermissionResponse) GetHeader() *ResponseHeader {
@@ -3192,6 +3341,10 @@ func init() {
 	proto.RegisterType((*DefragmentResponse)(nil), "etcdserverpb.DefragmentResponse")
 	proto.RegisterType((*MoveLeaderRequest)(nil), "etcdserverpb.MoveLeaderRequest")
 	proto.RegisterType((*MoveLeaderResponse)(nil), "etcdserverpb.MoveLeaderResponse")
+	proto.RegisterType((*RevisionTimeRequest)(nil), "etcdserverpb.RevisionTimeRequest")
+	proto.RegisterType((*RevisionTimeResponse)(nil), "etcdserverpb.RevisionTimeResponse")
+	proto.RegisterType((*CompactionConfigRequest)(nil), "etcdserverpb.CompactionConfigRequest")
+	proto.RegisterType((*CompactionConfigResponse)(nil), "etcdserverpb.CompactionConfigResponse")
 	proto.RegisterType((*AlarmRequest)(nil), "etcdserverpb.AlarmRequest")
 	proto.RegisterType((*AlarmMember)(nil), "etcdserverpb.AlarmMember")
 	proto.RegisterType((*AlarmResponse)(nil), "etcdserverpb.AlarmResponse")
@@ -4015,6 +4168,15 @@ type MaintenanceClient interface {
 	Snapshot(ctx context.Context, in *SnapshotRequest, opts ...grpc.CallOption) (Maintenance_SnapshotClient, error)
 	// MoveLeader requests current leader node to transfer its leadership to transferee.
 	MoveLeader(ctx context.Context, in *MoveLeaderRequest, opts ...grpc.CallOption) (*MoveLeaderResponse, error)
+	// RevisionTime looks up the time the member committed a revision at, or the
+	// latest revision committed at or before a time. The member must be started
+	// with --experimental-revision-times.
+	RevisionTime(ctx context.Context, in *RevisionTimeRequest, opts ...grpc.CallOption) (*RevisionTimeResponse, error)
+	// CompactionConfig changes the auto-compaction mode and retention of the
+	// cluster. The configuration is replicated through raft and persisted in the
+	// backend, so it overrides --auto-compaction-mode and
+	// --auto-compaction-retention on every member, including after a restart.
+	CompactionConfig(ctx context.Context, in *CompactionConfigRequest, opts ...grpc.CallOption) (*CompactionConfigResponse, error)
 }
 
 type maintenanceClient struct {
@@ -4111,6 +4273,24 @@ func (c *maintenanceClient) MoveLeader(ctx context.Context, in *MoveLeaderReques
 	return out, nil
 }
 
+func (c *maintenanceClient) RevisionTime(ctx context.Context, in *RevisionTimeRequest, opts ...grpc.CallOption) (*RevisionTimeResponse, error) {
+	out := new(RevisionTimeResponse)
+	err := grpc.Invoke(ctx, "/etcdserverpb.Maintenance/RevisionTime", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *maintenanceClient) CompactionConfig(ctx context.Context, in *CompactionConfigRequest, opts ...grpc.CallOption) (*CompactionConfigResponse, error) {
+	out := new(CompactionConfigResponse)
+	err := grpc.Invoke(ctx, "/etcdserverpb.Maintenance/CompactionConfig", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Maintenance service
 
 type MaintenanceServer interface {
@@ -4134,6 +4314,15 @@ type MaintenanceServer interface {
 	Snapshot(*SnapshotRequest, Maintenance_SnapshotServer) error
 	// MoveLeader requests current leader node to transfer its leadership to transferee.
 	MoveLeader(context.Context, *MoveLeaderRequest) (*MoveLeaderResponse, error)
+	// RevisionTime looks up the time the member committed a revision at, or the
+	// latest revision committed at or before a time. The member must be started
+	// with --experimental-revision-times.
+	RevisionTime(context.Context, *RevisionTimeRequest) (*RevisionTimeResponse, error)
+	// CompactionConfig changes the auto-compaction mode and retention of the
+	// cluster. The configuration is replicated through raft and persisted in the
+	// backend, so it overrides --auto-compaction-mode and
+	// --auto-compaction-retention on every member, including after a restart.
+	CompactionConfig(context.Context, *CompactionConfigRequest) (*CompactionConfigResponse, error)
 }
 
 func RegisterMaintenanceServer(s *grpc.Server, srv MaintenanceServer) {
@@ -4269,6 +4458,42 @@ func _Maintenance_MoveLeader_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Maintenance_RevisionTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevisionTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).RevisionTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Maintenance/RevisionTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).RevisionTime(ctx, req.(*RevisionTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Maintenance_CompactionConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactionConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MaintenanceServer).CompactionConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/etcdserverpb.Maintenance/CompactionConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MaintenanceServer).CompactionConfig(ctx, req.(*CompactionConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Maintenance_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "etcdserverpb.Maintenance",
 	HandlerType: (*MaintenanceServer)(nil),
@@ -4297,6 +4522,14 @@ var _Maintenance_serviceDesc = grpc.ServiceDesc{
 			MethodName: "MoveLeader",
 			Handler:    _Maintenance_MoveLeader_Handler,
 		},
+		{
+			MethodName: "RevisionTime",
+			Handler:    _Maintenance_RevisionTime_Handler,
+		},
+		{
+			MethodName: "CompactionConfig",
+			Handler:    _Maintenance_CompactionConfig_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -6000,6 +6233,16 @@ func (m *WatchCreateRequest) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Coalesce {
+		dAtA[i] = 0x48
+		i++
+		if m.Coalesce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	return i, nil
 }
 
@@ -6097,6 +6340,16 @@ func (m *WatchResponse) MarshalTo(dAtA []byte) (int, error) {
 		}
 		i++
 	}
+	if m.Coalesced {
+		dAtA[i] = 0x40
+		i++
+		if m.Coalesced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i++
+	}
 	if len(m.Events) > 0 {
 		for _, msg := range m.Events {
 			dAtA[i] = 0x5a
@@ -6899,6 +7152,140 @@ func (m *MoveLeaderResponse) MarshalTo(dAtA []byte) (int, error) {
 	return i, nil
 }
 
+func (m *RevisionTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		dAtA[i] = 0x8
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Revision))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func (m *RevisionTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevisionTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
+		n39, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.Revision != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Revision))
+	}
+	if m.Time != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Time))
+	}
+	return i, nil
+}
+
+func (m *CompactionConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactionConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
+	}
+	if m.Retention != 0 {
+		dAtA[i] = 0x10
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Retention))
+	}
+	return i, nil
+}
+
+func (m *CompactionConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalTo(dAtA)
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CompactionConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	if m.Header != nil {
+		dAtA[i] = 0xa
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
+		n40, err := m.Header.MarshalTo(dAtA[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
+	if len(m.Mode) > 0 {
+		dAtA[i] = 0x12
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(len(m.Mode)))
+		i += copy(dAtA[i:], m.Mode)
+	}
+	if m.Retention != 0 {
+		dAtA[i] = 0x18
+		i++
+		i = encodeVarintRpc(dAtA, i, uint64(m.Retention))
+	}
+	return i, nil
+}
+
 func (m *AlarmRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6979,11 +7366,11 @@ func (m *AlarmResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n39, err := m.Header.MarshalTo(dAtA[i:])
+		n41, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n41
 	}
 	if len(m.Alarms) > 0 {
 		for _, msg := range m.Alarms {
@@ -7037,11 +7424,11 @@ func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n40, err := m.Header.MarshalTo(dAtA[i:])
+		n42, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n42
 	}
 	if len(m.Version) > 0 {
 		dAtA[i] = 0x12
@@ -7464,11 +7851,11 @@ func (m *AuthRoleGrantPermissionRequest) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0x12
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Perm.Size()))
-		n41, err := m.Perm.MarshalTo(dAtA[i:])
+		n43, err := m.Perm.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n43
 	}
 	return i, nil
 }
@@ -7528,11 +7915,11 @@ func (m *AuthEnableResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n42, err := m.Header.MarshalTo(dAtA[i:])
+		n44, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n44
 	}
 	return i, nil
 }
@@ -7556,11 +7943,11 @@ func (m *AuthDisableResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n43, err := m.Header.MarshalTo(dAtA[i:])
+		n45, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n45
 	}
 	return i, nil
 }
@@ -7584,11 +7971,11 @@ func (m *AuthenticateResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n44, err := m.Header.MarshalTo(dAtA[i:])
+		n46, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n46
 	}
 	if len(m.Token) > 0 {
 		dAtA[i] = 0x12
@@ -7618,11 +8005,11 @@ func (m *AuthUserAddResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n45, err := m.Header.MarshalTo(dAtA[i:])
+		n47, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n47
 	}
 	return i, nil
 }
@@ -7646,11 +8033,11 @@ func (m *AuthUserGetResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n46, err := m.Header.MarshalTo(dAtA[i:])
+		n48, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n48
 	}
 	if len(m.Roles) > 0 {
 		for _, s := range m.Roles {
@@ -7689,11 +8076,11 @@ func (m *AuthUserDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n47, err := m.Header.MarshalTo(dAtA[i:])
+		n49, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n49
 	}
 	return i, nil
 }
@@ -7717,11 +8104,11 @@ func (m *AuthUserChangePasswordResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n48, err := m.Header.MarshalTo(dAtA[i:])
+		n50, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n50
 	}
 	return i, nil
 }
@@ -7745,11 +8132,11 @@ func (m *AuthUserGrantRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n49, err := m.Header.MarshalTo(dAtA[i:])
+		n51, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n51
 	}
 	return i, nil
 }
@@ -7773,11 +8160,11 @@ func (m *AuthUserRevokeRoleResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n50, err := m.Header.MarshalTo(dAtA[i:])
+		n52, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n52
 	}
 	return i, nil
 }
@@ -7801,11 +8188,11 @@ func (m *AuthRoleAddResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n51, err := m.Header.MarshalTo(dAtA[i:])
+		n53, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n53
 	}
 	return i, nil
 }
@@ -7829,11 +8216,11 @@ func (m *AuthRoleGetResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n52, err := m.Header.MarshalTo(dAtA[i:])
+		n54, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n54
 	}
 	if len(m.Perm) > 0 {
 		for _, msg := range m.Perm {
@@ -7869,11 +8256,11 @@ func (m *AuthRoleListResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n53, err := m.Header.MarshalTo(dAtA[i:])
+		n55, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n55
 	}
 	if len(m.Roles) > 0 {
 		for _, s := range m.Roles {
@@ -7912,11 +8299,11 @@ func (m *AuthUserListResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n54, err := m.Header.MarshalTo(dAtA[i:])
+		n56, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n56
 	}
 	if len(m.Users) > 0 {
 		for _, s := range m.Users {
@@ -7955,11 +8342,11 @@ func (m *AuthRoleDeleteResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n55, err := m.Header.MarshalTo(dAtA[i:])
+		n57, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n57
 	}
 	return i, nil
 }
@@ -7983,11 +8370,11 @@ func (m *AuthRoleGrantPermissionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n56, err := m.Header.MarshalTo(dAtA[i:])
+		n58, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n58
 	}
 	return i, nil
 }
@@ -8011,11 +8398,11 @@ func (m *AuthRoleRevokePermissionResponse) MarshalTo(dAtA []byte) (int, error) {
 		dAtA[i] = 0xa
 		i++
 		i = encodeVarintRpc(dAtA, i, uint64(m.Header.Size()))
-		n57, err := m.Header.MarshalTo(dAtA[i:])
+		n59, err := m.Header.MarshalTo(dAtA[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n59
 	}
 	return i, nil
 }
@@ -8530,6 +8917,9 @@ func (m *WatchCreateRequest) Size() (n int) {
 	if m.Fragment {
 		n += 2
 	}
+	if m.Coalesce {
+		n += 2
+	}
 	return n
 }
 
@@ -8568,6 +8958,9 @@ func (m *WatchResponse) Size() (n int) {
 	if m.Fragment {
 		n += 2
 	}
+	if m.Coalesced {
+		n += 2
+	}
 	if len(m.Events) > 0 {
 		for _, e := range m.Events {
 			l = e.Size()
@@ -8891,6 +9284,64 @@ func (m *MoveLeaderResponse) Size() (n int) {
 	return n
 }
 
+func (m *RevisionTimeRequest) Size() (n int) {
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovRpc(uint64(m.Revision))
+	}
+	if m.Time != 0 {
+		n += 1 + sovRpc(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *RevisionTimeResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovRpc(uint64(m.Revision))
+	}
+	if m.Time != 0 {
+		n += 1 + sovRpc(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *CompactionConfigRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Retention != 0 {
+		n += 1 + sovRpc(uint64(m.Retention))
+	}
+	return n
+}
+
+func (m *CompactionConfigResponse) Size() (n int) {
+	var l int
+	_ = l
+	if m.Header != nil {
+		l = m.Header.Size()
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	l = len(m.Mode)
+	if l > 0 {
+		n += 1 + l + sovRpc(uint64(l))
+	}
+	if m.Retention != 0 {
+		n += 1 + sovRpc(uint64(m.Retention))
+	}
+	return n
+}
+
 func (m *AlarmRequest) Size() (n int) {
 	var l int
 	_ = l
@@ -12485,6 +12936,26 @@ func (m *WatchCreateRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Fragment = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coalesce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Coalesce = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpc(dAtA[iNdEx:])
@@ -12764,6 +13235,26 @@ func (m *WatchResponse) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Fragment = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coalesced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Coalesced = bool(v != 0)
 		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
@@ -15113,6 +15604,444 @@ func (m *MoveLeaderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RevisionTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevisionTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevisionTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevisionTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactionConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			m.Retention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retention |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CompactionConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRpc
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CompactionConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CompactionConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header == nil {
+				m.Header = &ResponseHeader{}
+			}
+			if err := m.Header.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRpc
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retention", wireType)
+			}
+			m.Retention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retention |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRpc(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthRpc
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *AlarmRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -18698,240 +19627,250 @@ var (
 func init() { proto.RegisterFile("rpc.proto", fileDescriptorRpc) }
 
 var fileDescriptorRpc = []byte{
-	// 3750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0xcd, 0x6f, 0x1c, 0x47,
-	0x76, 0x67, 0xcf, 0x70, 0xbe, 0xde, 0x7c, 0x70, 0x58, 0x24, 0xa5, 0xd1, 0x48, 0xa2, 0xa8, 0x92,
-	0x64, 0xd1, 0x92, 0xcd, 0xb1, 0x69, 0x3b, 0x01, 0x94, 0xc4, 0x30, 0x45, 0x8e, 0x45, 0x9a, 0x14,
-	0x49, 0x37, 0x87, 0xf2, 0x07, 0x8c, 0x10, 0xcd, 0x99, 0x22, 0xd9, 0xe1, 0x4c, 0xf7, 0xb8, 0xbb,
-	0x67, 0x44, 0x3a, 0x41, 0x1c, 0x18, 0xce, 0x21, 0x39, 0xda, 0x40, 0x90, 0x1c, 0x72, 0x0a, 0x82,
-	0xc0, 0x87, 0x00, 0x7b, 0x59, 0x2c, 0xb0, 0x7f, 0xc1, 0xde, 0x76, 0x17, 0xfb, 0x0f, 0x2c, 0xbc,
-	0xbe, 0xec, 0x7f, 0xb1, 0xa8, 0xaf, 0xee, 0xea, 0x9e, 0x6e, 0x52, 0xf6, 0xd8, 0xbe, 0x50, 0x5d,
-	0x55, 0xaf, 0xde, 0xef, 0xd5, 0xab, 0xaa, 0xf7, 0xaa, 0x7e, 0x35, 0x82, 0x82, 0xd3, 0x6f, 0x2f,
-	0xf5, 0x1d, 0xdb, 0xb3, 0x51, 0x89, 0x78, 0xed, 0x8e, 0x4b, 0x9c, 0x21, 0x71, 0xfa, 0x87, 0xf5,
-	0xd9, 0x63, 0xfb, 0xd8, 0x66, 0x0d, 0x0d, 0xfa, 0xc5, 0x65, 0xea, 0xd7, 0xa8, 0x4c, 0xa3, 0x37,
-	0x6c, 0xb7, 0xd9, 0x9f, 0xfe, 0x61, 0xe3, 0x74, 0x28, 0x9a, 0xae, 0xb3, 0x26, 0x63, 0xe0, 0x9d,
-	0xb0, 0x3f, 0xfd, 0x43, 0xf6, 0x8f, 0x68, 0xbc, 0x71, 0x6c, 0xdb, 0xc7, 0x5d, 0xd2, 0x30, 0xfa,
-	0x66, 0xc3, 0xb0, 0x2c, 0xdb, 0x33, 0x3c, 0xd3, 0xb6, 0x5c, 0xde, 0x8a, 0xff, 0x55, 0x83, 0x8a,
-	0x4e, 0xdc, 0xbe, 0x6d, 0xb9, 0x64, 0x9d, 0x18, 0x1d, 0xe2, 0xa0, 0x9b, 0x00, 0xed, 0xee, 0xc0,
-	0xf5, 0x88, 0x73, 0x60, 0x76, 0x6a, 0xda, 0x82, 0xb6, 0x38, 0xa9, 0x17, 0x44, 0xcd, 0x46, 0x07,
-	0x5d, 0x87, 0x42, 0x8f, 0xf4, 0x0e, 0x79, 0x6b, 0x8a, 0xb5, 0xe6, 0x79, 0xc5, 0x46, 0x07, 0xd5,
-	0x21, 0xef, 0x90, 0xa1, 0xe9, 0x9a, 0xb6, 0x55, 0x4b, 0x2f, 0x68, 0x8b, 0x69, 0xdd, 0x2f, 0xd3,
-	0x8e, 0x8e, 0x71, 0xe4, 0x1d, 0x78, 0xc4, 0xe9, 0xd5, 0x26, 0x79, 0x47, 0x5a, 0xd1, 0x22, 0x4e,
-	0x0f, 0x7f, 0x99, 0x81, 0x92, 0x6e, 0x58, 0xc7, 0x44, 0x27, 0x9f, 0x0e, 0x88, 0xeb, 0xa1, 0x2a,
-	0xa4, 0x4f, 0xc9, 0x39, 0x83, 0x2f, 0xe9, 0xf4, 0x93, 0xf7, 0xb7, 0x8e, 0xc9, 0x01, 0xb1, 0x38,
-	0x70, 0x89, 0xf6, 0xb7, 0x8e, 0x49, 0xd3, 0xea, 0xa0, 0x59, 0xc8, 0x74, 0xcd, 0x9e, 0xe9, 0x09,
-	0x54, 0x5e, 0x08, 0x99, 0x33, 0x19, 0x31, 0x67, 0x15, 0xc0, 0xb5, 0x1d, 0xef, 0xc0, 0x76, 0x3a,
-	0xc4, 0xa9, 0x65, 0x16, 0xb4, 0xc5, 0xca, 0xf2, 0xdd, 0x25, 0x75, 0x22, 0x96, 0x54, 0x83, 0x96,
-	0xf6, 0x6c, 0xc7, 0xdb, 0xa1, 0xb2, 0x7a, 0xc1, 0x95, 0x9f, 0xe8, 0x5d, 0x28, 0x32, 0x25, 0x9e,
-	0xe1, 0x1c, 0x13, 0xaf, 0x96, 0x65, 0x5a, 0xee, 0x5d, 0xa2, 0xa5, 0xc5, 0x84, 0x75, 0x06, 0xcf,
-	0xbf, 0x11, 0x86, 0x92, 0x4b, 0x1c, 0xd3, 0xe8, 0x9a, 0x9f, 0x19, 0x87, 0x5d, 0x52, 0xcb, 0x2d,
-	0x68, 0x8b, 0x79, 0x3d, 0x54, 0x47, 0xc7, 0x7f, 0x4a, 0xce, 0xdd, 0x03, 0xdb, 0xea, 0x9e, 0xd7,
-	0xf2, 0x4c, 0x20, 0x4f, 0x2b, 0x76, 0xac, 0xee, 0x39, 0x9b, 0x34, 0x7b, 0x60, 0x79, 0xbc, 0xb5,
-	0xc0, 0x5a, 0x0b, 0xac, 0x86, 0x35, 0x2f, 0x42, 0xb5, 0x67, 0x5a, 0x07, 0x3d, 0xbb, 0x73, 0xe0,
-	0x3b, 0x04, 0x98, 0x43, 0x2a, 0x3d, 0xd3, 0x7a, 0x6a, 0x77, 0x74, 0xe9, 0x16, 0x2a, 0x69, 0x9c,
-	0x85, 0x25, 0x8b, 0x42, 0xd2, 0x38, 0x53, 0x25, 0x97, 0x60, 0x86, 0xea, 0x6c, 0x3b, 0xc4, 0xf0,
-	0x48, 0x20, 0x5c, 0x62, 0xc2, 0xd3, 0x3d, 0xd3, 0x5a, 0x65, 0x2d, 0x21, 0x79, 0xe3, 0x6c, 0x44,
-	0xbe, 0x2c, 0xe4, 0x8d, 0xb3, 0xb0, 0x3c, 0x5e, 0x82, 0x82, 0xef, 0x73, 0x94, 0x87, 0xc9, 0xed,
-	0x9d, 0xed, 0x66, 0x75, 0x02, 0x01, 0x64, 0x57, 0xf6, 0x56, 0x9b, 0xdb, 0x6b, 0x55, 0x0d, 0x15,
-	0x21, 0xb7, 0xd6, 0xe4, 0x85, 0x14, 0x7e, 0x0c, 0x10, 0x78, 0x17, 0xe5, 0x20, 0xbd, 0xd9, 0xfc,
-	0xa8, 0x3a, 0x41, 0x65, 0x9e, 0x35, 0xf5, 0xbd, 0x8d, 0x9d, 0xed, 0xaa, 0x46, 0x3b, 0xaf, 0xea,
-	0xcd, 0x95, 0x56, 0xb3, 0x9a, 0xa2, 0x12, 0x4f, 0x77, 0xd6, 0xaa, 0x69, 0x54, 0x80, 0xcc, 0xb3,
-	0x95, 0xad, 0xfd, 0x66, 0x75, 0x12, 0x7f, 0xad, 0x41, 0x59, 0xcc, 0x17, 0xdf, 0x13, 0xe8, 0x4d,
-	0xc8, 0x9e, 0xb0, 0x7d, 0xc1, 0x96, 0x62, 0x71, 0xf9, 0x46, 0x64, 0x72, 0x43, 0x7b, 0x47, 0x17,
-	0xb2, 0x08, 0x43, 0xfa, 0x74, 0xe8, 0xd6, 0x52, 0x0b, 0xe9, 0xc5, 0xe2, 0x72, 0x75, 0x89, 0x6f,
-	0xd8, 0xa5, 0x4d, 0x72, 0xfe, 0xcc, 0xe8, 0x0e, 0x88, 0x4e, 0x1b, 0x11, 0x82, 0xc9, 0x9e, 0xed,
-	0x10, 0xb6, 0x62, 0xf3, 0x3a, 0xfb, 0xa6, 0xcb, 0x98, 0x4d, 0x9a, 0x58, 0xad, 0xbc, 0x80, 0xbf,
-	0xd1, 0x00, 0x76, 0x07, 0x5e, 0xf2, 0xd6, 0x98, 0x85, 0xcc, 0x90, 0x2a, 0x16, 0xdb, 0x82, 0x17,
-	0xd8, 0x9e, 0x20, 0x86, 0x4b, 0xfc, 0x3d, 0x41, 0x0b, 0xe8, 0x2a, 0xe4, 0xfa, 0x0e, 0x19, 0x1e,
-	0x9c, 0x0e, 0x19, 0x48, 0x5e, 0xcf, 0xd2, 0xe2, 0xe6, 0x10, 0xdd, 0x86, 0x92, 0x79, 0x6c, 0xd9,
-	0x0e, 0x39, 0xe0, 0xba, 0x32, 0xac, 0xb5, 0xc8, 0xeb, 0x98, 0xdd, 0x8a, 0x08, 0x57, 0x9c, 0x55,
-	0x45, 0xb6, 0x68, 0x15, 0xb6, 0xa0, 0xc8, 0x4c, 0x1d, 0xcb, 0x7d, 0x2f, 0x07, 0x36, 0xa6, 0x58,
-	0xb7, 0x51, 0x17, 0x0a, 0xab, 0xf1, 0x27, 0x80, 0xd6, 0x48, 0x97, 0x78, 0x64, 0x9c, 0xe8, 0xa1,
-	0xf8, 0x24, 0xad, 0xfa, 0x04, 0x7f, 0xa5, 0xc1, 0x4c, 0x48, 0xfd, 0x58, 0xc3, 0xaa, 0x41, 0xae,
-	0xc3, 0x94, 0x71, 0x0b, 0xd2, 0xba, 0x2c, 0xa2, 0x87, 0x90, 0x17, 0x06, 0xb8, 0xb5, 0x74, 0xc2,
-	0xa2, 0xc9, 0x71, 0x9b, 0x5c, 0xfc, 0x4d, 0x0a, 0x0a, 0x62, 0xa0, 0x3b, 0x7d, 0xb4, 0x02, 0x65,
-	0x87, 0x17, 0x0e, 0xd8, 0x78, 0x84, 0x45, 0xf5, 0xe4, 0x20, 0xb4, 0x3e, 0xa1, 0x97, 0x44, 0x17,
-	0x56, 0x8d, 0xfe, 0x06, 0x8a, 0x52, 0x45, 0x7f, 0xe0, 0x09, 0x97, 0xd7, 0xc2, 0x0a, 0x82, 0xf5,
-	0xb7, 0x3e, 0xa1, 0x83, 0x10, 0xdf, 0x1d, 0x78, 0xa8, 0x05, 0xb3, 0xb2, 0x33, 0x1f, 0x8d, 0x30,
-	0x23, 0xcd, 0xb4, 0x2c, 0x84, 0xb5, 0x8c, 0x4e, 0xd5, 0xfa, 0x84, 0x8e, 0x44, 0x7f, 0xa5, 0x51,
-	0x35, 0xc9, 0x3b, 0xe3, 0xc1, 0x7b, 0xc4, 0xa4, 0xd6, 0x99, 0x35, 0x6a, 0x52, 0xeb, 0xcc, 0x7a,
-	0x5c, 0x80, 0x9c, 0x28, 0xe1, 0x5f, 0xa5, 0x00, 0xe4, 0x6c, 0xec, 0xf4, 0xd1, 0x1a, 0x54, 0x1c,
-	0x51, 0x0a, 0x79, 0xeb, 0x7a, 0xac, 0xb7, 0xc4, 0x24, 0x4e, 0xe8, 0x65, 0xd9, 0x89, 0x1b, 0xf7,
-	0x36, 0x94, 0x7c, 0x2d, 0x81, 0xc3, 0xae, 0xc5, 0x38, 0xcc, 0xd7, 0x50, 0x94, 0x1d, 0xa8, 0xcb,
-	0x3e, 0x80, 0x39, 0xbf, 0x7f, 0x8c, 0xcf, 0x6e, 0x5f, 0xe0, 0x33, 0x5f, 0xe1, 0x8c, 0xd4, 0xa0,
-	0x7a, 0x4d, 0x35, 0x2c, 0x70, 0xdb, 0xb5, 0x18, 0xb7, 0x8d, 0x1a, 0x46, 0x1d, 0x07, 0x34, 0x5f,
-	0xf2, 0x22, 0xfe, 0x73, 0x1a, 0x72, 0xab, 0x76, 0xaf, 0x6f, 0x38, 0x74, 0x36, 0xb2, 0x0e, 0x71,
-	0x07, 0x5d, 0x8f, 0xb9, 0xab, 0xb2, 0x7c, 0x27, 0xac, 0x51, 0x88, 0xc9, 0x7f, 0x75, 0x26, 0xaa,
-	0x8b, 0x2e, 0xb4, 0xb3, 0x48, 0x8f, 0xa9, 0x17, 0xe8, 0x2c, 0x92, 0xa3, 0xe8, 0x22, 0x37, 0x72,
-	0x3a, 0xd8, 0xc8, 0x75, 0xc8, 0x0d, 0x89, 0x13, 0xa4, 0xf4, 0xf5, 0x09, 0x5d, 0x56, 0xa0, 0x97,
-	0x61, 0x2a, 0x9a, 0x5e, 0x32, 0x42, 0xa6, 0xd2, 0x0e, 0x67, 0xa3, 0x3b, 0x50, 0x0a, 0xe5, 0xb8,
-	0xac, 0x90, 0x2b, 0xf6, 0x94, 0x14, 0x77, 0x45, 0xc6, 0x55, 0x9a, 0x8f, 0x4b, 0xeb, 0x13, 0x32,
-	0xb2, 0x5e, 0x91, 0x91, 0x35, 0x2f, 0x7a, 0x89, 0xd8, 0x1a, 0x0a, 0x32, 0xef, 0x84, 0x83, 0x0c,
-	0x7e, 0x07, 0xca, 0x21, 0x07, 0xd1, 0xbc, 0xd3, 0x7c, 0x7f, 0x7f, 0x65, 0x8b, 0x27, 0xa9, 0x27,
-	0x2c, 0x2f, 0xe9, 0x55, 0x8d, 0xe6, 0xba, 0xad, 0xe6, 0xde, 0x5e, 0x35, 0x85, 0xca, 0x50, 0xd8,
-	0xde, 0x69, 0x1d, 0x70, 0xa9, 0x34, 0x7e, 0xe2, 0x6b, 0x10, 0x49, 0x4e, 0xc9, 0x6d, 0x13, 0x4a,
-	0x6e, 0xd3, 0x64, 0x6e, 0x4b, 0x05, 0xb9, 0x8d, 0xa5, 0xb9, 0xad, 0xe6, 0xca, 0x5e, 0xb3, 0x3a,
-	0xf9, 0xb8, 0x02, 0x25, 0xee, 0xdf, 0x83, 0x81, 0x45, 0x53, 0xed, 0xff, 0x68, 0x00, 0xc1, 0x6e,
-	0x42, 0x0d, 0xc8, 0xb5, 0x39, 0x4e, 0x4d, 0x63, 0xc1, 0x68, 0x2e, 0x76, 0xca, 0x74, 0x29, 0x85,
-	0x5e, 0x87, 0x9c, 0x3b, 0x68, 0xb7, 0x89, 0x2b, 0x53, 0xde, 0xd5, 0x68, 0x3c, 0x14, 0xd1, 0x4a,
-	0x97, 0x72, 0xb4, 0xcb, 0x91, 0x61, 0x76, 0x07, 0x2c, 0x01, 0x5e, 0xdc, 0x45, 0xc8, 0xe1, 0xff,
-	0xd2, 0xa0, 0xa8, 0x2c, 0xde, 0x1f, 0x18, 0x84, 0x6f, 0x40, 0x81, 0xd9, 0x40, 0x3a, 0x22, 0x0c,
-	0xe7, 0xf5, 0xa0, 0x02, 0xfd, 0x15, 0x14, 0xe4, 0x0e, 0x90, 0x91, 0xb8, 0x16, 0xaf, 0x76, 0xa7,
-	0xaf, 0x07, 0xa2, 0x78, 0x13, 0xa6, 0x99, 0x57, 0xda, 0xf4, 0x70, 0x2d, 0xfd, 0xa8, 0x1e, 0x3f,
-	0xb5, 0xc8, 0xf1, 0xb3, 0x0e, 0xf9, 0xfe, 0xc9, 0xb9, 0x6b, 0xb6, 0x8d, 0xae, 0xb0, 0xc2, 0x2f,
-	0xe3, 0xf7, 0x00, 0xa9, 0xca, 0xc6, 0x19, 0x2e, 0x2e, 0x43, 0x71, 0xdd, 0x70, 0x4f, 0x84, 0x49,
-	0xf8, 0x21, 0x94, 0x69, 0x71, 0xf3, 0xd9, 0x0b, 0xd8, 0xc8, 0x2e, 0x07, 0x52, 0x7a, 0x2c, 0x9f,
-	0x23, 0x98, 0x3c, 0x31, 0xdc, 0x13, 0x36, 0xd0, 0xb2, 0xce, 0xbe, 0xd1, 0xcb, 0x50, 0x6d, 0xf3,
-	0x41, 0x1e, 0x44, 0xae, 0x0c, 0x53, 0xa2, 0xde, 0x3f, 0x09, 0x7e, 0x08, 0x25, 0x3e, 0x86, 0x1f,
-	0xdb, 0x08, 0x3c, 0x0d, 0x53, 0x7b, 0x96, 0xd1, 0x77, 0x4f, 0x6c, 0x99, 0xdd, 0xe8, 0xa0, 0xab,
-	0x41, 0xdd, 0x58, 0x88, 0xf7, 0x61, 0xca, 0x21, 0x3d, 0xc3, 0xb4, 0x4c, 0xeb, 0xf8, 0xe0, 0xf0,
-	0xdc, 0x23, 0xae, 0xb8, 0x30, 0x55, 0xfc, 0xea, 0xc7, 0xb4, 0x96, 0x9a, 0x76, 0xd8, 0xb5, 0x0f,
-	0x45, 0x98, 0x63, 0xdf, 0xf8, 0x97, 0x1a, 0x94, 0x3e, 0x30, 0xbc, 0xb6, 0x9c, 0x3a, 0xb4, 0x01,
-	0x15, 0x3f, 0xb8, 0xb1, 0x1a, 0x61, 0x4b, 0x24, 0xc5, 0xb2, 0x3e, 0xf2, 0x28, 0x2d, 0xb3, 0x63,
-	0xb9, 0xad, 0x56, 0x30, 0x55, 0x86, 0xd5, 0x26, 0x5d, 0x5f, 0x55, 0x2a, 0x59, 0x15, 0x13, 0x54,
-	0x55, 0xa9, 0x15, 0x8f, 0xa7, 0x82, 0xe3, 0x07, 0x8f, 0x25, 0xbf, 0x4f, 0x01, 0x1a, 0xb5, 0xe1,
-	0xfb, 0x9e, 0xc8, 0xee, 0x41, 0xc5, 0xf5, 0x0c, 0x67, 0x64, 0x6d, 0x94, 0x59, 0xad, 0x1f, 0xa0,
-	0xef, 0xc3, 0x54, 0xdf, 0xb1, 0x8f, 0x1d, 0xe2, 0xba, 0x07, 0x96, 0xed, 0x99, 0x47, 0xe7, 0xe2,
-	0x50, 0x5b, 0x91, 0xd5, 0xdb, 0xac, 0x16, 0x35, 0x21, 0x77, 0x64, 0x76, 0x3d, 0xe2, 0xb8, 0xb5,
-	0xcc, 0x42, 0x7a, 0xb1, 0xb2, 0xfc, 0xf0, 0x32, 0xaf, 0x2d, 0xbd, 0xcb, 0xe4, 0x5b, 0xe7, 0x7d,
-	0xa2, 0xcb, 0xbe, 0xea, 0x41, 0x31, 0x1b, 0x3a, 0x3c, 0x5f, 0x83, 0xfc, 0x73, 0xaa, 0x82, 0x5e,
-	0x8a, 0x73, 0xfc, 0x6c, 0xc7, 0xca, 0xfc, 0x4e, 0x7c, 0xe4, 0x18, 0xc7, 0x3d, 0x62, 0x79, 0xf2,
-	0xda, 0x26, 0xcb, 0xf8, 0x1e, 0x40, 0x00, 0x43, 0x23, 0xf4, 0xf6, 0xce, 0xee, 0x7e, 0xab, 0x3a,
-	0x81, 0x4a, 0x90, 0xdf, 0xde, 0x59, 0x6b, 0x6e, 0x35, 0x69, 0x38, 0xc7, 0x0d, 0xe9, 0x52, 0xd5,
-	0xf5, 0x21, 0x4c, 0x2d, 0x84, 0x49, 0x8f, 0x88, 0x65, 0xb1, 0x78, 0xc6, 0x5a, 0xc1, 0x2a, 0x44,
-	0x2a, 0x3c, 0xac, 0x1a, 0xe4, 0xf8, 0xa2, 0xea, 0x88, 0x33, 0xb3, 0x2c, 0xd2, 0x01, 0xf3, 0x35,
-	0x42, 0x3a, 0x62, 0x36, 0xfc, 0x72, 0xec, 0xae, 0xcf, 0xc4, 0xee, 0x7a, 0x74, 0x07, 0xca, 0xfe,
-	0x22, 0x35, 0x5c, 0x91, 0xa2, 0x0b, 0x7a, 0x49, 0xae, 0x3f, 0x5a, 0x17, 0x72, 0x6e, 0x2e, 0xec,
-	0x5c, 0x74, 0x0f, 0xb2, 0x64, 0x48, 0x2c, 0xcf, 0xad, 0x15, 0x59, 0x20, 0x2f, 0xcb, 0x23, 0x75,
-	0x93, 0xd6, 0xea, 0xa2, 0x11, 0xbf, 0x05, 0xd3, 0xec, 0xea, 0xf2, 0xc4, 0x31, 0x2c, 0xf5, 0x8e,
-	0xd5, 0x6a, 0x6d, 0x09, 0xb7, 0xd2, 0x4f, 0x54, 0x81, 0xd4, 0xc6, 0x9a, 0x70, 0x42, 0x6a, 0x63,
-	0x0d, 0x7f, 0xa1, 0x01, 0x52, 0xfb, 0x8d, 0xe5, 0xe7, 0x88, 0x72, 0x09, 0x9f, 0x0e, 0xe0, 0x67,
-	0x21, 0x43, 0x1c, 0xc7, 0x76, 0x98, 0x47, 0x0b, 0x3a, 0x2f, 0xe0, 0xbb, 0xc2, 0x06, 0x9d, 0x0c,
-	0xed, 0x53, 0x7f, 0xaf, 0x71, 0x6d, 0x9a, 0x6f, 0xea, 0x26, 0xcc, 0x84, 0xa4, 0xc6, 0x4a, 0x28,
-	0xf7, 0x61, 0x8e, 0x29, 0xdb, 0x24, 0xa4, 0xbf, 0xd2, 0x35, 0x87, 0x89, 0xa8, 0x7d, 0xb8, 0x12,
-	0x15, 0xfc, 0x69, 0x7d, 0x84, 0xff, 0x56, 0x20, 0xb6, 0xcc, 0x1e, 0x69, 0xd9, 0x5b, 0xc9, 0xb6,
-	0xd1, 0x80, 0x7b, 0x4a, 0xce, 0x5d, 0x91, 0x79, 0xd9, 0x37, 0xfe, 0x5f, 0x0d, 0xae, 0x8e, 0x74,
-	0xff, 0x89, 0x67, 0x75, 0x1e, 0xe0, 0x98, 0x2e, 0x1f, 0xd2, 0xa1, 0x0d, 0xfc, 0xd2, 0xaf, 0xd4,
-	0xf8, 0x76, 0xd2, 0x98, 0x55, 0x12, 0x76, 0xce, 0x8a, 0x39, 0x67, 0x7f, 0x5c, 0x99, 0xb6, 0x6e,
-	0x42, 0x91, 0x55, 0xec, 0x79, 0x86, 0x37, 0x70, 0x47, 0x26, 0xe3, 0x9f, 0xc5, 0x12, 0x90, 0x9d,
-	0xc6, 0x1a, 0xd7, 0xeb, 0x90, 0x65, 0xe7, 0x5d, 0x79, 0xda, 0x8b, 0x5c, 0x30, 0x14, 0x3b, 0x74,
-	0x21, 0x88, 0x4f, 0x20, 0xfb, 0x94, 0x91, 0x84, 0x8a, 0x65, 0x93, 0x72, 0x2a, 0x2c, 0xa3, 0xc7,
-	0xa9, 0x8b, 0x82, 0xce, 0xbe, 0xd9, 0xe1, 0x88, 0x10, 0x67, 0x5f, 0xdf, 0xe2, 0x87, 0xb0, 0x82,
-	0xee, 0x97, 0xa9, 0xcb, 0xda, 0x5d, 0x93, 0x58, 0x1e, 0x6b, 0x9d, 0x64, 0xad, 0x4a, 0x0d, 0x5e,
-	0x82, 0x2a, 0x47, 0x5a, 0xe9, 0x74, 0x94, 0x43, 0x8e, 0xaf, 0x4f, 0x0b, 0xeb, 0xc3, 0xff, 0xa7,
-	0xc1, 0xb4, 0xd2, 0x61, 0x2c, 0xc7, 0xbc, 0x02, 0x59, 0x4e, 0x85, 0x8a, 0x7c, 0x3a, 0x1b, 0xee,
-	0xc5, 0x61, 0x74, 0x21, 0x83, 0x96, 0x20, 0xc7, 0xbf, 0xe4, 0x49, 0x33, 0x5e, 0x5c, 0x0a, 0xe1,
-	0x7b, 0x30, 0x23, 0xaa, 0x48, 0xcf, 0x8e, 0x5b, 0xdb, 0xcc, 0xa1, 0xf8, 0x9f, 0x60, 0x36, 0x2c,
-	0x36, 0xd6, 0x90, 0x14, 0x23, 0x53, 0x2f, 0x62, 0xe4, 0x8a, 0x34, 0x72, 0xbf, 0xdf, 0x51, 0xd2,
-	0x7f, 0x74, 0xd6, 0xd5, 0x19, 0x49, 0x45, 0x66, 0xc4, 0x1f, 0x80, 0x54, 0xf1, 0xb3, 0x0e, 0x60,
-	0x46, 0x2e, 0x87, 0x2d, 0xd3, 0xf5, 0x0f, 0x85, 0x9f, 0x01, 0x52, 0x2b, 0x7f, 0x6e, 0x83, 0xd6,
-	0x88, 0x4c, 0x6a, 0xd2, 0xa0, 0xf7, 0x00, 0xa9, 0x95, 0x63, 0x45, 0xf4, 0x06, 0x4c, 0x3f, 0xb5,
-	0x87, 0x34, 0x34, 0xd0, 0xda, 0x60, 0xcb, 0xf0, 0x2b, 0xa2, 0x3f, 0x6d, 0x7e, 0x99, 0x82, 0xab,
-	0x1d, 0xc6, 0x02, 0xff, 0xad, 0x06, 0xa5, 0x95, 0xae, 0xe1, 0xf4, 0x24, 0xf0, 0xdb, 0x90, 0xe5,
-	0x17, 0x1f, 0xc1, 0x35, 0xbc, 0x14, 0x56, 0xa3, 0xca, 0xf2, 0xc2, 0x0a, 0xbf, 0x26, 0x89, 0x5e,
-	0xd4, 0x70, 0xf1, 0x1c, 0xb1, 0x16, 0x79, 0x9e, 0x58, 0x43, 0xaf, 0x42, 0xc6, 0xa0, 0x5d, 0x58,
-	0x08, 0xae, 0x44, 0xaf, 0x9c, 0x4c, 0x1b, 0x3b, 0xef, 0x71, 0x29, 0xfc, 0x26, 0x14, 0x15, 0x04,
-	0x7a, 0xa9, 0x7e, 0xd2, 0x14, 0x87, 0xb3, 0x95, 0xd5, 0xd6, 0xc6, 0x33, 0x7e, 0xd7, 0xae, 0x00,
-	0xac, 0x35, 0xfd, 0x72, 0x0a, 0x7f, 0x28, 0x7a, 0x89, 0x78, 0xa7, 0xda, 0xa3, 0x25, 0xd9, 0x93,
-	0x7a, 0x21, 0x7b, 0xce, 0xa0, 0x2c, 0x86, 0x3f, 0x6e, 0xf8, 0x66, 0xfa, 0x12, 0xc2, 0xb7, 0x62,
-	0xbc, 0x2e, 0x04, 0xf1, 0x14, 0x94, 0x45, 0x40, 0x17, 0xeb, 0xef, 0x17, 0x29, 0xa8, 0xc8, 0x9a,
-	0x71, 0x39, 0x51, 0x49, 0xe7, 0xf0, 0x0c, 0xe0, 0x93, 0x39, 0x57, 0x20, 0xdb, 0x39, 0xdc, 0x33,
-	0x3f, 0x93, 0xfc, 0xb5, 0x28, 0xd1, 0xfa, 0x2e, 0xc7, 0xe1, 0x8f, 0x48, 0xa2, 0x44, 0x2f, 0xf6,
-	0x8e, 0x71, 0xe4, 0x6d, 0x58, 0x1d, 0x72, 0xc6, 0xce, 0x94, 0x93, 0x7a, 0x50, 0xc1, 0xee, 0xb9,
-	0xe2, 0xb1, 0x89, 0x1d, 0x24, 0x95, 0xc7, 0x27, 0xf4, 0x00, 0xaa, 0xf4, 0x7b, 0xa5, 0xdf, 0xef,
-	0x9a, 0xa4, 0xc3, 0x15, 0xe4, 0x98, 0xcc, 0x48, 0x3d, 0x45, 0x67, 0x47, 0x2f, 0xb7, 0x96, 0x67,
-	0x61, 0x4b, 0x94, 0xd0, 0x02, 0x14, 0xb9, 0x7d, 0x1b, 0xd6, 0xbe, 0x4b, 0xd8, 0x0b, 0x4c, 0x5a,
-	0x57, 0xab, 0xe8, 0x3e, 0x5e, 0x19, 0x78, 0x27, 0x4d, 0xcb, 0x38, 0xec, 0xca, 0xb8, 0x48, 0x93,
-	0x39, 0xad, 0x5c, 0x33, 0x5d, 0xb5, 0xb6, 0x09, 0x33, 0xb4, 0x96, 0x58, 0x9e, 0xd9, 0x56, 0x82,
-	0xa8, 0x4c, 0x95, 0x5a, 0x24, 0x55, 0x1a, 0xae, 0xfb, 0xdc, 0x76, 0x3a, 0xc2, 0x81, 0x7e, 0x19,
-	0xaf, 0x71, 0xe5, 0xfb, 0x6e, 0x28, 0x19, 0x7e, 0x5f, 0x2d, 0x8b, 0x81, 0x96, 0x27, 0xc4, 0xbb,
-	0x40, 0x0b, 0x7e, 0x08, 0x73, 0x52, 0x52, 0xb0, 0x92, 0x17, 0x08, 0xef, 0xc0, 0x4d, 0x29, 0xbc,
-	0x7a, 0x42, 0xaf, 0x7d, 0xbb, 0x02, 0xf0, 0x87, 0xda, 0xf9, 0x18, 0x6a, 0xbe, 0x9d, 0xec, 0x48,
-	0x6e, 0x77, 0x55, 0x03, 0x06, 0xae, 0x58, 0x99, 0x05, 0x9d, 0x7d, 0xd3, 0x3a, 0xc7, 0xee, 0xfa,
-	0x07, 0x0f, 0xfa, 0x8d, 0x57, 0xe1, 0x9a, 0xd4, 0x21, 0x0e, 0xcb, 0x61, 0x25, 0x23, 0x06, 0xc5,
-	0x29, 0x11, 0x0e, 0xa3, 0x5d, 0x2f, 0x76, 0xbb, 0x2a, 0x19, 0x76, 0x2d, 0xd3, 0xa9, 0x29, 0x3a,
-	0xe7, 0xf8, 0x8a, 0xa0, 0x86, 0xa9, 0x79, 0x49, 0x54, 0x53, 0x05, 0x6a, 0xb5, 0x98, 0x08, 0x5a,
-	0x3d, 0x32, 0x11, 0x23, 0xaa, 0x3f, 0x81, 0x79, 0xdf, 0x08, 0xea, 0xb7, 0x5d, 0xe2, 0xf4, 0x4c,
-	0xd7, 0x55, 0x78, 0xac, 0xb8, 0x81, 0xbf, 0x04, 0x93, 0x7d, 0x22, 0x22, 0x57, 0x71, 0x19, 0x2d,
-	0xf1, 0x87, 0xe7, 0x25, 0xa5, 0x33, 0x6b, 0xc7, 0x1d, 0xb8, 0x25, 0xb5, 0x73, 0x8f, 0xc6, 0xaa,
-	0x8f, 0x1a, 0x25, 0xe9, 0x82, 0x54, 0x02, 0x5d, 0x90, 0x8e, 0x70, 0xab, 0xef, 0x71, 0x47, 0xca,
-	0xbd, 0x35, 0x56, 0x46, 0xda, 0xe4, 0x3e, 0xf5, 0xb7, 0xe4, 0x58, 0xca, 0x0e, 0x61, 0x36, 0xbc,
-	0x93, 0xc7, 0x0a, 0x96, 0xb3, 0x90, 0xf1, 0xec, 0x53, 0x22, 0x43, 0x25, 0x2f, 0x48, 0x83, 0xfd,
-	0x6d, 0x3e, 0x96, 0xc1, 0x46, 0xa0, 0x8c, 0x2d, 0xc9, 0x71, 0xed, 0xa5, 0xb3, 0x29, 0x8f, 0x78,
-	0xbc, 0x80, 0xb7, 0xe1, 0x4a, 0x34, 0x4c, 0x8c, 0x65, 0xf2, 0x33, 0xbe, 0x80, 0xe3, 0x22, 0xc9,
-	0x58, 0x7a, 0xdf, 0x0f, 0x82, 0x81, 0x12, 0x50, 0xc6, 0x52, 0xa9, 0x43, 0x3d, 0x2e, 0xbe, 0xfc,
-	0x18, 0xeb, 0xd5, 0x0f, 0x37, 0x63, 0x29, 0x73, 0x03, 0x65, 0xe3, 0x4f, 0x7f, 0x10, 0x23, 0xd2,
-	0x17, 0xc6, 0x08, 0xb1, 0x49, 0x82, 0x28, 0xf6, 0x13, 0x2c, 0x3a, 0x81, 0x11, 0x04, 0xd0, 0x71,
-	0x31, 0x68, 0x0e, 0xf1, 0x31, 0x58, 0x41, 0x2e, 0x6c, 0x35, 0xec, 0x8e, 0x35, 0x19, 0x1f, 0x04,
-	0xb1, 0x73, 0x24, 0x32, 0x8f, 0xa5, 0xf8, 0x43, 0x58, 0x48, 0x0e, 0xca, 0xe3, 0x68, 0x7e, 0xd0,
-	0x80, 0x82, 0x7f, 0x6c, 0x55, 0x7e, 0xb4, 0x51, 0x84, 0xdc, 0xf6, 0xce, 0xde, 0xee, 0xca, 0x6a,
-	0x93, 0xff, 0x6a, 0x63, 0x75, 0x47, 0xd7, 0xf7, 0x77, 0x5b, 0xd5, 0xd4, 0xf2, 0x77, 0x69, 0x48,
-	0x6d, 0x3e, 0x43, 0x1f, 0x41, 0x86, 0x3f, 0x61, 0x5e, 0xf0, 0x6e, 0x5d, 0xbf, 0xe8, 0x95, 0x16,
-	0x5f, 0xfd, 0xe2, 0x0f, 0xdf, 0x7d, 0x9d, 0x9a, 0xc6, 0xa5, 0xc6, 0xf0, 0x8d, 0xc6, 0xe9, 0xb0,
-	0xc1, 0x72, 0xc3, 0x23, 0xed, 0x01, 0x7a, 0x1f, 0xd2, 0xbb, 0x03, 0x0f, 0x25, 0xbe, 0x67, 0xd7,
-	0x93, 0x1f, 0x6e, 0xf1, 0x1c, 0x53, 0x3a, 0x85, 0x41, 0x28, 0xed, 0x0f, 0x3c, 0xaa, 0xf2, 0x53,
-	0x28, 0xaa, 0xcf, 0xae, 0x97, 0x3e, 0x72, 0xd7, 0x2f, 0x7f, 0xd2, 0xc5, 0x37, 0x19, 0xd4, 0x55,
-	0x8c, 0x04, 0x14, 0x7f, 0x18, 0x56, 0x47, 0xd1, 0x3a, 0xb3, 0x50, 0xe2, 0x13, 0x78, 0x3d, 0xf9,
-	0x95, 0x77, 0x64, 0x14, 0xde, 0x99, 0x45, 0x55, 0xfe, 0x83, 0x78, 0xe0, 0x6d, 0x7b, 0xe8, 0x56,
-	0xcc, 0x03, 0x9f, 0xfa, 0x94, 0x55, 0x5f, 0x48, 0x16, 0x10, 0x20, 0x37, 0x18, 0xc8, 0x15, 0x3c,
-	0x2d, 0x40, 0xda, 0xbe, 0xc8, 0x23, 0xed, 0xc1, 0x72, 0x1b, 0x32, 0x8c, 0x8f, 0x46, 0x1f, 0xcb,
-	0x8f, 0x7a, 0x0c, 0x01, 0x9f, 0x30, 0xd1, 0x21, 0x26, 0x1b, 0xcf, 0x32, 0xa0, 0x0a, 0x2e, 0x50,
-	0x20, 0xc6, 0x46, 0x3f, 0xd2, 0x1e, 0x2c, 0x6a, 0xaf, 0x69, 0xcb, 0xff, 0x9f, 0x81, 0x0c, 0x23,
-	0x9f, 0xd0, 0x29, 0x40, 0xc0, 0xcd, 0x46, 0x47, 0x37, 0xc2, 0xf6, 0x46, 0x47, 0x37, 0x4a, 0xeb,
-	0xe2, 0x3a, 0x03, 0x9d, 0xc5, 0x53, 0x14, 0x94, 0x71, 0x5a, 0x0d, 0x46, 0xd3, 0x51, 0x3f, 0xfe,
-	0x9b, 0x26, 0xb8, 0x37, 0xbe, 0x97, 0x50, 0x9c, 0xb6, 0x10, 0x41, 0x1b, 0x5d, 0x0e, 0x31, 0xe4,
-	0x2c, 0x7e, 0x8b, 0x01, 0x36, 0x70, 0x35, 0x00, 0x74, 0x98, 0xc4, 0x23, 0xed, 0xc1, 0xc7, 0x35,
-	0x3c, 0x23, 0xbc, 0x1c, 0x69, 0x41, 0x9f, 0x43, 0x25, 0x4c, 0xba, 0xa2, 0x3b, 0x31, 0x58, 0x51,
-	0xee, 0xb6, 0x7e, 0xf7, 0x62, 0x21, 0x61, 0xd3, 0x3c, 0xb3, 0x49, 0x80, 0x73, 0xe4, 0x53, 0x42,
-	0xfa, 0x06, 0x15, 0x12, 0x73, 0x80, 0xfe, 0x5b, 0x83, 0xa9, 0x08, 0x8b, 0x8a, 0xe2, 0xb4, 0x8f,
-	0x70, 0xb4, 0xf5, 0x7b, 0x97, 0x48, 0x09, 0x23, 0xfe, 0x8e, 0x19, 0xf1, 0xd7, 0x78, 0x36, 0x30,
-	0xc2, 0x33, 0x7b, 0xc4, 0xb3, 0x85, 0x15, 0x1f, 0xdf, 0xc0, 0x57, 0x43, 0xce, 0x09, 0xb5, 0x06,
-	0x93, 0xc5, 0x99, 0xd0, 0xd8, 0xc9, 0x0a, 0x31, 0xab, 0xb1, 0x93, 0x15, 0xa6, 0x51, 0xe3, 0x26,
-	0x8b, 0xf3, 0x9e, 0x71, 0x93, 0xe5, 0xb7, 0x2c, 0xb3, 0x9f, 0x58, 0xf0, 0x1f, 0x56, 0x22, 0x1b,
-	0x0a, 0x3e, 0x0b, 0x89, 0xe6, 0xe3, 0x18, 0xa1, 0xe0, 0x2e, 0x51, 0xbf, 0x95, 0xd8, 0x2e, 0x0c,
-	0xba, 0xcd, 0x0c, 0xba, 0x8e, 0xaf, 0x50, 0x64, 0xf1, 0xdb, 0xcd, 0x06, 0xa7, 0x1d, 0x1a, 0x46,
-	0xa7, 0x43, 0x1d, 0xf1, 0x8f, 0x50, 0x52, 0x69, 0x42, 0x74, 0x3b, 0x96, 0x85, 0x52, 0x99, 0xc6,
-	0x3a, 0xbe, 0x48, 0x44, 0x20, 0xdf, 0x65, 0xc8, 0xf3, 0xf8, 0x5a, 0x0c, 0xb2, 0xc3, 0x44, 0x43,
-	0xe0, 0x9c, 0xe2, 0x8b, 0x07, 0x0f, 0x31, 0x88, 0xf1, 0xe0, 0x61, 0x86, 0xf0, 0x42, 0xf0, 0x01,
-	0x13, 0xa5, 0xe0, 0x2e, 0x40, 0x40, 0xe6, 0xa1, 0x58, 0x5f, 0x2a, 0x97, 0xa9, 0x68, 0x70, 0x18,
-	0xe5, 0x01, 0x31, 0x66, 0xb0, 0x62, 0xdd, 0x45, 0x60, 0xbb, 0xa6, 0x4b, 0x83, 0xc4, 0xf2, 0xbf,
-	0x67, 0xa1, 0xf8, 0xd4, 0x30, 0x2d, 0x8f, 0x58, 0x86, 0xd5, 0x26, 0xe8, 0x10, 0x32, 0x2c, 0x51,
-	0x46, 0xe3, 0xa0, 0xca, 0x6f, 0x45, 0xe3, 0x60, 0x88, 0xfc, 0xc1, 0x0b, 0x0c, 0xb5, 0x8e, 0xe7,
-	0x28, 0x6a, 0x2f, 0x50, 0xdd, 0x60, 0x9c, 0x0d, 0x1d, 0xe8, 0x11, 0x64, 0xc5, 0x73, 0x40, 0x44,
-	0x51, 0x88, 0xcb, 0xa9, 0xdf, 0x88, 0x6f, 0x8c, 0x5b, 0x4a, 0x2a, 0x8c, 0xcb, 0xe4, 0x28, 0xce,
-	0x10, 0x20, 0x20, 0x23, 0xa3, 0x0e, 0x1d, 0xe1, 0x2e, 0xeb, 0x0b, 0xc9, 0x02, 0x02, 0xf3, 0x1e,
-	0xc3, 0xbc, 0x85, 0xeb, 0x51, 0xcc, 0x8e, 0x2f, 0x4b, 0x71, 0xff, 0x1e, 0x26, 0xd7, 0x0d, 0xf7,
-	0x04, 0x45, 0x52, 0x9f, 0xf2, 0x7b, 0x87, 0x7a, 0x3d, 0xae, 0x49, 0xa0, 0xdc, 0x62, 0x28, 0xd7,
-	0x78, 0x24, 0x51, 0x51, 0x4e, 0x0c, 0x97, 0xe6, 0x14, 0xd4, 0x81, 0x2c, 0xff, 0xf9, 0x43, 0xd4,
-	0x7f, 0xa1, 0x9f, 0x50, 0x44, 0xfd, 0x17, 0xfe, 0xc5, 0xc4, 0xe5, 0x28, 0x7d, 0xc8, 0xcb, 0xdf,
-	0x1b, 0xa0, 0x9b, 0x91, 0xa9, 0x08, 0xff, 0x36, 0xa1, 0x3e, 0x9f, 0xd4, 0x2c, 0xb0, 0xee, 0x30,
-	0xac, 0x9b, 0xb8, 0x36, 0x32, 0x57, 0x42, 0xf2, 0x91, 0xf6, 0xe0, 0x35, 0x0d, 0x7d, 0x0e, 0x10,
-	0xf0, 0xb7, 0x23, 0x1b, 0x20, 0x4a, 0x05, 0x8f, 0x6c, 0x80, 0x11, 0xea, 0x17, 0x2f, 0x31, 0xdc,
-	0x45, 0x7c, 0x27, 0x8a, 0xeb, 0x39, 0x86, 0xe5, 0x1e, 0x11, 0xe7, 0x55, 0xce, 0xd1, 0xb9, 0x27,
-	0x66, 0x9f, 0x6e, 0x86, 0x5f, 0x4f, 0xc1, 0x24, 0x3d, 0x80, 0xd2, 0x3c, 0x1d, 0xdc, 0xdb, 0xa3,
-	0x96, 0x8c, 0xb0, 0x65, 0x51, 0x4b, 0x46, 0xaf, 0xfc, 0xe1, 0x3c, 0xcd, 0x7e, 0x11, 0x4f, 0x98,
-	0x00, 0x75, 0xb4, 0x0d, 0x45, 0xe5, 0x62, 0x8f, 0x62, 0x94, 0x85, 0x69, 0xb8, 0x68, 0xe4, 0x8f,
-	0x61, 0x05, 0xf0, 0x75, 0x86, 0x37, 0xc7, 0x23, 0x3f, 0xc3, 0xeb, 0x70, 0x09, 0x0a, 0xf8, 0x1c,
-	0x4a, 0xea, 0xe5, 0x1f, 0xc5, 0xe8, 0x8b, 0x50, 0x7c, 0xd1, 0x28, 0x17, 0xc7, 0x1d, 0x84, 0x37,
-	0xbe, 0xff, 0xab, 0x7f, 0x29, 0x46, 0x81, 0xbb, 0x90, 0x13, 0x6c, 0x40, 0xdc, 0x28, 0xc3, 0x7c,
-	0x60, 0xdc, 0x28, 0x23, 0x54, 0x42, 0xf8, 0x6c, 0xc7, 0x10, 0xe9, 0x85, 0x47, 0x66, 0x12, 0x81,
-	0xf6, 0x84, 0x78, 0x49, 0x68, 0x01, 0xb9, 0x95, 0x84, 0xa6, 0x5c, 0x36, 0x93, 0xd0, 0x8e, 0x89,
-	0x27, 0xb6, 0x8b, 0xbc, 0xc4, 0xa1, 0x04, 0x65, 0x6a, 0xf4, 0xc6, 0x17, 0x89, 0xc4, 0x1d, 0xbd,
-	0x03, 0x40, 0x11, 0xba, 0xd1, 0x19, 0x40, 0xc0, 0x55, 0x44, 0xcf, 0x53, 0xb1, 0x84, 0x67, 0xf4,
-	0x3c, 0x15, 0x4f, 0x77, 0x84, 0x43, 0x43, 0x80, 0xcb, 0x4f, 0xfe, 0x14, 0xf9, 0x2b, 0x0d, 0xd0,
-	0x28, 0xad, 0x81, 0x1e, 0xc6, 0x6b, 0x8f, 0xa5, 0x51, 0xeb, 0xaf, 0xbc, 0x98, 0x70, 0x5c, 0xb4,
-	0x0f, 0x4c, 0x6a, 0x33, 0xe9, 0xfe, 0x73, 0x6a, 0xd4, 0xbf, 0x68, 0x50, 0x0e, 0x71, 0x22, 0xe8,
-	0xa5, 0x84, 0x39, 0x8d, 0xb0, 0xb0, 0xf5, 0xfb, 0x97, 0xca, 0xc5, 0x1d, 0x34, 0x95, 0x15, 0x20,
-	0x4f, 0xdc, 0x5f, 0x6a, 0x50, 0x09, 0x73, 0x28, 0x28, 0x41, 0xf7, 0x08, 0x8b, 0x5b, 0x5f, 0xbc,
-	0x5c, 0xf0, 0xe2, 0xe9, 0x09, 0x0e, 0xdb, 0x5d, 0xc8, 0x09, 0xd6, 0x25, 0x6e, 0xe1, 0x87, 0xf9,
-	0xdf, 0xb8, 0x85, 0x1f, 0xa1, 0x6c, 0x62, 0x16, 0xbe, 0x63, 0x77, 0x89, 0xb2, 0xcd, 0x04, 0x2d,
-	0x93, 0x84, 0x76, 0xf1, 0x36, 0x8b, 0x70, 0x3a, 0x49, 0x68, 0xc1, 0x36, 0x93, 0x7c, 0x0c, 0x4a,
-	0x50, 0x76, 0xc9, 0x36, 0x8b, 0xd2, 0x39, 0x31, 0xdb, 0x8c, 0x01, 0x2a, 0xdb, 0x2c, 0x60, 0x4e,
-	0xe2, 0xb6, 0xd9, 0x08, 0x9d, 0x1d, 0xb7, 0xcd, 0x46, 0xc9, 0x97, 0x98, 0x79, 0x64, 0xb8, 0xa1,
-	0x6d, 0x36, 0x13, 0x43, 0xb2, 0xa0, 0x57, 0x12, 0x9c, 0x18, 0xcb, 0x92, 0xd7, 0x5f, 0x7d, 0x41,
-	0xe9, 0xc4, 0x35, 0xce, 0xdd, 0x2f, 0xd7, 0xf8, 0x7f, 0x68, 0x30, 0x1b, 0x47, 0xd0, 0xa0, 0x04,
-	0x9c, 0x04, 0x76, 0xbd, 0xbe, 0xf4, 0xa2, 0xe2, 0x17, 0x7b, 0xcb, 0x5f, 0xf5, 0x8f, 0xab, 0xbf,
-	0xf9, 0x76, 0x5e, 0xfb, 0xdd, 0xb7, 0xf3, 0xda, 0x1f, 0xbf, 0x9d, 0xd7, 0xfe, 0xf3, 0x4f, 0xf3,
-	0x13, 0x87, 0x59, 0xf6, 0x7f, 0xc9, 0xde, 0xf8, 0x4b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x91, 0xd4,
-	0xc1, 0xf9, 0xd2, 0x36, 0x00, 0x00,
+	// 3911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0xcd, 0x6f, 0x1c, 0xc9,
+	0x75, 0x67, 0xcf, 0x70, 0xbe, 0xde, 0x7c, 0x70, 0x54, 0xa4, 0xa4, 0x51, 0x4b, 0xa2, 0x86, 0xa5,
+	0x8f, 0xe5, 0x4a, 0x2b, 0xd2, 0xa6, 0xed, 0x04, 0x50, 0x12, 0x63, 0x47, 0xe4, 0x58, 0xe2, 0x92,
+	0x22, 0xb5, 0xcd, 0xa1, 0x76, 0xbd, 0x30, 0x42, 0x34, 0x67, 0x8a, 0x64, 0x87, 0x33, 0xdd, 0xe3,
+	0xee, 0x9e, 0x11, 0xb9, 0x09, 0xe2, 0xc0, 0x70, 0x0e, 0x39, 0x05, 0xb0, 0x81, 0x20, 0x39, 0xf8,
+	0x14, 0x04, 0x81, 0x0f, 0x01, 0x72, 0x09, 0x02, 0xe4, 0x1f, 0x48, 0x6e, 0x09, 0x90, 0x7f, 0x20,
+	0xd8, 0xf8, 0x92, 0x43, 0xfe, 0x07, 0xa3, 0xbe, 0xba, 0xab, 0x7b, 0xba, 0x87, 0xb4, 0xc7, 0xeb,
+	0xcb, 0xb0, 0xab, 0xea, 0x57, 0xef, 0xf7, 0xfa, 0x55, 0xd5, 0x7b, 0x55, 0xaf, 0x9a, 0x50, 0x72,
+	0x87, 0xdd, 0xb5, 0xa1, 0xeb, 0xf8, 0x0e, 0xaa, 0x10, 0xbf, 0xdb, 0xf3, 0x88, 0x3b, 0x26, 0xee,
+	0xf0, 0x58, 0x5f, 0x3a, 0x75, 0x4e, 0x1d, 0xd6, 0xb0, 0x4e, 0x9f, 0x38, 0x46, 0xbf, 0x43, 0x31,
+	0xeb, 0x83, 0x71, 0xb7, 0xcb, 0x7e, 0x86, 0xc7, 0xeb, 0xe7, 0x63, 0xd1, 0x74, 0x97, 0x35, 0x99,
+	0x23, 0xff, 0x8c, 0xfd, 0x0c, 0x8f, 0xd9, 0x1f, 0xd1, 0x78, 0xef, 0xd4, 0x71, 0x4e, 0xfb, 0x64,
+	0xdd, 0x1c, 0x5a, 0xeb, 0xa6, 0x6d, 0x3b, 0xbe, 0xe9, 0x5b, 0x8e, 0xed, 0xf1, 0x56, 0xfc, 0x97,
+	0x1a, 0xd4, 0x0c, 0xe2, 0x0d, 0x1d, 0xdb, 0x23, 0xaf, 0x89, 0xd9, 0x23, 0x2e, 0xba, 0x0f, 0xd0,
+	0xed, 0x8f, 0x3c, 0x9f, 0xb8, 0x47, 0x56, 0xaf, 0xa1, 0x35, 0xb5, 0xd5, 0x79, 0xa3, 0x24, 0x6a,
+	0xb6, 0x7b, 0xe8, 0x2e, 0x94, 0x06, 0x64, 0x70, 0xcc, 0x5b, 0x33, 0xac, 0xb5, 0xc8, 0x2b, 0xb6,
+	0x7b, 0x48, 0x87, 0xa2, 0x4b, 0xc6, 0x96, 0x67, 0x39, 0x76, 0x23, 0xdb, 0xd4, 0x56, 0xb3, 0x46,
+	0x50, 0xa6, 0x1d, 0x5d, 0xf3, 0xc4, 0x3f, 0xf2, 0x89, 0x3b, 0x68, 0xcc, 0xf3, 0x8e, 0xb4, 0xa2,
+	0x43, 0xdc, 0x01, 0xfe, 0x49, 0x0e, 0x2a, 0x86, 0x69, 0x9f, 0x12, 0x83, 0xfc, 0x70, 0x44, 0x3c,
+	0x1f, 0xd5, 0x21, 0x7b, 0x4e, 0x2e, 0x19, 0x7d, 0xc5, 0xa0, 0x8f, 0xbc, 0xbf, 0x7d, 0x4a, 0x8e,
+	0x88, 0xcd, 0x89, 0x2b, 0xb4, 0xbf, 0x7d, 0x4a, 0xda, 0x76, 0x0f, 0x2d, 0x41, 0xae, 0x6f, 0x0d,
+	0x2c, 0x5f, 0xb0, 0xf2, 0x42, 0x44, 0x9d, 0xf9, 0x98, 0x3a, 0x9b, 0x00, 0x9e, 0xe3, 0xfa, 0x47,
+	0x8e, 0xdb, 0x23, 0x6e, 0x23, 0xd7, 0xd4, 0x56, 0x6b, 0x1b, 0x8f, 0xd6, 0xd4, 0x81, 0x58, 0x53,
+	0x15, 0x5a, 0x3b, 0x70, 0x5c, 0x7f, 0x9f, 0x62, 0x8d, 0x92, 0x27, 0x1f, 0xd1, 0xf7, 0xa0, 0xcc,
+	0x84, 0xf8, 0xa6, 0x7b, 0x4a, 0xfc, 0x46, 0x9e, 0x49, 0x79, 0x7c, 0x85, 0x94, 0x0e, 0x03, 0x1b,
+	0xe0, 0x05, 0xcf, 0x08, 0x43, 0xc5, 0x23, 0xae, 0x65, 0xf6, 0xad, 0x2f, 0xcd, 0xe3, 0x3e, 0x69,
+	0x14, 0x9a, 0xda, 0x6a, 0xd1, 0x88, 0xd4, 0xd1, 0xf7, 0x3f, 0x27, 0x97, 0xde, 0x91, 0x63, 0xf7,
+	0x2f, 0x1b, 0x45, 0x06, 0x28, 0xd2, 0x8a, 0x7d, 0xbb, 0x7f, 0xc9, 0x06, 0xcd, 0x19, 0xd9, 0x3e,
+	0x6f, 0x2d, 0xb1, 0xd6, 0x12, 0xab, 0x61, 0xcd, 0xab, 0x50, 0x1f, 0x58, 0xf6, 0xd1, 0xc0, 0xe9,
+	0x1d, 0x05, 0x06, 0x01, 0x66, 0x90, 0xda, 0xc0, 0xb2, 0xdf, 0x38, 0x3d, 0x43, 0x9a, 0x85, 0x22,
+	0xcd, 0x8b, 0x28, 0xb2, 0x2c, 0x90, 0xe6, 0x85, 0x8a, 0x5c, 0x83, 0x45, 0x2a, 0xb3, 0xeb, 0x12,
+	0xd3, 0x27, 0x21, 0xb8, 0xc2, 0xc0, 0x37, 0x06, 0x96, 0xbd, 0xc9, 0x5a, 0x22, 0x78, 0xf3, 0x62,
+	0x02, 0x5f, 0x15, 0x78, 0xf3, 0x22, 0x8a, 0xc7, 0x6b, 0x50, 0x0a, 0x6c, 0x8e, 0x8a, 0x30, 0xbf,
+	0xb7, 0xbf, 0xd7, 0xae, 0xcf, 0x21, 0x80, 0x7c, 0xeb, 0x60, 0xb3, 0xbd, 0xb7, 0x55, 0xd7, 0x50,
+	0x19, 0x0a, 0x5b, 0x6d, 0x5e, 0xc8, 0xe0, 0x97, 0x00, 0xa1, 0x75, 0x51, 0x01, 0xb2, 0x3b, 0xed,
+	0xef, 0xd7, 0xe7, 0x28, 0xe6, 0x5d, 0xdb, 0x38, 0xd8, 0xde, 0xdf, 0xab, 0x6b, 0xb4, 0xf3, 0xa6,
+	0xd1, 0x6e, 0x75, 0xda, 0xf5, 0x0c, 0x45, 0xbc, 0xd9, 0xdf, 0xaa, 0x67, 0x51, 0x09, 0x72, 0xef,
+	0x5a, 0xbb, 0x87, 0xed, 0xfa, 0x3c, 0xfe, 0x99, 0x06, 0x55, 0x31, 0x5e, 0x7c, 0x4d, 0xa0, 0x6f,
+	0x43, 0xfe, 0x8c, 0xad, 0x0b, 0x36, 0x15, 0xcb, 0x1b, 0xf7, 0x62, 0x83, 0x1b, 0x59, 0x3b, 0x86,
+	0xc0, 0x22, 0x0c, 0xd9, 0xf3, 0xb1, 0xd7, 0xc8, 0x34, 0xb3, 0xab, 0xe5, 0x8d, 0xfa, 0x1a, 0x5f,
+	0xb0, 0x6b, 0x3b, 0xe4, 0xf2, 0x9d, 0xd9, 0x1f, 0x11, 0x83, 0x36, 0x22, 0x04, 0xf3, 0x03, 0xc7,
+	0x25, 0x6c, 0xc6, 0x16, 0x0d, 0xf6, 0x4c, 0xa7, 0x31, 0x1b, 0x34, 0x31, 0x5b, 0x79, 0x01, 0xff,
+	0x42, 0x03, 0x78, 0x3b, 0xf2, 0xd3, 0x97, 0xc6, 0x12, 0xe4, 0xc6, 0x54, 0xb0, 0x58, 0x16, 0xbc,
+	0xc0, 0xd6, 0x04, 0x31, 0x3d, 0x12, 0xac, 0x09, 0x5a, 0x40, 0xb7, 0xa1, 0x30, 0x74, 0xc9, 0xf8,
+	0xe8, 0x7c, 0xcc, 0x48, 0x8a, 0x46, 0x9e, 0x16, 0x77, 0xc6, 0x68, 0x05, 0x2a, 0xd6, 0xa9, 0xed,
+	0xb8, 0xe4, 0x88, 0xcb, 0xca, 0xb1, 0xd6, 0x32, 0xaf, 0x63, 0x7a, 0x2b, 0x10, 0x2e, 0x38, 0xaf,
+	0x42, 0x76, 0x69, 0x15, 0xb6, 0xa1, 0xcc, 0x54, 0x9d, 0xc9, 0x7c, 0x1f, 0x86, 0x3a, 0x66, 0x9a,
+	0x5a, 0xa2, 0x09, 0x85, 0xd6, 0xf8, 0x07, 0x80, 0xb6, 0x48, 0x9f, 0xf8, 0x64, 0x16, 0xef, 0xa1,
+	0xd8, 0x24, 0xab, 0xda, 0x04, 0xff, 0x54, 0x83, 0xc5, 0x88, 0xf8, 0x99, 0x5e, 0xab, 0x01, 0x85,
+	0x1e, 0x13, 0xc6, 0x35, 0xc8, 0x1a, 0xb2, 0x88, 0x9e, 0x41, 0x51, 0x28, 0xe0, 0x35, 0xb2, 0x29,
+	0x93, 0xa6, 0xc0, 0x75, 0xf2, 0xf0, 0x2f, 0x32, 0x50, 0x12, 0x2f, 0xba, 0x3f, 0x44, 0x2d, 0xa8,
+	0xba, 0xbc, 0x70, 0xc4, 0xde, 0x47, 0x68, 0xa4, 0xa7, 0x3b, 0xa1, 0xd7, 0x73, 0x46, 0x45, 0x74,
+	0x61, 0xd5, 0xe8, 0x0f, 0xa0, 0x2c, 0x45, 0x0c, 0x47, 0xbe, 0x30, 0x79, 0x23, 0x2a, 0x20, 0x9c,
+	0x7f, 0xaf, 0xe7, 0x0c, 0x10, 0xf0, 0xb7, 0x23, 0x1f, 0x75, 0x60, 0x49, 0x76, 0xe6, 0x6f, 0x23,
+	0xd4, 0xc8, 0x32, 0x29, 0xcd, 0xa8, 0x94, 0xc9, 0xa1, 0x7a, 0x3d, 0x67, 0x20, 0xd1, 0x5f, 0x69,
+	0x54, 0x55, 0xf2, 0x2f, 0xb8, 0xf3, 0x9e, 0x50, 0xa9, 0x73, 0x61, 0x4f, 0xaa, 0xd4, 0xb9, 0xb0,
+	0x5f, 0x96, 0xa0, 0x20, 0x4a, 0xf8, 0x5f, 0x33, 0x00, 0x72, 0x34, 0xf6, 0x87, 0x68, 0x0b, 0x6a,
+	0xae, 0x28, 0x45, 0xac, 0x75, 0x37, 0xd1, 0x5a, 0x62, 0x10, 0xe7, 0x8c, 0xaa, 0xec, 0xc4, 0x95,
+	0xfb, 0x2e, 0x54, 0x02, 0x29, 0xa1, 0xc1, 0xee, 0x24, 0x18, 0x2c, 0x90, 0x50, 0x96, 0x1d, 0xa8,
+	0xc9, 0x3e, 0x83, 0x9b, 0x41, 0xff, 0x04, 0x9b, 0xad, 0x4c, 0xb1, 0x59, 0x20, 0x70, 0x51, 0x4a,
+	0x50, 0xad, 0xa6, 0x2a, 0x16, 0x9a, 0xed, 0x4e, 0x82, 0xd9, 0x26, 0x15, 0xa3, 0x86, 0x03, 0x28,
+	0xca, 0x22, 0xfe, 0xbf, 0x2c, 0x14, 0x36, 0x9d, 0xc1, 0xd0, 0x74, 0xe9, 0x68, 0xe4, 0x5d, 0xe2,
+	0x8d, 0xfa, 0x3e, 0x33, 0x57, 0x6d, 0xe3, 0x61, 0x54, 0xa2, 0x80, 0xc9, 0xbf, 0x06, 0x83, 0x1a,
+	0xa2, 0x0b, 0xed, 0x2c, 0xc2, 0x63, 0xe6, 0x1a, 0x9d, 0x45, 0x70, 0x14, 0x5d, 0xe4, 0x42, 0xce,
+	0x86, 0x0b, 0x59, 0x87, 0xc2, 0x98, 0xb8, 0x61, 0x48, 0x7f, 0x3d, 0x67, 0xc8, 0x0a, 0xf4, 0x21,
+	0x2c, 0xc4, 0xc3, 0x4b, 0x4e, 0x60, 0x6a, 0xdd, 0x68, 0x34, 0x7a, 0x08, 0x95, 0x48, 0x8c, 0xcb,
+	0x0b, 0x5c, 0x79, 0xa0, 0x84, 0xb8, 0x5b, 0xd2, 0xaf, 0xd2, 0x78, 0x5c, 0x79, 0x3d, 0x27, 0x3d,
+	0xeb, 0x2d, 0xe9, 0x59, 0x8b, 0xa2, 0x17, 0x2f, 0x46, 0x9d, 0xcc, 0xc7, 0x51, 0x27, 0x83, 0x3f,
+	0x86, 0x6a, 0xc4, 0x40, 0x34, 0xee, 0xb4, 0x3f, 0x3d, 0x6c, 0xed, 0xf2, 0x20, 0xf5, 0x8a, 0xc5,
+	0x25, 0xa3, 0xae, 0xd1, 0x58, 0xb7, 0xdb, 0x3e, 0x38, 0xa8, 0x67, 0x50, 0x15, 0x4a, 0x7b, 0xfb,
+	0x9d, 0x23, 0x8e, 0xca, 0xe2, 0x57, 0x50, 0x8d, 0x58, 0x49, 0x8d, 0x6d, 0x73, 0x4a, 0x6c, 0xd3,
+	0x64, 0x6c, 0xcb, 0x84, 0xb1, 0x8d, 0x85, 0xb9, 0xdd, 0x76, 0xeb, 0xa0, 0x5d, 0x9f, 0x7f, 0x59,
+	0x83, 0x0a, 0xb7, 0xef, 0xd1, 0xc8, 0xa6, 0xa1, 0xf6, 0xef, 0x35, 0x80, 0x70, 0x35, 0xa1, 0x75,
+	0x28, 0x74, 0x39, 0x4f, 0x43, 0x63, 0xce, 0xe8, 0x66, 0xe2, 0x90, 0x19, 0x12, 0x85, 0xbe, 0x09,
+	0x05, 0x6f, 0xd4, 0xed, 0x12, 0x4f, 0x86, 0xbc, 0xdb, 0x71, 0x7f, 0x28, 0xbc, 0x95, 0x21, 0x71,
+	0xb4, 0xcb, 0x89, 0x69, 0xf5, 0x47, 0x2c, 0x00, 0x4e, 0xef, 0x22, 0x70, 0xf8, 0xef, 0x34, 0x28,
+	0x2b, 0x93, 0xf7, 0x37, 0x74, 0xc2, 0xf7, 0xa0, 0xc4, 0x74, 0x20, 0x3d, 0xe1, 0x86, 0x8b, 0x46,
+	0x58, 0x81, 0x7e, 0x0f, 0x4a, 0x72, 0x05, 0x48, 0x4f, 0xdc, 0x48, 0x16, 0xbb, 0x3f, 0x34, 0x42,
+	0x28, 0xde, 0x81, 0x1b, 0xcc, 0x2a, 0x5d, 0xba, 0xb9, 0x96, 0x76, 0x54, 0xb7, 0x9f, 0x5a, 0x6c,
+	0xfb, 0xa9, 0x43, 0x71, 0x78, 0x76, 0xe9, 0x59, 0x5d, 0xb3, 0x2f, 0xb4, 0x08, 0xca, 0xf8, 0x13,
+	0x40, 0xaa, 0xb0, 0x59, 0x5e, 0x17, 0x57, 0xa1, 0xfc, 0xda, 0xf4, 0xce, 0x84, 0x4a, 0xf8, 0x19,
+	0x54, 0x69, 0x71, 0xe7, 0xdd, 0x35, 0x74, 0x64, 0x87, 0x03, 0x89, 0x9e, 0xc9, 0xe6, 0x08, 0xe6,
+	0xcf, 0x4c, 0xef, 0x8c, 0xbd, 0x68, 0xd5, 0x60, 0xcf, 0xe8, 0x43, 0xa8, 0x77, 0xf9, 0x4b, 0x1e,
+	0xc5, 0x8e, 0x0c, 0x0b, 0xa2, 0x3e, 0xd8, 0x09, 0x7e, 0x0e, 0x15, 0xfe, 0x0e, 0xbf, 0x6d, 0x25,
+	0xf0, 0x0d, 0x58, 0x38, 0xb0, 0xcd, 0xa1, 0x77, 0xe6, 0xc8, 0xe8, 0x46, 0x5f, 0xba, 0x1e, 0xd6,
+	0xcd, 0xc4, 0xf8, 0x01, 0x2c, 0xb8, 0x64, 0x60, 0x5a, 0xb6, 0x65, 0x9f, 0x1e, 0x1d, 0x5f, 0xfa,
+	0xc4, 0x13, 0x07, 0xa6, 0x5a, 0x50, 0xfd, 0x92, 0xd6, 0x52, 0xd5, 0x8e, 0xfb, 0xce, 0xb1, 0x70,
+	0x73, 0xec, 0x19, 0xff, 0x8b, 0x06, 0x95, 0xcf, 0x4c, 0xbf, 0x2b, 0x87, 0x0e, 0x6d, 0x43, 0x2d,
+	0x70, 0x6e, 0xac, 0xa6, 0xa1, 0x25, 0x85, 0x58, 0xd6, 0x47, 0x6e, 0xa5, 0x65, 0x74, 0xac, 0x76,
+	0xd5, 0x0a, 0x26, 0xca, 0xb4, 0xbb, 0xa4, 0x1f, 0x88, 0xca, 0xa4, 0x8b, 0x62, 0x40, 0x55, 0x94,
+	0x5a, 0xf1, 0x72, 0x21, 0xdc, 0x7e, 0x70, 0x5f, 0xf2, 0xff, 0x19, 0x40, 0x93, 0x3a, 0xfc, 0xba,
+	0x3b, 0xb2, 0xc7, 0x50, 0xf3, 0x7c, 0xd3, 0x9d, 0x98, 0x1b, 0x55, 0x56, 0x1b, 0x38, 0xe8, 0x0f,
+	0x60, 0x61, 0xe8, 0x3a, 0xa7, 0x2e, 0xf1, 0xbc, 0x23, 0xdb, 0xf1, 0xad, 0x93, 0x4b, 0xb1, 0xa9,
+	0xad, 0xc9, 0xea, 0x3d, 0x56, 0x8b, 0xda, 0x50, 0x38, 0xb1, 0xfa, 0x3e, 0x71, 0xbd, 0x46, 0xae,
+	0x99, 0x5d, 0xad, 0x6d, 0x3c, 0xbb, 0xca, 0x6a, 0x6b, 0xdf, 0x63, 0xf8, 0xce, 0xe5, 0x90, 0x18,
+	0xb2, 0xaf, 0xba, 0x51, 0xcc, 0x47, 0x36, 0xcf, 0x77, 0xa0, 0xf8, 0x9e, 0x8a, 0xa0, 0x87, 0xe2,
+	0x02, 0xdf, 0xdb, 0xb1, 0x32, 0x3f, 0x13, 0x9f, 0xb8, 0xe6, 0xe9, 0x80, 0xd8, 0xbe, 0x3c, 0xb6,
+	0xc9, 0x32, 0x6d, 0xeb, 0x3a, 0x66, 0x9f, 0x78, 0x5d, 0x22, 0x0e, 0x6d, 0x41, 0x19, 0x3f, 0x06,
+	0x08, 0x55, 0xa0, 0xde, 0x7b, 0x6f, 0xff, 0xed, 0x61, 0xa7, 0x3e, 0x87, 0x2a, 0x50, 0xdc, 0xdb,
+	0xdf, 0x6a, 0xef, 0xb6, 0xa9, 0xab, 0xc7, 0xeb, 0xd2, 0xdc, 0xea, 0xb0, 0x44, 0xf4, 0xd1, 0x22,
+	0xfa, 0xe0, 0x7f, 0xcf, 0x40, 0x55, 0x4c, 0xac, 0x99, 0x66, 0xb7, 0x4a, 0x91, 0x89, 0xbe, 0x72,
+	0x03, 0x0a, 0x7c, 0xc2, 0xf5, 0xc4, 0x7e, 0x5a, 0x16, 0xd9, 0x0b, 0x33, 0x45, 0x49, 0x4f, 0x8c,
+	0x54, 0x50, 0x4e, 0xf4, 0x08, 0xb9, 0x44, 0x8f, 0x80, 0x1e, 0x42, 0x35, 0x98, 0xc0, 0xa6, 0x27,
+	0xc2, 0x77, 0xc9, 0xa8, 0xc8, 0xb9, 0x49, 0xeb, 0x22, 0x86, 0x2f, 0xc4, 0x0c, 0x7f, 0x0f, 0x4a,
+	0xd2, 0xd0, 0x3d, 0x31, 0x2a, 0x61, 0x05, 0x7a, 0x0c, 0x79, 0x32, 0x26, 0xb6, 0xef, 0x35, 0xca,
+	0x2c, 0x04, 0x54, 0xe5, 0x66, 0xbc, 0x4d, 0x6b, 0x0d, 0xd1, 0x88, 0xbf, 0x03, 0x37, 0xd8, 0xa1,
+	0xe7, 0x95, 0x6b, 0xda, 0xea, 0xe9, 0xac, 0xd3, 0xd9, 0x15, 0x46, 0xa7, 0x8f, 0xa8, 0x06, 0x99,
+	0xed, 0x2d, 0x61, 0xa2, 0xcc, 0xf6, 0x16, 0xfe, 0xb1, 0x06, 0x48, 0xed, 0x37, 0xd3, 0x28, 0xc4,
+	0x84, 0x4b, 0xfa, 0x6c, 0x48, 0xbf, 0x04, 0x39, 0xe2, 0xba, 0x8e, 0xcb, 0xec, 0x5d, 0x32, 0x78,
+	0x01, 0x3f, 0x12, 0x3a, 0x18, 0x64, 0xec, 0x9c, 0x07, 0xab, 0x94, 0x4b, 0xd3, 0x02, 0x55, 0x77,
+	0x60, 0x31, 0x82, 0x9a, 0x29, 0x14, 0x7d, 0x00, 0x37, 0x99, 0xb0, 0x1d, 0x42, 0x86, 0xad, 0xbe,
+	0x35, 0x4e, 0x65, 0x1d, 0xc2, 0xad, 0x38, 0xf0, 0xeb, 0xb5, 0x11, 0xfe, 0x43, 0xc1, 0xd8, 0xb1,
+	0x06, 0xa4, 0xe3, 0xec, 0xa6, 0xeb, 0x46, 0x5d, 0x35, 0x4d, 0xba, 0x88, 0x98, 0xcd, 0x9e, 0xf1,
+	0x3f, 0x68, 0x70, 0x7b, 0xa2, 0xfb, 0xd7, 0x3c, 0xaa, 0xcb, 0x00, 0xa7, 0x74, 0xfa, 0x90, 0x1e,
+	0x6d, 0xe0, 0xe9, 0x02, 0xa5, 0x26, 0xd0, 0x93, 0x7a, 0xbb, 0x8a, 0xd0, 0x73, 0x49, 0x8c, 0x39,
+	0xfb, 0xf1, 0x64, 0xc0, 0xbb, 0x0f, 0x65, 0x56, 0x71, 0xe0, 0x9b, 0xfe, 0xc8, 0x9b, 0x18, 0x8c,
+	0x3f, 0x17, 0x53, 0x40, 0x76, 0x9a, 0xe9, 0xbd, 0xbe, 0x09, 0x79, 0xb6, 0x53, 0x96, 0xfb, 0xc4,
+	0xd8, 0xd1, 0x44, 0xd1, 0xc3, 0x10, 0x40, 0x7c, 0x06, 0xf9, 0x37, 0x2c, 0xbd, 0xa8, 0x68, 0x36,
+	0x2f, 0x87, 0xc2, 0x36, 0x07, 0x3c, 0xe9, 0x51, 0x32, 0xd8, 0x33, 0xdb, 0x56, 0x11, 0xe2, 0x1e,
+	0x1a, 0xbb, 0x7c, 0xfb, 0x56, 0x32, 0x82, 0x32, 0x35, 0x59, 0xb7, 0x6f, 0x11, 0xdb, 0x67, 0xad,
+	0xf3, 0xac, 0x55, 0xa9, 0xc1, 0x6b, 0x50, 0xe7, 0x4c, 0xad, 0x5e, 0x4f, 0xd9, 0x1e, 0x05, 0xf2,
+	0xb4, 0xa8, 0x3c, 0xfc, 0x8f, 0x1a, 0xdc, 0x50, 0x3a, 0xcc, 0x64, 0x98, 0x8f, 0x20, 0xcf, 0x93,
+	0xa8, 0x22, 0x12, 0x2f, 0x45, 0x7b, 0x71, 0x1a, 0x43, 0x60, 0xd0, 0x1a, 0x14, 0xf8, 0x93, 0xdc,
+	0xa3, 0x26, 0xc3, 0x25, 0x08, 0x3f, 0x86, 0x45, 0x51, 0x45, 0x06, 0x4e, 0xd2, 0xdc, 0x66, 0x06,
+	0xc5, 0x7f, 0x06, 0x4b, 0x51, 0xd8, 0x4c, 0xaf, 0xa4, 0x28, 0x99, 0xb9, 0x8e, 0x92, 0x2d, 0xa9,
+	0xe4, 0xe1, 0xb0, 0x67, 0xfa, 0x69, 0x4a, 0x46, 0x46, 0x24, 0x13, 0x1b, 0x91, 0xe0, 0x05, 0xa4,
+	0x88, 0xdf, 0xe9, 0x0b, 0x2c, 0xca, 0xe9, 0xb0, 0x6b, 0x79, 0xc1, 0x76, 0xf2, 0x4b, 0x40, 0x6a,
+	0xe5, 0xef, 0x5a, 0xa1, 0x2d, 0x22, 0x43, 0x9e, 0x54, 0xe8, 0x13, 0x40, 0x6a, 0xe5, 0x4c, 0x1e,
+	0x7d, 0x1d, 0x6e, 0xbc, 0x71, 0xc6, 0x64, 0x97, 0xd7, 0x86, 0x4b, 0x86, 0x1f, 0x2e, 0x83, 0x61,
+	0x0b, 0xca, 0x94, 0x5c, 0xed, 0x30, 0x13, 0x79, 0x1b, 0x16, 0xe5, 0x7e, 0x80, 0xfa, 0xdd, 0xeb,
+	0x1c, 0xba, 0x10, 0xcc, 0xfb, 0x96, 0xf0, 0x18, 0x59, 0x83, 0x3d, 0xd3, 0x39, 0x13, 0x15, 0x33,
+	0xd3, 0x10, 0xa9, 0xec, 0x99, 0x14, 0xf6, 0xac, 0xc2, 0xbe, 0x03, 0xb7, 0xc3, 0xa3, 0xde, 0xa6,
+	0x63, 0x9f, 0x58, 0xa7, 0xf2, 0x45, 0x58, 0x7e, 0xb8, 0xc7, 0x33, 0x54, 0x25, 0x83, 0x3d, 0xd3,
+	0x6d, 0x8b, 0x4b, 0x7c, 0x62, 0xfb, 0xa1, 0xfc, 0xb0, 0x82, 0x6e, 0x2c, 0x1a, 0x93, 0xd2, 0x66,
+	0x3d, 0x34, 0x31, 0x25, 0x32, 0x69, 0x4a, 0x64, 0xe3, 0x4a, 0xfc, 0xa7, 0x06, 0x95, 0x56, 0xdf,
+	0x74, 0x07, 0xf2, 0x3d, 0xbe, 0x0b, 0x79, 0xae, 0x90, 0x48, 0x1e, 0x3d, 0x89, 0x12, 0xab, 0x58,
+	0x5e, 0x68, 0x31, 0xb4, 0x21, 0x7a, 0x51, 0x93, 0x8a, 0xfb, 0xa5, 0xad, 0xd8, 0x7d, 0xd3, 0x16,
+	0x7a, 0x0e, 0x39, 0x93, 0x76, 0x61, 0x6a, 0xd4, 0xe2, 0x39, 0x04, 0x26, 0x8d, 0x6d, 0xe0, 0x39,
+	0x0a, 0x7f, 0x1b, 0xca, 0x0a, 0x03, 0xcd, 0x92, 0xbc, 0x6a, 0x8b, 0x1d, 0x75, 0x6b, 0xb3, 0xb3,
+	0xfd, 0x8e, 0x27, 0x4f, 0x6a, 0x00, 0x5b, 0xed, 0xa0, 0x9c, 0xc1, 0x9f, 0x8b, 0x5e, 0x22, 0x0c,
+	0xa9, 0xfa, 0x68, 0x69, 0xfa, 0x64, 0xae, 0xa5, 0xcf, 0x05, 0x54, 0xc5, 0xeb, 0xcf, 0x1a, 0x55,
+	0x99, 0xbc, 0x94, 0xa8, 0xaa, 0x28, 0x6f, 0x08, 0x20, 0x5e, 0x80, 0xaa, 0x88, 0xb3, 0xc2, 0x2d,
+	0xfc, 0x73, 0x06, 0x6a, 0xb2, 0x66, 0xd6, 0x24, 0xb7, 0xcc, 0xcf, 0xf1, 0x49, 0x23, 0x8b, 0xe8,
+	0x16, 0xe4, 0x7b, 0xc7, 0x07, 0xd6, 0x97, 0x72, 0x05, 0x88, 0x12, 0xad, 0xef, 0x73, 0x1e, 0x7e,
+	0x2b, 0x28, 0x4a, 0x6c, 0x9e, 0x99, 0x27, 0xfe, 0xb6, 0xdd, 0x23, 0x17, 0xec, 0x20, 0x30, 0x6f,
+	0x84, 0x15, 0x6c, 0xa5, 0x89, 0xdb, 0xc3, 0x46, 0x3e, 0x7a, 0x9b, 0x88, 0x9e, 0x42, 0x9d, 0x3e,
+	0xb7, 0x86, 0xc3, 0xbe, 0x45, 0x7a, 0x5c, 0x40, 0x81, 0x61, 0x26, 0xea, 0x29, 0x3b, 0xdb, 0x11,
+	0x7b, 0x8d, 0x22, 0x8b, 0x26, 0xa2, 0x84, 0x9a, 0x50, 0xe6, 0xfa, 0x6d, 0xdb, 0x87, 0x1e, 0x3f,
+	0x9d, 0x65, 0x0d, 0xb5, 0x8a, 0xba, 0xd7, 0xd6, 0xc8, 0x3f, 0x6b, 0xdb, 0xf4, 0x7a, 0x4e, 0xda,
+	0x71, 0x09, 0x10, 0xad, 0xdc, 0xb2, 0x3c, 0xb5, 0xb6, 0x0d, 0x8b, 0xb4, 0x96, 0x2e, 0x91, 0xae,
+	0x12, 0xdb, 0xe4, 0x0e, 0x46, 0x8b, 0xed, 0x60, 0x4c, 0xcf, 0x7b, 0xef, 0xb8, 0x3d, 0x61, 0xc0,
+	0xa0, 0x8c, 0xb7, 0xb8, 0xf0, 0x43, 0x2f, 0xb2, 0x47, 0xf9, 0x75, 0xa5, 0xac, 0x86, 0x52, 0x5e,
+	0x11, 0x7f, 0x8a, 0x14, 0xfc, 0x0c, 0x6e, 0x4a, 0xa4, 0x48, 0x33, 0x4f, 0x01, 0xef, 0xc3, 0x7d,
+	0x09, 0xde, 0x3c, 0xa3, 0xe7, 0xf8, 0xb7, 0x82, 0xf0, 0x37, 0xd5, 0xf3, 0x25, 0x34, 0x02, 0x3d,
+	0xd9, 0x49, 0xc9, 0xe9, 0xab, 0x0a, 0x8c, 0x3c, 0x31, 0x33, 0x4b, 0x06, 0x7b, 0xa6, 0x75, 0xae,
+	0xd3, 0x0f, 0x7c, 0x15, 0x7d, 0xc6, 0x9b, 0x70, 0x47, 0xca, 0x10, 0x67, 0x98, 0xa8, 0x90, 0x09,
+	0x85, 0x92, 0x84, 0x08, 0x83, 0xd1, 0xae, 0xd3, 0xcd, 0xae, 0x22, 0xa3, 0xa6, 0x65, 0x32, 0x35,
+	0x45, 0xe6, 0x4d, 0x58, 0x94, 0x8a, 0xa9, 0xdb, 0x05, 0x51, 0x4d, 0x05, 0xa8, 0xd5, 0x62, 0x20,
+	0x68, 0xf5, 0xc4, 0x40, 0x4c, 0x88, 0xfe, 0x01, 0x2c, 0x07, 0x4a, 0x50, 0xbb, 0xbd, 0x25, 0xee,
+	0xc0, 0xf2, 0x3c, 0x25, 0x31, 0x99, 0xf4, 0xe2, 0x4f, 0x60, 0x7e, 0x48, 0x84, 0xe7, 0x2a, 0x6f,
+	0xa0, 0x35, 0xfe, 0x25, 0xc1, 0x9a, 0xd2, 0x99, 0xb5, 0xe3, 0x1e, 0x3c, 0x90, 0xd2, 0xb9, 0x45,
+	0x13, 0xc5, 0xc7, 0x95, 0x92, 0xf9, 0x9f, 0x4c, 0x4a, 0xfe, 0x27, 0x1b, 0x4b, 0x96, 0x7f, 0x02,
+	0x48, 0x5d, 0x5b, 0x33, 0x6d, 0x14, 0x76, 0x60, 0x31, 0xb2, 0x24, 0x67, 0x12, 0x76, 0x0c, 0x4b,
+	0xd1, 0x95, 0x3c, 0x93, 0xb3, 0x5c, 0x82, 0x9c, 0xef, 0x9c, 0x13, 0xe9, 0x2a, 0x79, 0x01, 0xef,
+	0x84, 0x73, 0x63, 0xe6, 0x93, 0x05, 0x36, 0x43, 0x61, 0x6c, 0x4a, 0xce, 0xaa, 0x2f, 0x1d, 0x4d,
+	0xb9, 0xf3, 0xe6, 0x05, 0xbc, 0x07, 0xb7, 0xe2, 0x6e, 0x62, 0x26, 0x95, 0xdf, 0xc1, 0xb2, 0x94,
+	0x17, 0xf7, 0x24, 0x33, 0xc9, 0xfd, 0x34, 0x74, 0x06, 0x8a, 0x43, 0x99, 0x49, 0xa4, 0x01, 0x7a,
+	0x92, 0x7f, 0xf9, 0x6d, 0xcc, 0xd7, 0xc0, 0xdd, 0xcc, 0x24, 0xcc, 0x0b, 0x85, 0xcd, 0x3e, 0xfc,
+	0xa1, 0x8f, 0xc8, 0x4e, 0xf5, 0x11, 0x62, 0x91, 0x84, 0x5e, 0xec, 0x6b, 0x98, 0x74, 0x82, 0x23,
+	0x74, 0xa0, 0xb3, 0x72, 0xd0, 0x18, 0x12, 0x70, 0xb0, 0x82, 0x9c, 0xd8, 0xaa, 0xdb, 0x9d, 0x69,
+	0x30, 0x3e, 0x0b, 0x7d, 0xe7, 0x84, 0x67, 0x9e, 0x49, 0xf0, 0xe7, 0xd0, 0x4c, 0x77, 0xca, 0xb3,
+	0x48, 0x7e, 0xfa, 0x31, 0x94, 0x82, 0x6d, 0xab, 0xf2, 0x15, 0x4e, 0x19, 0x0a, 0x7b, 0xfb, 0x07,
+	0x6f, 0x5b, 0x9b, 0x6d, 0xfe, 0x19, 0xce, 0xe6, 0xbe, 0x61, 0x1c, 0xbe, 0xed, 0xd4, 0x33, 0x68,
+	0x01, 0xca, 0x6f, 0x5a, 0xdb, 0x7b, 0x9d, 0xf6, 0x5e, 0x6b, 0x6f, 0xb3, 0x5d, 0xcf, 0x6e, 0xfc,
+	0x32, 0x0b, 0x99, 0x9d, 0x77, 0xe8, 0xfb, 0x90, 0xe3, 0x97, 0xd4, 0x53, 0xbe, 0x4c, 0xd0, 0xa7,
+	0xdd, 0xc3, 0xe3, 0xdb, 0x3f, 0xfe, 0xef, 0x5f, 0xfe, 0x2c, 0x73, 0x03, 0x57, 0xd6, 0xc7, 0xdf,
+	0x5a, 0x3f, 0x1f, 0xaf, 0xb3, 0x60, 0xf1, 0x42, 0x7b, 0x8a, 0x3e, 0x85, 0x2c, 0xbd, 0x56, 0x4f,
+	0xfd, 0x62, 0x41, 0x4f, 0xbf, 0x9a, 0xc7, 0x37, 0x99, 0xd0, 0x05, 0x0c, 0x42, 0xe8, 0x70, 0xe4,
+	0x53, 0x91, 0x3f, 0x84, 0xb2, 0x7a, 0xb1, 0x7e, 0xe5, 0x67, 0x0c, 0xfa, 0xd5, 0x97, 0xf6, 0xf8,
+	0x3e, 0xa3, 0xba, 0x8d, 0x91, 0xa0, 0xe2, 0x57, 0xff, 0xea, 0x5b, 0x74, 0x2e, 0x6c, 0x94, 0xfa,
+	0x91, 0x83, 0x9e, 0x7e, 0x8f, 0x3f, 0xf1, 0x16, 0xfe, 0x85, 0x4d, 0x45, 0xfe, 0x89, 0xb8, 0xc2,
+	0xef, 0xfa, 0xe8, 0x41, 0xc2, 0x15, 0xae, 0x7a, 0x59, 0xa9, 0x37, 0xd3, 0x01, 0x82, 0xe4, 0x1e,
+	0x23, 0xb9, 0x85, 0x6f, 0x08, 0x92, 0x6e, 0x00, 0x79, 0xa1, 0x3d, 0xdd, 0xe8, 0x42, 0x8e, 0xdd,
+	0x2a, 0xa0, 0x2f, 0xe4, 0x83, 0x9e, 0x70, 0xc5, 0x92, 0x32, 0xd0, 0x91, 0xfb, 0x08, 0xbc, 0xc4,
+	0x88, 0x6a, 0xb8, 0x44, 0x89, 0xd8, 0x9d, 0xc2, 0x0b, 0xed, 0xe9, 0xaa, 0xf6, 0x0d, 0x6d, 0xe3,
+	0x9f, 0x72, 0x90, 0x63, 0x49, 0x42, 0x74, 0x0e, 0x10, 0xe6, 0xd0, 0xe3, 0x6f, 0x37, 0x91, 0x95,
+	0xd7, 0x9b, 0xe9, 0x00, 0x41, 0xaa, 0x33, 0xd2, 0x25, 0xbc, 0x40, 0x49, 0x59, 0xee, 0x71, 0x9d,
+	0xa5, 0x53, 0xa9, 0x1d, 0xff, 0x4a, 0x13, 0x39, 0x52, 0xbe, 0xb8, 0x50, 0x92, 0xb4, 0x48, 0x22,
+	0x5d, 0x5f, 0x99, 0x82, 0x10, 0x84, 0xdf, 0x61, 0x84, 0xeb, 0xb8, 0x1e, 0x12, 0xba, 0x0c, 0xf1,
+	0x42, 0x7b, 0xfa, 0x45, 0x03, 0x2f, 0x0a, 0x2b, 0xc7, 0x5a, 0xd0, 0x8f, 0xa0, 0x16, 0x4d, 0x8e,
+	0xa3, 0x87, 0x09, 0x5c, 0xf1, 0x1c, 0xbb, 0xfe, 0x68, 0x3a, 0x48, 0xe8, 0xb4, 0xcc, 0x74, 0x12,
+	0xe4, 0x9c, 0xf9, 0x9c, 0x90, 0xa1, 0x49, 0x41, 0x62, 0x0c, 0xd0, 0xcf, 0x35, 0x58, 0x88, 0x65,
+	0xbb, 0x51, 0x92, 0xf4, 0x89, 0x5c, 0xba, 0xfe, 0xf8, 0x0a, 0x94, 0x50, 0xe2, 0x8f, 0x98, 0x12,
+	0xbf, 0x8f, 0x97, 0x42, 0x25, 0x68, 0xae, 0xc4, 0x77, 0x84, 0x16, 0x5f, 0xdc, 0xc3, 0xb7, 0x23,
+	0xc6, 0x89, 0xb4, 0x86, 0x83, 0xc5, 0x7e, 0xbc, 0xc4, 0xc1, 0x8a, 0x64, 0xc0, 0xf5, 0x95, 0x29,
+	0x88, 0xf4, 0xc1, 0x62, 0xbf, 0x5e, 0xd2, 0x60, 0x05, 0x2d, 0x1b, 0xec, 0x23, 0x1a, 0xfe, 0xe9,
+	0x2c, 0x72, 0xa0, 0x14, 0x64, 0x8b, 0xd1, 0x72, 0x52, 0xe6, 0x2e, 0x3c, 0x5c, 0xe8, 0x0f, 0x52,
+	0xdb, 0x85, 0x42, 0x2b, 0x4c, 0xa1, 0xbb, 0xf8, 0x16, 0x65, 0x16, 0x5f, 0xe7, 0xae, 0xf3, 0x3c,
+	0xc4, 0xba, 0xd9, 0xeb, 0x51, 0x43, 0xfc, 0x29, 0x54, 0xd4, 0x74, 0x2e, 0x5a, 0x49, 0x92, 0x19,
+	0xc9, 0x08, 0xeb, 0x78, 0x1a, 0x44, 0x30, 0x3f, 0x62, 0xcc, 0xcb, 0xf8, 0x4e, 0x02, 0xb3, 0xcb,
+	0xa0, 0x11, 0x72, 0x9e, 0x8a, 0x4d, 0x26, 0x8f, 0x64, 0x7a, 0x75, 0x3c, 0x0d, 0x72, 0x0d, 0xf2,
+	0x11, 0x83, 0x52, 0x72, 0x0f, 0x20, 0x4c, 0xba, 0xa2, 0x44, 0x5b, 0x2a, 0xa7, 0x2b, 0xbd, 0x99,
+	0x0e, 0x10, 0xb4, 0x98, 0xd1, 0x8a, 0x79, 0x17, 0xa3, 0xed, 0x5b, 0x1e, 0x75, 0x12, 0x1b, 0x3f,
+	0x2f, 0x42, 0xf9, 0x8d, 0x69, 0xd9, 0x3e, 0xb1, 0xe9, 0x2d, 0x24, 0x3a, 0x86, 0x1c, 0x8b, 0x9c,
+	0x71, 0x3f, 0xa8, 0x26, 0xbc, 0xf4, 0xbb, 0x89, 0x6d, 0x82, 0xb5, 0xc9, 0x58, 0x75, 0x7c, 0x93,
+	0xb2, 0x0e, 0x42, 0xd1, 0xeb, 0x2c, 0x89, 0x43, 0x5f, 0xf4, 0x04, 0xf2, 0xe2, 0xda, 0x26, 0x26,
+	0x28, 0x92, 0xdc, 0xd1, 0xef, 0x25, 0x37, 0x26, 0x4d, 0x25, 0x95, 0xc6, 0x63, 0x38, 0xca, 0x33,
+	0x06, 0x08, 0x93, 0xc6, 0x71, 0x83, 0x4e, 0xe4, 0x98, 0xf5, 0x66, 0x3a, 0x40, 0x70, 0x3e, 0x66,
+	0x9c, 0x0f, 0xb0, 0x1e, 0xe7, 0xec, 0x05, 0x58, 0xca, 0xfb, 0xc7, 0x30, 0x4f, 0xbf, 0xfc, 0x40,
+	0xb1, 0xd0, 0xa7, 0x7c, 0xd1, 0xa2, 0xeb, 0x49, 0x4d, 0x82, 0xe5, 0x01, 0x63, 0xb9, 0x83, 0x97,
+	0xe2, 0x2c, 0xf4, 0xe3, 0x0f, 0x2a, 0xbf, 0x07, 0x79, 0xfe, 0x81, 0x4b, 0xdc, 0x7e, 0x91, 0x8f,
+	0x64, 0xf4, 0x7b, 0xc9, 0x8d, 0xd7, 0x65, 0x19, 0x42, 0x51, 0x7e, 0x51, 0x82, 0xee, 0xc7, 0x86,
+	0x22, 0xfa, 0xf5, 0x89, 0xbe, 0x9c, 0xd6, 0x2c, 0xb8, 0x1e, 0x32, 0xae, 0xfb, 0xb8, 0x31, 0x31,
+	0x56, 0x02, 0xf9, 0x42, 0x7b, 0xfa, 0x0d, 0x0d, 0xfd, 0x08, 0x20, 0xcc, 0xb3, 0x4f, 0x2c, 0x80,
+	0x78, 0xca, 0x5e, 0x6f, 0xa6, 0x03, 0x04, 0xef, 0x1a, 0xe3, 0x5d, 0xc5, 0x0f, 0xe3, 0xbc, 0xbe,
+	0x6b, 0xda, 0xde, 0x09, 0x71, 0x9f, 0xf3, 0xa4, 0x9d, 0x77, 0x66, 0x0d, 0x79, 0x94, 0xaa, 0xa8,
+	0x59, 0xf5, 0xf8, 0xf2, 0x4f, 0x48, 0xdc, 0xeb, 0x78, 0x1a, 0x44, 0xa8, 0xb1, 0xca, 0xd4, 0xc0,
+	0xf8, 0x7e, 0x5c, 0x0d, 0x99, 0x64, 0x7f, 0x4e, 0x63, 0x01, 0x55, 0xe0, 0xaf, 0x35, 0xa8, 0xc7,
+	0x73, 0xe1, 0xe8, 0x71, 0xda, 0x1e, 0x27, 0x92, 0x79, 0xd7, 0x9f, 0x5c, 0x05, 0x13, 0xda, 0x7c,
+	0xc4, 0xb4, 0x79, 0x82, 0x57, 0xe2, 0xda, 0x84, 0x3b, 0xa3, 0xe7, 0x5d, 0xd6, 0x85, 0xfa, 0x87,
+	0x7f, 0x5b, 0x80, 0x79, 0xba, 0x49, 0xa7, 0x5b, 0x97, 0x30, 0xb7, 0x11, 0x1f, 0x9c, 0x89, 0x8c,
+	0xa2, 0xde, 0x4c, 0x07, 0x24, 0x6d, 0x5d, 0xd8, 0xbf, 0x81, 0x10, 0x06, 0xa0, 0x76, 0x70, 0xa0,
+	0xac, 0x24, 0x3f, 0x50, 0x82, 0xb0, 0x68, 0xaa, 0x52, 0x5f, 0x99, 0x82, 0x10, 0x7c, 0x77, 0x19,
+	0xdf, 0x4d, 0x5c, 0x0f, 0xf8, 0x7a, 0x96, 0x27, 0x09, 0xdf, 0x43, 0x45, 0x4d, 0x90, 0xa0, 0x04,
+	0x79, 0xb1, 0x34, 0xa8, 0x8e, 0xa7, 0x41, 0x92, 0x7c, 0x61, 0xf0, 0xaf, 0x2e, 0x12, 0x46, 0x89,
+	0xfb, 0x50, 0x10, 0x19, 0x93, 0xa4, 0xb7, 0x8c, 0xe6, 0x4c, 0xf5, 0x95, 0x29, 0x88, 0xa4, 0xed,
+	0x2e, 0x63, 0x1c, 0x79, 0x61, 0x70, 0x15, 0x6c, 0xaf, 0x88, 0x9f, 0xc6, 0x16, 0x26, 0x00, 0xf5,
+	0x95, 0x29, 0x88, 0xe9, 0x6c, 0xa7, 0xc4, 0x17, 0x1e, 0x44, 0x1e, 0x74, 0x51, 0x8a, 0x30, 0x35,
+	0xa0, 0xe1, 0x69, 0x90, 0xa4, 0xd3, 0x48, 0x48, 0x28, 0xa2, 0x19, 0xba, 0x00, 0x08, 0xf3, 0x39,
+	0xe8, 0x61, 0xb2, 0xc0, 0x48, 0x2e, 0x52, 0x7f, 0x34, 0x1d, 0x94, 0xe4, 0x2d, 0x43, 0x5e, 0x7e,
+	0x18, 0xa2, 0xcc, 0x3f, 0xd5, 0x00, 0x4d, 0xa6, 0x7e, 0xd0, 0xb3, 0x64, 0xe9, 0x89, 0xa9, 0x66,
+	0xfd, 0xa3, 0xeb, 0x81, 0x93, 0x02, 0x60, 0xa8, 0x52, 0x97, 0xa1, 0x87, 0xef, 0xa9, 0x52, 0x7f,
+	0xa1, 0x41, 0x35, 0x92, 0x37, 0x42, 0x4f, 0x52, 0xc6, 0x34, 0x96, 0xa9, 0xd6, 0x3f, 0xb8, 0x12,
+	0x97, 0xb4, 0xf7, 0x56, 0x66, 0x80, 0x3c, 0x84, 0xfc, 0x44, 0x83, 0x5a, 0x34, 0xcf, 0x84, 0x52,
+	0x64, 0x4f, 0x64, 0xba, 0xf5, 0xd5, 0xab, 0x81, 0xd3, 0x87, 0x27, 0x3c, 0x7f, 0xf4, 0xa1, 0x20,
+	0x32, 0x53, 0x49, 0x13, 0x3f, 0x9a, 0x23, 0xd7, 0x57, 0xa6, 0x20, 0x52, 0x27, 0xbe, 0xeb, 0xf4,
+	0x89, 0xb2, 0xcc, 0x44, 0xea, 0x2a, 0x8d, 0x6d, 0xfa, 0x32, 0x8b, 0xe5, 0xbd, 0xd2, 0xd8, 0xc2,
+	0x65, 0x26, 0x73, 0x56, 0x28, 0x45, 0xd8, 0x15, 0xcb, 0x2c, 0x9e, 0xf2, 0x4a, 0x58, 0x66, 0x8c,
+	0x50, 0x59, 0x66, 0x61, 0x76, 0x29, 0x69, 0x99, 0x4d, 0xa4, 0xfc, 0xf5, 0x47, 0xd3, 0x41, 0xa9,
+	0xe3, 0xc8, 0x78, 0x23, 0xcb, 0x6c, 0x31, 0x21, 0x11, 0x85, 0x3e, 0x4a, 0x31, 0x62, 0xe2, 0x4d,
+	0x82, 0xfe, 0xfc, 0x9a, 0xe8, 0xd4, 0x39, 0xce, 0xcd, 0x2f, 0xe7, 0xf8, 0xdf, 0x68, 0xb0, 0x94,
+	0x94, 0xc4, 0x42, 0x29, 0x3c, 0x29, 0x37, 0x10, 0xfa, 0xda, 0x75, 0xe1, 0xd3, 0xad, 0x15, 0xcc,
+	0xfa, 0x97, 0xf5, 0xff, 0xf8, 0x6a, 0x59, 0xfb, 0xaf, 0xaf, 0x96, 0xb5, 0xff, 0xf9, 0x6a, 0x59,
+	0xfb, 0xdb, 0xff, 0x5d, 0x9e, 0x3b, 0xce, 0xb3, 0x7f, 0xa0, 0xfc, 0xd6, 0xaf, 0x06, 0x00, 0x9b,
+	0x81, 0x11, 0xca, 0xc7, 0x39, 0x00, 0x00,
 }
@@ -0,0 +1,139 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/pkg/types"
+
+	"github.com/coreos/go-semver/semver"
+	"go.uber.org/zap"
+)
+
+// maintenanceModeClusterVersion is the cluster version from which every
+// member applies the MAINTENANCE alarm. Older members ignore it and would
+// keep accepting the writes the others reject.
+var maintenanceModeClusterVersion = semver.Version{Major: 3, Minor: 4}
+
+// maintenanceModeCheckInterval is the interval at which the leader checks
+// whether the MAINTENANCE alarm has timed out.
+var maintenanceModeCheckInterval = time.Second
+
+type applierV3Maintenance struct {
+	applierV3
+}
+
+// newApplierV3Maintenance creates an applyV3 that rejects the requests that
+// change the keyspace or grant leases, while the MAINTENANCE alarm is active.
+// Reads and watches are served as usual. Leases still expire, so keys
+// attached to them may be deleted.
+func newApplierV3Maintenance(a applierV3) applierV3 { return &applierV3Maintenance{a} }
+
+func (a *applierV3Maintenance) Put(txn mvcc.TxnWrite, p *pb.PutRequest) (*pb.PutResponse, error) {
+	return nil, ErrMaintenanceMode
+}
+
+func (a *applierV3Maintenance) DeleteRange(txn mvcc.TxnWrite, dr *pb.DeleteRangeRequest) (*pb.DeleteRangeResponse, error) {
+	return nil, ErrMaintenanceMode
+}
+
+func (a *applierV3Maintenance) Txn(rt *pb.TxnRequest) (*pb.TxnResponse, error) {
+	if !isTxnReadonly(rt) {
+		return nil, ErrMaintenanceMode
+	}
+	return a.applierV3.Txn(rt)
+}
+
+func (a *applierV3Maintenance) Compaction(compaction *pb.CompactionRequest) (*pb.CompactionResponse, <-chan struct{}, error) {
+	return nil, nil, ErrMaintenanceMode
+}
+
+func (a *applierV3Maintenance) LeaseGrant(lc *pb.LeaseGrantRequest) (*pb.LeaseGrantResponse, error) {
+	return nil, ErrMaintenanceMode
+}
+
+// enterMaintenanceMode starts rejecting writes once the MAINTENANCE alarm
+// is activated.
+func (s *EtcdServer) enterMaintenanceMode() {
+	s.applyV3 = s.newAlarmApplierV3()
+	atomic.StoreInt64(&s.maintenanceSince, time.Now().UnixNano())
+}
+
+// exitMaintenanceMode accepts writes again once the MAINTENANCE alarm is
+// deactivated, unless other alarms still forbid them.
+func (s *EtcdServer) exitMaintenanceMode() {
+	s.applyV3 = s.newAlarmApplierV3()
+	atomic.StoreInt64(&s.maintenanceSince, 0)
+}
+
+// monitorMaintenanceMode deactivates the MAINTENANCE alarm once it has been
+// active for MaintenanceModeTimeout, so that a cluster is not left read-only
+// by an operator or a migration that never finished. Only the leader
+// deactivates it. Every member times the alarm from when it applied it, so
+// a new leader does not restart the timeout.
+func (s *EtcdServer) monitorMaintenanceMode() {
+	t := s.Cfg.MaintenanceModeTimeout
+	if t == 0 {
+		return
+	}
+	for {
+		select {
+		case <-s.stopping:
+			return
+		case <-time.After(maintenanceModeCheckInterval):
+		}
+		since := atomic.LoadInt64(&s.maintenanceSince)
+		if since == 0 || !s.isLeader() || time.Since(time.Unix(0, since)) < t {
+			continue
+		}
+		s.disarmMaintenanceMode(t)
+	}
+}
+
+func (s *EtcdServer) disarmMaintenanceMode(timeout time.Duration) {
+	lg := s.getLogger()
+	for _, m := range s.alarmStore.Get(pb.AlarmType_MAINTENANCE) {
+		if lg != nil {
+			lg.Warn(
+				"maintenance mode timed out; disarming alarm",
+				zap.String("from", types.ID(m.MemberID).String()),
+				zap.Duration("timeout", timeout),
+			)
+		} else {
+			plog.Warningf("maintenance mode timed out after %v; disarming alarm from %s", timeout, types.ID(m.MemberID))
+		}
+		ar := &pb.AlarmRequest{
+			MemberID: m.MemberID,
+			Action:   pb.AlarmRequest_DEACTIVATE,
+			Alarm:    pb.AlarmType_MAINTENANCE,
+		}
+		ctx, cancel := context.WithTimeout(s.ctx, s.Cfg.ReqTimeout())
+		_, err := s.Alarm(ctx, ar)
+		cancel()
+		if err != nil {
+			if lg != nil {
+				lg.Warn("failed to disarm maintenance mode", zap.Error(err))
+			} else {
+				plog.Errorf("failed to disarm maintenance mode (%v)", err)
+			}
+			return
+		}
+	}
+}
@@ -84,6 +84,36 @@ var (
 		Name:      "lease_expired_total",
 		Help:      "The total number of expired leases.",
 	})
+	orphanLeaseKeysDeleted = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "orphan_lease_keys_deleted_total",
+		Help:      "The total number of deleted keys that were attached to missing leases.",
+	})
+	rangeCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "range_cache_hits_total",
+		Help:      "The total number of range requests served from the range cache.",
+	})
+	rangeCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "range_cache_misses_total",
+		Help:      "The total number of cacheable range requests not found in the range cache.",
+	})
+	quotaBackendGrowthRate = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "quota_backend_growth_bytes_per_second",
+		Help:      "The rate at which the backend size grew over the last ten minutes.",
+	})
+	quotaBackendProjectedFull = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "etcd_debugging",
+		Subsystem: "server",
+		Name:      "quota_backend_projected_full_seconds",
+		Help:      "The projected time until the backend quota is reached at the current growth rate; +Inf if not growing.",
+	})
 	currentVersion = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Namespace: "etcd",
 		Subsystem: "server",
@@ -104,6 +134,11 @@ func init() {
 	prometheus.MustRegister(proposalsPending)
 	prometheus.MustRegister(proposalsFailed)
 	prometheus.MustRegister(leaseExpired)
+	prometheus.MustRegister(orphanLeaseKeysDeleted)
+	prometheus.MustRegister(rangeCacheHits)
+	prometheus.MustRegister(rangeCacheMisses)
+	prometheus.MustRegister(quotaBackendGrowthRate)
+	prometheus.MustRegister(quotaBackendProjectedFull)
 	prometheus.MustRegister(currentVersion)
 
 	currentVersion.With(prometheus.Labels{
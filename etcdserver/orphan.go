@@ -0,0 +1,132 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+
+	"go.uber.org/zap"
+)
+
+// orphanScanBatch is the number of keys read at a time while scanning
+// the keyspace for orphaned keys.
+const orphanScanBatch = 1000
+
+// monitorOrphanLeaseKeys periodically deletes keys attached to leases that
+// no longer exist, e.g. after a crash in the middle of a revoke, so that
+// the keyspace stays consistent with the lease state.
+func (s *EtcdServer) monitorOrphanLeaseKeys() {
+	t := s.Cfg.OrphanLeaseKeyCheckTime
+	if t == 0 {
+		return
+	}
+
+	lg := s.getLogger()
+	if lg != nil {
+		lg.Info(
+			"enabled orphan lease key cleanup",
+			zap.String("local-member-id", s.ID().String()),
+			zap.Duration("interval", t),
+		)
+	} else {
+		plog.Infof("enabled orphan lease key cleanup with %s interval", t)
+	}
+
+	for {
+		select {
+		case <-s.stopping:
+			return
+		case <-time.After(t):
+		}
+		if !s.isLeader() {
+			continue
+		}
+		n, err := s.deleteOrphanLeaseKeys()
+		if err != nil {
+			if lg != nil {
+				lg.Warn("failed to delete orphan lease keys", zap.Int("deleted", n), zap.Error(err))
+			} else {
+				plog.Warningf("failed to delete orphan lease keys after deleting %d (%v)", n, err)
+			}
+		}
+	}
+}
+
+// deleteOrphanLeaseKeys scans the keyspace for keys whose lease does not
+// exist and deletes them through raft, so that watchers observe the
+// deletions. It returns the number of deleted keys.
+func (s *EtcdServer) deleteOrphanLeaseKeys() (int, error) {
+	lg := s.getLogger()
+
+	deleted := 0
+	key, end := []byte{0}, mkGteRange([]byte{0})
+	for {
+		rr, err := s.KV().Range(key, end, mvcc.RangeOptions{Limit: orphanScanBatch})
+		if err != nil {
+			return deleted, err
+		}
+		for _, kv := range rr.KVs {
+			if kv.Lease == 0 || s.lessor.Lookup(lease.LeaseID(kv.Lease)) != nil {
+				continue
+			}
+			// only delete the key if it was not written since the scan
+			// read it; a put attaching it to a lease granted meanwhile,
+			// even one reusing the missing lease ID, changes its mod
+			// revision
+			txn := &pb.TxnRequest{
+				Compare: []*pb.Compare{{
+					Key:         kv.Key,
+					Target:      pb.Compare_MOD,
+					Result:      pb.Compare_EQUAL,
+					TargetUnion: &pb.Compare_ModRevision{ModRevision: kv.ModRevision},
+				}},
+				Success: []*pb.RequestOp{{
+					Request: &pb.RequestOp_RequestDeleteRange{RequestDeleteRange: &pb.DeleteRangeRequest{Key: kv.Key}},
+				}},
+			}
+			ctx, cancel := context.WithTimeout(s.authStore.WithRoot(s.ctx), s.Cfg.ReqTimeout())
+			resp, err := s.Txn(ctx, txn)
+			cancel()
+			if err != nil {
+				return deleted, err
+			}
+			if !resp.Succeeded {
+				continue
+			}
+			deleted++
+			orphanLeaseKeysDeleted.Inc()
+			if lg != nil {
+				lg.Warn(
+					"deleted key attached to missing lease",
+					zap.String("key", string(kv.Key)),
+					zap.String("lease-id", fmt.Sprintf("%016x", kv.Lease)),
+				)
+			} else {
+				plog.Warningf("deleted key %q attached to missing lease %016x", kv.Key, kv.Lease)
+			}
+		}
+		if len(rr.KVs) < orphanScanBatch {
+			return deleted, nil
+		}
+		last := rr.KVs[len(rr.KVs)-1].Key
+		key = append(append(make([]byte, 0, len(last)+1), last...), 0)
+	}
+}
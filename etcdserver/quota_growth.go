@@ -0,0 +1,137 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"math"
+	"time"
+
+	humanize "github.com/dustin/go-humanize"
+	"go.uber.org/zap"
+)
+
+// quotaGrowthSamples is the number of samples the growth rate is
+// computed over.
+const quotaGrowthSamples = 11
+
+// quotaGrowthSampleInterval is the interval between samples of the
+// backend size.
+var quotaGrowthSampleInterval = time.Minute
+
+type sizeSample struct {
+	t    time.Time
+	size int64
+}
+
+// growthWindow holds the latest samples of a size, to compute its
+// growth rate over a sliding window.
+type growthWindow struct {
+	samples []sizeSample
+}
+
+func (w *growthWindow) add(t time.Time, size int64) {
+	if len(w.samples) == quotaGrowthSamples {
+		w.samples = append(w.samples[:0], w.samples[1:]...)
+	}
+	w.samples = append(w.samples, sizeSample{t, size})
+}
+
+// rate returns the growth in bytes per second between the oldest and
+// the newest sample, or 0 with fewer than two samples.
+func (w *growthWindow) rate() float64 {
+	if len(w.samples) < 2 {
+		return 0
+	}
+	first, last := w.samples[0], w.samples[len(w.samples)-1]
+	d := last.t.Sub(first.t).Seconds()
+	if d <= 0 {
+		return 0
+	}
+	return float64(last.size-first.size) / d
+}
+
+// timeToQuota returns how long the size takes to reach quota at rate,
+// or a negative duration if it does not grow.
+func timeToQuota(size, quota int64, rate float64) time.Duration {
+	if rate <= 0 {
+		return -1
+	}
+	if size >= quota {
+		return 0
+	}
+	secs := float64(quota-size) / rate
+	if secs > float64(math.MaxInt64/int64(time.Second)) {
+		return -1
+	}
+	return time.Duration(secs * float64(time.Second))
+}
+
+// monitorBackendGrowth samples the backend size to export its growth rate
+// and the projected time until the quota is reached, and warns when that
+// time falls under QuotaAlertTime, so that operators can compact or
+// defragment before the NOSPACE alarm stops writes. Like the quota check,
+// it uses the total size, since the space freed by compaction is reused
+// but only given back by defragmentation.
+func (s *EtcdServer) monitorBackendGrowth() {
+	quota := s.Cfg.QuotaBackendBytes
+	if quota == 0 {
+		quota = DefaultQuotaBytes
+	}
+
+	var w growthWindow
+	for {
+		w.add(time.Now(), s.Backend().Size())
+		rate := w.rate()
+		quotaBackendGrowthRate.Set(rate)
+
+		if quota > 0 {
+			size := w.samples[len(w.samples)-1].size
+			left := timeToQuota(size, quota, rate)
+			if left < 0 {
+				quotaBackendProjectedFull.Set(math.Inf(1))
+			} else {
+				quotaBackendProjectedFull.Set(left.Seconds())
+			}
+			if left >= 0 && left < s.Cfg.QuotaAlertTime {
+				s.alertQuota(size, quota, rate, left)
+			}
+		}
+
+		select {
+		case <-s.stopping:
+			return
+		case <-time.After(quotaGrowthSampleInterval):
+		}
+	}
+}
+
+func (s *EtcdServer) alertQuota(size, quota int64, rate float64, left time.Duration) {
+	if lg := s.getLogger(); lg != nil {
+		lg.Warn(
+			"backend is projected to reach its quota soon",
+			zap.Int64("backend-size-bytes", size),
+			zap.String("backend-size", humanize.Bytes(uint64(size))),
+			zap.Int64("quota-size-bytes", quota),
+			zap.String("quota-size", humanize.Bytes(uint64(quota))),
+			zap.Float64("growth-bytes-per-second", rate),
+			zap.Duration("projected-time-to-quota", left),
+		)
+	} else {
+		plog.Warningf("backend size %s is projected to reach its quota %s in %v", humanize.Bytes(uint64(size)), humanize.Bytes(uint64(quota)), left)
+	}
+	if s.Cfg.QuotaAlert != nil {
+		s.Cfg.QuotaAlert(size, quota, left)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/mvcc/backend"
+
+	"go.uber.org/zap"
+)
+
+func TestGrowthWindowRate(t *testing.T) {
+	var w growthWindow
+	if r := w.rate(); r != 0 {
+		t.Fatalf("rate of empty window = %v, want 0", r)
+	}
+
+	start := time.Unix(0, 0)
+	w.add(start, 1000)
+	if r := w.rate(); r != 0 {
+		t.Fatalf("rate of one sample = %v, want 0", r)
+	}
+	for i := 1; i < quotaGrowthSamples; i++ {
+		w.add(start.Add(time.Duration(i)*time.Minute), 1000+int64(i)*60)
+	}
+	if r := w.rate(); r != 1 {
+		t.Fatalf("rate = %v, want 1", r)
+	}
+
+	// the oldest sample slides out of the window
+	w.add(start.Add(quotaGrowthSamples*time.Minute), 1000+quotaGrowthSamples*60+600)
+	if len(w.samples) != quotaGrowthSamples {
+		t.Fatalf("window holds %d samples, want %d", len(w.samples), quotaGrowthSamples)
+	}
+	if r := w.rate(); r != 2 {
+		t.Fatalf("rate = %v, want 2", r)
+	}
+}
+
+func TestTimeToQuota(t *testing.T) {
+	tests := []struct {
+		size, quota int64
+		rate        float64
+		want        time.Duration
+	}{
+		{100, 1000, 0, -1},
+		{100, 1000, -5, -1},
+		{100, 1000, 1, 900 * time.Second},
+		{100, 1000, 0.5, 1800 * time.Second},
+		{1000, 1000, 1, 0},
+		{2000, 1000, 1, 0},
+		{0, 1 << 62, 1e-9, -1},
+	}
+	for i, tt := range tests {
+		if got := timeToQuota(tt.size, tt.quota, tt.rate); got != tt.want {
+			t.Errorf("#%d: timeToQuota(%d, %d, %v) = %v, want %v", i, tt.size, tt.quota, tt.rate, got, tt.want)
+		}
+	}
+}
+
+// growingBackend grows by 1000 bytes each time its size is read.
+type growingBackend struct {
+	backend.Backend
+	size int64
+}
+
+func (b *growingBackend) Size() int64 { return atomic.AddInt64(&b.size, 1000) }
+
+func TestMonitorBackendGrowthAlert(t *testing.T) {
+	oldInterval := quotaGrowthSampleInterval
+	quotaGrowthSampleInterval = 10 * time.Millisecond
+	defer func() { quotaGrowthSampleInterval = oldInterval }()
+
+	type alert struct {
+		size, quota int64
+		left        time.Duration
+	}
+	alertc := make(chan alert, 1)
+	s := &EtcdServer{
+		lgMu:     new(sync.RWMutex),
+		lg:       zap.NewExample(),
+		be:       &growingBackend{},
+		stopping: make(chan struct{}),
+		Cfg: ServerConfig{
+			QuotaBackendBytes: 1000000,
+			QuotaAlertTime:    time.Hour,
+			QuotaAlert: func(size, quota int64, left time.Duration) {
+				select {
+				case alertc <- alert{size, quota, left}:
+				default:
+				}
+			},
+		},
+	}
+	donec := make(chan struct{})
+	go func() {
+		s.monitorBackendGrowth()
+		close(donec)
+	}()
+
+	select {
+	case a := <-alertc:
+		// the first alert needs two samples for a growth rate
+		if a.size != 2000 || a.quota != 1000000 {
+			t.Errorf("alert size %d, quota %d, want 2000 and 1000000", a.size, a.quota)
+		}
+		if a.left <= 0 || a.left >= time.Hour {
+			t.Errorf("alert projected %v to quota, want between 0 and 1h", a.left)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no alert while the backend grows toward its quota")
+	}
+
+	close(s.stopping)
+	select {
+	case <-donec:
+	case <-time.After(5 * time.Second):
+		t.Fatal("monitor did not stop")
+	}
+}
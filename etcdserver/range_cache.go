@@ -0,0 +1,258 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+)
+
+const (
+	// maxRangeCacheEntries bounds the number of cached range responses.
+	maxRangeCacheEntries = 1024
+	// maxRangeCacheBytes bounds the total size of the cached range
+	// responses. Larger responses are not cached.
+	maxRangeCacheBytes = 64 * 1024 * 1024
+)
+
+// rangeCache caches the responses of identical range requests for a short
+// time, to absorb bursts of the same read, e.g. clients relisting after
+// their watches reconnect. Applied writes invalidate the entries whose key
+// ranges they touch, so a response keeps being served at later revisions
+// while its keys are unchanged.
+type rangeCache struct {
+	ttl      time.Duration
+	maxBytes int
+
+	mu sync.Mutex
+	// rev is the latest revision whose writes invalidated the cache.
+	// Entries are only served at revisions up to rev, since the writes
+	// after it may not have invalidated them yet.
+	rev     int64
+	size    int
+	entries map[string]*rangeCacheEntry
+}
+
+type rangeCacheEntry struct {
+	key, end []byte
+	rev      int64
+	size     int
+	expires  time.Time
+	resp     *pb.RangeResponse
+}
+
+func newRangeCache(ttl time.Duration) *rangeCache {
+	return &rangeCache{ttl: ttl, maxBytes: maxRangeCacheBytes, entries: make(map[string]*rangeCacheEntry)}
+}
+
+// cacheable reports whether the response of r may be cached. Requests for
+// a past revision are not, since the revision may be compacted.
+func (rc *rangeCache) cacheable(r *pb.RangeRequest) bool {
+	return rc != nil && r.Revision == 0
+}
+
+// get returns the cached response to r if none of its keys were written
+// between the revision it was read at and rev, the current revision. The
+// returned response has its own header, at rev, which callers may fill.
+func (rc *rangeCache) get(r *pb.RangeRequest, rev int64) *pb.RangeResponse {
+	k, err := r.Marshal()
+	if err != nil {
+		return nil
+	}
+	rc.mu.Lock()
+	e, ok := rc.entries[string(k)]
+	if ok && time.Now().After(e.expires) {
+		rc.remove(string(k), e)
+		ok = false
+	}
+	ok = ok && (e.rev == rev || (e.rev < rev && rev <= rc.rev))
+	rc.mu.Unlock()
+	if !ok {
+		rangeCacheMisses.Inc()
+		return nil
+	}
+	rangeCacheHits.Inc()
+	resp := *e.resp
+	hdr := *e.resp.Header
+	hdr.Revision = rev
+	resp.Header = &hdr
+	return &resp
+}
+
+func (rc *rangeCache) add(r *pb.RangeRequest, resp *pb.RangeResponse) {
+	k, err := r.Marshal()
+	if err != nil {
+		return
+	}
+	now := time.Now()
+	e := &rangeCacheEntry{
+		key:     r.Key,
+		end:     r.RangeEnd,
+		rev:     resp.Header.Revision,
+		size:    len(k) + resp.Size(),
+		expires: now.Add(rc.ttl),
+	}
+	if e.size > rc.maxBytes {
+		return
+	}
+	cp := *resp
+	hdr := *resp.Header
+	cp.Header = &hdr
+	e.resp = &cp
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if e.rev < rc.rev {
+		// read before a write that may have already invalidated its keys
+		return
+	}
+	if old, ok := rc.entries[string(k)]; ok {
+		rc.remove(string(k), old)
+	}
+	if len(rc.entries) >= maxRangeCacheEntries || rc.size+e.size > rc.maxBytes {
+		rc.evict(now, e.size)
+	}
+	rc.entries[string(k)] = e
+	rc.size += e.size
+}
+
+// invalidate drops the entries read before revision rev whose key ranges
+// overlap the keys r may have written, once r is applied at rev.
+func (rc *rangeCache) invalidate(r *pb.InternalRaftRequest, rev int64) {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if rev <= rc.rev {
+		return
+	}
+	rc.rev = rev
+	ranges, ok := writeRanges(r)
+	for ek, ee := range rc.entries {
+		if ee.rev >= rev {
+			continue
+		}
+		if !ok {
+			rc.remove(ek, ee)
+			continue
+		}
+		for _, wr := range ranges {
+			if rangesOverlap(ee.key, ee.end, wr.key, wr.end) {
+				rc.remove(ek, ee)
+				break
+			}
+		}
+	}
+}
+
+// reset drops all entries, e.g. once the store is replaced by a snapshot
+// at revision rev.
+func (rc *rangeCache) reset(rev int64) {
+	if rc == nil {
+		return
+	}
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.rev, rc.size = rev, 0
+	rc.entries = make(map[string]*rangeCacheEntry)
+}
+
+// evict drops the expired entries, then the oldest ones until there is
+// room for an entry of size bytes.
+func (rc *rangeCache) evict(now time.Time, size int) {
+	keys := make([]string, 0, len(rc.entries))
+	for ek, ee := range rc.entries {
+		if now.After(ee.expires) {
+			rc.remove(ek, ee)
+			continue
+		}
+		keys = append(keys, ek)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return rc.entries[keys[i]].expires.Before(rc.entries[keys[j]].expires)
+	})
+	for _, ek := range keys {
+		if len(rc.entries) < maxRangeCacheEntries && rc.size+size <= rc.maxBytes {
+			break
+		}
+		rc.remove(ek, rc.entries[ek])
+	}
+}
+
+func (rc *rangeCache) remove(k string, e *rangeCacheEntry) {
+	delete(rc.entries, k)
+	rc.size -= e.size
+}
+
+type keyRange struct{ key, end []byte }
+
+// writeRanges returns the key ranges r writes to. It returns false if they
+// are not known, e.g. for lease revocations, which delete the keys
+// attached to the lease.
+func writeRanges(r *pb.InternalRaftRequest) ([]keyRange, bool) {
+	switch {
+	case r.Put != nil:
+		return []keyRange{{key: r.Put.Key}}, true
+	case r.DeleteRange != nil:
+		return []keyRange{{key: r.DeleteRange.Key, end: r.DeleteRange.RangeEnd}}, true
+	case r.Txn != nil:
+		return txnWriteRanges(r.Txn, nil), true
+	}
+	return nil, false
+}
+
+// txnWriteRanges appends the key ranges of the puts and deletes in either
+// branch of the txn rt to ranges.
+func txnWriteRanges(rt *pb.TxnRequest, ranges []keyRange) []keyRange {
+	for _, ops := range [][]*pb.RequestOp{rt.Success, rt.Failure} {
+		for _, op := range ops {
+			switch tv := op.Request.(type) {
+			case *pb.RequestOp_RequestPut:
+				ranges = append(ranges, keyRange{key: tv.RequestPut.Key})
+			case *pb.RequestOp_RequestDeleteRange:
+				ranges = append(ranges, keyRange{key: tv.RequestDeleteRange.Key, end: tv.RequestDeleteRange.RangeEnd})
+			case *pb.RequestOp_RequestTxn:
+				ranges = txnWriteRanges(tv.RequestTxn, ranges)
+			}
+		}
+	}
+	return ranges
+}
+
+// rangesOverlap reports whether the key ranges [key1, end1) and
+// [key2, end2) share a key. An empty end is the single key range of key,
+// and an end of "\x00" reaches the end of the keyspace.
+func rangesOverlap(key1, end1, key2, end2 []byte) bool {
+	end1, end2 = rangeEndOf(key1, end1), rangeEndOf(key2, end2)
+	return (end2 == nil || bytes.Compare(key1, end2) < 0) &&
+		(end1 == nil || bytes.Compare(key2, end1) < 0)
+}
+
+// rangeEndOf returns the exclusive end of the range [key, end), or nil if
+// the range reaches the end of the keyspace.
+func rangeEndOf(key, end []byte) []byte {
+	switch {
+	case len(end) == 0:
+		return append(append([]byte{}, key...), 0)
+	case len(end) == 1 && end[0] == 0:
+		return nil
+	}
+	return end
+}
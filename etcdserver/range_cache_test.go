@@ -0,0 +1,166 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package etcdserver
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+func TestRangeCache(t *testing.T) {
+	rc := newRangeCache(time.Hour)
+	r := &pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("b")}
+	resp := &pb.RangeResponse{
+		Header: &pb.ResponseHeader{Revision: 5},
+		Kvs:    []*mvccpb.KeyValue{{Key: []byte("a1"), ModRevision: 3}},
+		Count:  1,
+	}
+	rc.add(r, resp)
+
+	got := rc.get(r, 5)
+	if got == nil || got.Count != 1 || string(got.Kvs[0].Key) != "a1" {
+		t.Fatalf("get = %+v, want cached response", got)
+	}
+	// callers fill the header of the returned response
+	got.Header.MemberId = 1
+	if resp.Header.MemberId != 0 || rc.get(r, 5).Header.MemberId != 0 {
+		t.Error("header of cached response was modified")
+	}
+
+	if rc.get(&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("b"), Limit: 1}, 5) != nil {
+		t.Error("got response cached for a different request")
+	}
+	// writes at revision 6 are not known to the cache yet
+	if rc.get(r, 6) != nil {
+		t.Error("got response cached at an older revision before invalidation")
+	}
+
+	// a write outside of the range keeps the entry, served at the new revision
+	rc.invalidate(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("b")}}, 6)
+	if got = rc.get(r, 6); got == nil || got.Header.Revision != 6 {
+		t.Fatalf("get = %+v, want cached response at revision 6", got)
+	}
+	// a write inside of the range drops it
+	rc.invalidate(&pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("a2")}}, 7)
+	if rc.get(r, 7) != nil {
+		t.Error("got response invalidated by a write")
+	}
+	if len(rc.entries) != 0 || rc.size != 0 {
+		t.Errorf("entries = %d (%d bytes), want none", len(rc.entries), rc.size)
+	}
+
+	// responses read before the last invalidation may miss it
+	rc.add(r, resp)
+	if rc.get(r, 5) != nil {
+		t.Error("got response read before the last invalidation")
+	}
+}
+
+func TestRangeCacheInvalidate(t *testing.T) {
+	tests := []struct {
+		r     *pb.RangeRequest
+		req   *pb.InternalRaftRequest
+		wdrop bool
+	}{
+		{&pb.RangeRequest{Key: []byte("a")}, &pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("a")}}, true},
+		{&pb.RangeRequest{Key: []byte("a")}, &pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("a0")}}, false},
+		{&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte("b")}, &pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("b")}}, false},
+		{&pb.RangeRequest{Key: []byte("a"), RangeEnd: []byte{0}}, &pb.InternalRaftRequest{Put: &pb.PutRequest{Key: []byte("z")}}, true},
+		{&pb.RangeRequest{Key: []byte("c")}, &pb.InternalRaftRequest{DeleteRange: &pb.DeleteRangeRequest{Key: []byte("a"), RangeEnd: []byte("d")}}, true},
+		{&pb.RangeRequest{Key: []byte("d")}, &pb.InternalRaftRequest{DeleteRange: &pb.DeleteRangeRequest{Key: []byte("a"), RangeEnd: []byte("d")}}, false},
+		{&pb.RangeRequest{Key: []byte("z")}, &pb.InternalRaftRequest{DeleteRange: &pb.DeleteRangeRequest{Key: []byte("a"), RangeEnd: []byte{0}}}, true},
+		{
+			&pb.RangeRequest{Key: []byte("c")},
+			&pb.InternalRaftRequest{Txn: &pb.TxnRequest{
+				Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("a")}}}},
+				Failure: []*pb.RequestOp{{Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{
+					Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte("c")}}}},
+				}}}},
+			}},
+			true,
+		},
+		{
+			&pb.RangeRequest{Key: []byte("c")},
+			&pb.InternalRaftRequest{Txn: &pb.TxnRequest{
+				Success: []*pb.RequestOp{{Request: &pb.RequestOp_RequestRange{RequestRange: &pb.RangeRequest{Key: []byte("c")}}}},
+			}},
+			false,
+		},
+		// lease revocations delete unknown keys
+		{&pb.RangeRequest{Key: []byte("a")}, &pb.InternalRaftRequest{LeaseRevoke: &pb.LeaseRevokeRequest{ID: 1}}, true},
+	}
+	for i, tt := range tests {
+		rc := newRangeCache(time.Hour)
+		rc.add(tt.r, &pb.RangeResponse{Header: &pb.ResponseHeader{Revision: 1}})
+		rc.invalidate(tt.req, 2)
+		if dropped := rc.get(tt.r, 2) == nil; dropped != tt.wdrop {
+			t.Errorf("#%d: dropped = %v, want %v", i, dropped, tt.wdrop)
+		}
+	}
+}
+
+func TestRangeCacheSizeCap(t *testing.T) {
+	rc := newRangeCache(time.Hour)
+	resp := &pb.RangeResponse{
+		Header: &pb.ResponseHeader{Revision: 1},
+		Kvs:    []*mvccpb.KeyValue{{Key: []byte("a"), Value: make([]byte, 100)}},
+	}
+	ra, rb := &pb.RangeRequest{Key: []byte("a")}, &pb.RangeRequest{Key: []byte("b")}
+	rc.add(ra, resp)
+	rc.maxBytes = rc.size + rc.size/2
+
+	// the oldest entry is evicted to make room
+	rc.add(rb, resp)
+	if rc.get(ra, 1) != nil || rc.get(rb, 1) == nil {
+		t.Error("expected only the last entry to be cached")
+	}
+	if rc.size > rc.maxBytes {
+		t.Errorf("size = %d, want at most %d", rc.size, rc.maxBytes)
+	}
+
+	// responses over the cap are not cached
+	rc.maxBytes = 10
+	rc.add(ra, resp)
+	if rc.get(ra, 1) != nil {
+		t.Error("expected response over the cap not to be cached")
+	}
+}
+
+func TestRangeCacheExpire(t *testing.T) {
+	rc := newRangeCache(time.Nanosecond)
+	r := &pb.RangeRequest{Key: []byte("a")}
+	rc.add(r, &pb.RangeResponse{Header: &pb.ResponseHeader{Revision: 1}})
+	time.Sleep(time.Millisecond)
+	if rc.get(r, 1) != nil {
+		t.Error("got expired response")
+	}
+}
+
+func TestRangeCacheCacheable(t *testing.T) {
+	var rc *rangeCache
+	if rc.cacheable(&pb.RangeRequest{Key: []byte("a")}) {
+		t.Error("nil cache should not cache")
+	}
+	rc = newRangeCache(time.Second)
+	if !rc.cacheable(&pb.RangeRequest{Key: []byte("a")}) {
+		t.Error("expected latest revision range to be cacheable")
+	}
+	if rc.cacheable(&pb.RangeRequest{Key: []byte("a"), Revision: 3}) {
+		t.Error("expected past revision range not to be cacheable")
+	}
+}
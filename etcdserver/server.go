@@ -134,6 +134,8 @@ type ServerV2 interface {
 	Do(ctx context.Context, r pb.Request) (Response, error)
 	stats.Stats
 	ClientCertAuthEnabled() bool
+	// ReportedVersion is the server version served to HTTP clients.
+	ReportedVersion() string
 }
 
 type ServerV3 interface {
@@ -143,6 +145,13 @@ type ServerV3 interface {
 
 func (s *EtcdServer) ClientCertAuthEnabled() bool { return s.Cfg.ClientCertAuthEnabled }
 
+func (s *EtcdServer) ReportedVersion() string {
+	if s.Cfg.ReportedVersion != "" {
+		return s.Cfg.ReportedVersion
+	}
+	return version.Version
+}
+
 type Server interface {
 	// AddMember attempts to add a member into the cluster. It will return
 	// ErrIDRemoved if member ID is removed from the cluster, or return
@@ -183,6 +192,9 @@ type EtcdServer struct {
 	committedIndex    uint64 // must use atomic operations to access; keep 64-bit aligned.
 	term              uint64 // must use atomic operations to access; keep 64-bit aligned.
 	lead              uint64 // must use atomic operations to access; keep 64-bit aligned.
+	// maintenanceSince is when the MAINTENANCE alarm was applied in unix
+	// nanoseconds, or 0 if it is not active.
+	maintenanceSince int64 // must use atomic operations to access; keep 64-bit aligned.
 
 	// consistIndex used to hold the offset of current executing entry
 	// It is initialized to 0 before executing any entry.
@@ -205,12 +217,19 @@ type EtcdServer struct {
 	// when there is no error
 	readNotifier *notifier
 
+	// sched applies the committed entries handed over by raft in order.
+	sched schedule.Scheduler
+	// inflightTxns is the number of Txn requests being served.
+	inflightTxns int64
+
 	// stop signals the run goroutine should shutdown.
 	stop chan struct{}
 	// stopping is closed by run goroutine on shutdown.
 	stopping chan struct{}
 	// done is closed when all goroutines from start() complete.
 	done chan struct{}
+	// started is non-zero once Start has been called.
+	started int32
 
 	errorc     chan error
 	id         types.ID
@@ -235,13 +254,19 @@ type EtcdServer struct {
 	be         backend.Backend
 	authStore  auth.AuthStore
 	alarmStore *v3alarm.AlarmStore
+	// rangeCache is nil if range responses are not cached.
+	rangeCache *rangeCache
 
 	stats  *stats.ServerStats
 	lstats *stats.LeaderStats
 
 	SyncTicker *time.Ticker
-	// compactor is used to auto-compact the KV.
-	compactor v3compactor.Compactor
+	// compactorMu protects compactor and the configuration it was created
+	// with, which the CompactionConfig RPC may change at runtime.
+	compactorMu         sync.Mutex
+	compactor           v3compactor.Compactor
+	compactionMode      string
+	compactionRetention time.Duration
 
 	// peerRt used to send requests (version, lease) to peers.
 	peerRt   http.RoundTripper
@@ -300,6 +325,16 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 	}
 
 	haveWAL := wal.Exist(cfg.WALDir())
+	if haveWAL {
+		// fail fast instead of waiting on the backend file lock
+		inUse, ierr := wal.InUse(cfg.Logger, cfg.WALDir())
+		if ierr != nil {
+			return nil, fmt.Errorf("cannot check whether wal directory %q is in use: %v", cfg.WALDir(), ierr)
+		}
+		if inUse {
+			return nil, fmt.Errorf("wal directory %q is in use by another etcd process", cfg.WALDir())
+		}
+	}
 
 	if err = fileutil.TouchDirAll(cfg.SnapDir()); err != nil {
 		if cfg.Logger != nil {
@@ -523,8 +558,24 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 
 	// always recover lessor before kv. When we recover the mvcc.KV it will reattach keys to its leases.
 	// If we recover mvcc.KV first, it will attach the keys to the wrong lessor before it recovers.
-	srv.lessor = lease.NewLessor(srv.be, int64(math.Ceil(minTTL.Seconds())))
+	srv.lessor = lease.NewLessor(srv.be, lease.LessorConfig{MinLeaseTTL: int64(math.Ceil(minTTL.Seconds())), CheckpointInterval: cfg.LeaseCheckpointInterval})
+	if cfg.EnableLeaseCheckpoint {
+		// setting checkpointer enables lease checkpoint feature.
+		srv.lessor.SetCheckpointer(func(ctx context.Context, cp *pb.LeaseCheckpointRequest) {
+			ctx, cancel := context.WithTimeout(ctx, cfg.ReqTimeout())
+			defer cancel()
+			srv.raftRequestOnce(ctx, pb.InternalRaftRequest{LeaseCheckpoint: cp})
+		})
+	}
 	srv.kv = mvcc.New(srv.getLogger(), srv.be, srv.lessor, &srv.consistIndex)
+	if kv, ok := srv.kv.(interface{ SetCompactionPauseLatency(time.Duration) }); ok {
+		kv.SetCompactionPauseLatency(cfg.CompactionPauseLatency)
+	}
+	srv.kv.SetEventHistorySize(cfg.WatchEventHistorySize)
+	srv.kv.SetRevisionTimes(cfg.RevisionTimes)
+	if cfg.RangeCacheTTL > 0 {
+		srv.rangeCache = newRangeCache(cfg.RangeCacheTTL)
+	}
 	if beExist {
 		kvindex := srv.kv.ConsistentIndex()
 		// TODO: remove kvindex != 0 checking when we do not expect users to upgrade
@@ -566,13 +617,13 @@ func NewServer(cfg ServerConfig) (srv *EtcdServer, err error) {
 		}
 		return nil, err
 	}
-	srv.authStore = auth.NewAuthStore(srv.getLogger(), srv.be, tp, int(cfg.BcryptCost))
-	if num := cfg.AutoCompactionRetention; num != 0 {
-		srv.compactor, err = v3compactor.New(cfg.Logger, cfg.AutoCompactionMode, num, srv.kv, srv)
-		if err != nil {
-			return nil, err
-		}
-		srv.compactor.Run()
+	as := auth.NewAuthStore(srv.getLogger(), srv.be, tp, int(cfg.BcryptCost))
+	if cfg.ClientCertAuthSANUsername {
+		as.EnableCertSANUsername()
+	}
+	srv.authStore = as
+	if err = srv.restoreCompactionConfig(); err != nil {
+		return nil, err
 	}
 
 	srv.applyV3Base = srv.newApplierV3Backend()
@@ -693,8 +744,16 @@ func (s *EtcdServer) adjustTicks() {
 // Start performs any initialization of the Server necessary for it to
 // begin serving requests. It must be called before Do or Process.
 // Start must be non-blocking; any long-running server functionality
-// should be implemented in goroutines.
+// should be implemented in goroutines. Calls after the first are ignored.
 func (s *EtcdServer) Start() {
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		if lg := s.getLogger(); lg != nil {
+			lg.Warn("ignored duplicate server start", zap.String("local-member-id", s.ID().String()))
+		} else {
+			plog.Warningf("ignored duplicate start of %s", s.ID())
+		}
+		return
+	}
 	s.start()
 	s.goAttach(func() { s.adjustTicks() })
 	s.goAttach(func() { s.publish(s.Cfg.ReqTimeout()) })
@@ -703,6 +762,9 @@ func (s *EtcdServer) Start() {
 	s.goAttach(s.monitorVersions)
 	s.goAttach(s.linearizableReadLoop)
 	s.goAttach(s.monitorKVHash)
+	s.goAttach(s.monitorOrphanLeaseKeys)
+	s.goAttach(s.monitorBackendGrowth)
+	s.goAttach(s.monitorMaintenanceMode)
 }
 
 // start prepares and starts server in a new goroutine. It is no longer safe to
@@ -742,6 +804,7 @@ func (s *EtcdServer) start() {
 	s.ctx, s.cancel = context.WithCancel(context.Background())
 	s.readwaitc = make(chan struct{}, 1)
 	s.readNotifier = newNotifier()
+	s.sched = schedule.NewFIFOScheduler()
 	if s.ClusterVersion() != nil {
 		if lg != nil {
 			lg.Info(
@@ -887,7 +950,7 @@ func (s *EtcdServer) run() {
 	}
 
 	// asynchronously accept apply packets, dispatch progress in-order
-	sched := schedule.NewFIFOScheduler()
+	sched := s.sched
 
 	var (
 		smu   sync.RWMutex
@@ -912,9 +975,11 @@ func (s *EtcdServer) run() {
 				if s.lessor != nil {
 					s.lessor.Demote()
 				}
+				s.compactorMu.Lock()
 				if s.compactor != nil {
 					s.compactor.Pause()
 				}
+				s.compactorMu.Unlock()
 				setSyncC(nil)
 			} else {
 				if newLeader {
@@ -924,9 +989,11 @@ func (s *EtcdServer) run() {
 					s.leadTimeMu.Unlock()
 				}
 				setSyncC(s.SyncTicker.C)
+				s.compactorMu.Lock()
 				if s.compactor != nil {
 					s.compactor.Resume()
 				}
+				s.compactorMu.Unlock()
 			}
 
 			// TODO: remove the nil checking
@@ -982,9 +1049,11 @@ func (s *EtcdServer) run() {
 		if s.be != nil {
 			s.be.Close()
 		}
+		s.compactorMu.Lock()
 		if s.compactor != nil {
 			s.compactor.Stop()
 		}
+		s.compactorMu.Unlock()
 		close(s.done)
 	}()
 
@@ -1162,6 +1231,7 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 	}
 
 	s.consistIndex.setConsistentIndex(s.kv.ConsistentIndex())
+	s.rangeCache.reset(s.kv.Rev())
 	if lg != nil {
 		lg.Info("restored mvcc store")
 	} else {
@@ -1218,6 +1288,17 @@ func (s *EtcdServer) applySnapshot(ep *etcdProgress, apply *apply) {
 		plog.Info("finished recovering alarms")
 	}
 
+	// the snapshot carries the auto-compaction configuration set at runtime
+	if cc := readCompactionConfig(newbe); cc != nil {
+		if err := s.setCompactor(cc.Mode, time.Duration(cc.Retention)); err != nil {
+			if lg != nil {
+				lg.Panic("failed to restore auto-compaction configuration", zap.Error(err))
+			} else {
+				plog.Panicf("restore auto-compaction configuration error: %v", err)
+			}
+		}
+	}
+
 	if s.authStore != nil {
 		if lg != nil {
 			lg.Info("restoring auth store")
@@ -1485,6 +1566,10 @@ func (s *EtcdServer) stopWithDelay(d time.Duration, err error) {
 // when the server is stopped.
 func (s *EtcdServer) StopNotify() <-chan struct{} { return s.done }
 
+// StoppingNotify returns a channel that receives a empty struct
+// when the server is being stopped.
+func (s *EtcdServer) StoppingNotify() <-chan struct{} { return s.stopping }
+
 func (s *EtcdServer) SelfStats() []byte { return s.stats.JSON() }
 
 func (s *EtcdServer) LeaderStats() []byte {
@@ -1982,6 +2067,7 @@ func (s *EtcdServer) applyEntryNormal(e *raftpb.Entry) {
 			removeNeedlessRangeReqs(raftReq.Txn)
 		}
 		ar = s.applyV3.Apply(&raftReq)
+		s.rangeCache.invalidate(&raftReq, s.kv.Rev())
 	}
 
 	if ar == nil {
@@ -2214,6 +2300,13 @@ func (s *EtcdServer) ClusterVersion() *semver.Version {
 	return s.cluster.Version()
 }
 
+// clusterVersionAtLeast returns true if the cluster version is at least v,
+// so that every member applies the raft requests introduced in v.
+func (s *EtcdServer) clusterVersionAtLeast(v semver.Version) bool {
+	cv := s.ClusterVersion()
+	return cv != nil && !cv.LessThan(v)
+}
+
 // monitorVersions checks the member's version every monitorVersionInterval.
 // It updates the cluster version if all members agrees on a higher one.
 // It prints out log if there is a member with a higher version than the
@@ -2367,11 +2460,12 @@ func (s *EtcdServer) restoreAlarms() error {
 		return err
 	}
 	s.alarmStore = as
-	if len(as.Get(pb.AlarmType_NOSPACE)) > 0 {
-		s.applyV3 = newApplierV3Capped(s.applyV3)
-	}
-	if len(as.Get(pb.AlarmType_CORRUPT)) > 0 {
-		s.applyV3 = newApplierV3Corrupt(s.applyV3)
+	s.applyV3 = s.newAlarmApplierV3()
+	if len(as.Get(pb.AlarmType_MAINTENANCE)) > 0 {
+		// the timeout restarts, since the activation time is not persisted
+		atomic.StoreInt64(&s.maintenanceSince, time.Now().UnixNano())
+	} else {
+		atomic.StoreInt64(&s.maintenanceSince, 0)
 	}
 	return nil
 }
@@ -2400,6 +2494,10 @@ func (s *EtcdServer) goAttach(f func()) {
 	}()
 }
 
+// GoAttach runs f in a goroutine that the server waits for when it stops.
+// f must return once StoppingNotify is closed.
+func (s *EtcdServer) GoAttach(f func()) { s.goAttach(f) }
+
 func (s *EtcdServer) Alarms() []*pb.AlarmMember {
 	return s.alarmStore.Get(pb.AlarmType_NONE)
 }
@@ -28,6 +28,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coreos/go-semver/semver"
 	"go.uber.org/zap"
 
 	"github.com/coreos/etcd/etcdserver/api/membership"
@@ -500,6 +501,38 @@ func TestApplyRequestOnAdminMemberAttributes(t *testing.T) {
 	}
 }
 
+// TestAlarmMaintenanceNotCapable ensures the MAINTENANCE alarm is not
+// proposed until every member runs a version that applies it.
+func TestAlarmMaintenanceNotCapable(t *testing.T) {
+	cl := membership.NewCluster(zap.NewExample(), "")
+	cl.SetVersion(semver.Must(semver.NewVersion("3.3.0")), func(*zap.Logger, *semver.Version) {})
+	srv := &EtcdServer{
+		lgMu:    new(sync.RWMutex),
+		lg:      zap.NewExample(),
+		cluster: cl,
+	}
+	ar := &pb.AlarmRequest{Action: pb.AlarmRequest_ACTIVATE, Alarm: pb.AlarmType_MAINTENANCE}
+	if _, err := srv.Alarm(context.TODO(), ar); err != ErrNotCapable {
+		t.Fatalf("err = %v, want %v", err, ErrNotCapable)
+	}
+}
+
+// TestCompactionConfigNotCapable ensures compaction config changes are not
+// proposed until every member runs a version that applies them.
+func TestCompactionConfigNotCapable(t *testing.T) {
+	cl := membership.NewCluster(zap.NewExample(), "")
+	cl.SetVersion(semver.Must(semver.NewVersion("3.3.0")), func(*zap.Logger, *semver.Version) {})
+	srv := &EtcdServer{
+		lgMu:    new(sync.RWMutex),
+		lg:      zap.NewExample(),
+		cluster: cl,
+	}
+	cc := &pb.CompactionConfigRequest{Mode: "periodic", Retention: int64(time.Hour)}
+	if _, err := srv.CompactionConfig(context.TODO(), cc); err != ErrNotCapable {
+		t.Fatalf("err = %v, want %v", err, ErrNotCapable)
+	}
+}
+
 func TestApplyConfChangeError(t *testing.T) {
 	cl := membership.NewCluster(zap.NewExample(), "")
 	cl.SetStore(v2store.New())
@@ -1729,6 +1762,42 @@ func newTestCluster(membs []*membership.Member) *membership.RaftCluster {
 	return c
 }
 
+// TestKeyValidator ensures puts, including those nested in txns, to keys
+// the KeyValidator rejects fail before they are proposed.
+func TestKeyValidator(t *testing.T) {
+	srv := &EtcdServer{
+		lgMu: new(sync.RWMutex),
+		lg:   zap.NewExample(),
+		Cfg: ServerConfig{Logger: zap.NewExample(), KeyValidator: func(key []byte) error {
+			if string(key) != "ok" {
+				return fmt.Errorf("not ok")
+			}
+			return nil
+		}},
+	}
+
+	if _, err := srv.Put(context.TODO(), &pb.PutRequest{Key: []byte("bad")}); err == nil {
+		t.Fatal("expected put to be rejected")
+	} else if _, ok := err.(KeyRejectedError); !ok {
+		t.Fatalf("err = %v, want KeyRejectedError", err)
+	}
+
+	put := func(k string) *pb.RequestOp {
+		return &pb.RequestOp{Request: &pb.RequestOp_RequestPut{RequestPut: &pb.PutRequest{Key: []byte(k)}}}
+	}
+	nested := &pb.RequestOp{Request: &pb.RequestOp_RequestTxn{RequestTxn: &pb.TxnRequest{Failure: []*pb.RequestOp{put("bad")}}}}
+	txn := &pb.TxnRequest{Success: []*pb.RequestOp{put("ok"), nested}}
+	if _, err := srv.Txn(context.TODO(), txn); err == nil {
+		t.Fatal("expected txn with nested put to be rejected")
+	} else if _, ok := err.(KeyRejectedError); !ok {
+		t.Fatalf("err = %v, want KeyRejectedError", err)
+	}
+
+	if err := srv.validateTxnKeys([]*pb.RequestOp{put("ok")}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+}
+
 type nopTransporter struct{}
 
 func newNopTransporter() rafthttp.Transporter {
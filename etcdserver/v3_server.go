@@ -19,10 +19,12 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/coreos/etcd/auth"
 	"github.com/coreos/etcd/etcdserver/api/membership"
+	"github.com/coreos/etcd/etcdserver/api/v3compactor"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/lease"
 	"github.com/coreos/etcd/lease/leasehttp"
@@ -100,7 +102,18 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 		return s.authStore.IsRangePermitted(ai, r.Key, r.RangeEnd)
 	}
 
-	get := func() { resp, err = s.applyV3Base.Range(nil, r) }
+	get := func() {
+		if !s.rangeCache.cacheable(r) {
+			resp, err = s.applyV3Base.Range(nil, r)
+			return
+		}
+		if resp = s.rangeCache.get(r, s.KV().Rev()); resp != nil {
+			return
+		}
+		if resp, err = s.applyV3Base.Range(nil, r); err == nil {
+			s.rangeCache.add(r, resp)
+		}
+	}
 	if serr := s.doSerialize(ctx, chk, get); serr != nil {
 		return nil, serr
 	}
@@ -108,6 +121,9 @@ func (s *EtcdServer) Range(ctx context.Context, r *pb.RangeRequest) (*pb.RangeRe
 }
 
 func (s *EtcdServer) Put(ctx context.Context, r *pb.PutRequest) (*pb.PutResponse, error) {
+	if err := s.validateKey(r.Key); err != nil {
+		return nil, err
+	}
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Put: r})
 	if err != nil {
 		return nil, err
@@ -124,6 +140,9 @@ func (s *EtcdServer) DeleteRange(ctx context.Context, r *pb.DeleteRangeRequest)
 }
 
 func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse, error) {
+	atomic.AddInt64(&s.inflightTxns, 1)
+	defer atomic.AddInt64(&s.inflightTxns, -1)
+
 	if isTxnReadonly(r) {
 		if !isTxnSerializable(r) {
 			err := s.linearizableReadNotify(ctx)
@@ -146,6 +165,13 @@ func (s *EtcdServer) Txn(ctx context.Context, r *pb.TxnRequest) (*pb.TxnResponse
 		return resp, err
 	}
 
+	if err := s.validateTxnKeys(r.Success); err != nil {
+		return nil, err
+	}
+	if err := s.validateTxnKeys(r.Failure); err != nil {
+		return nil, err
+	}
+
 	resp, err := s.raftRequest(ctx, pb.InternalRaftRequest{Txn: r})
 	if err != nil {
 		return nil, err
@@ -181,6 +207,48 @@ func isTxnReadonly(r *pb.TxnRequest) bool {
 	return true
 }
 
+// validateKey returns a KeyRejectedError if the configured KeyValidator
+// rejects a put to key.
+func (s *EtcdServer) validateKey(key []byte) error {
+	if s.Cfg.KeyValidator == nil {
+		return nil
+	}
+	if err := s.Cfg.KeyValidator(key); err != nil {
+		return KeyRejectedError{Key: key, Err: err}
+	}
+	return nil
+}
+
+// validateTxnKeys validates the keys of the puts in reqs, including those
+// of nested txns.
+func (s *EtcdServer) validateTxnKeys(reqs []*pb.RequestOp) error {
+	if s.Cfg.KeyValidator == nil {
+		return nil
+	}
+	for _, req := range reqs {
+		switch tv := req.Request.(type) {
+		case *pb.RequestOp_RequestPut:
+			if tv.RequestPut == nil {
+				continue
+			}
+			if err := s.validateKey(tv.RequestPut.Key); err != nil {
+				return err
+			}
+		case *pb.RequestOp_RequestTxn:
+			if tv.RequestTxn == nil {
+				continue
+			}
+			if err := s.validateTxnKeys(tv.RequestTxn.Success); err != nil {
+				return err
+			}
+			if err := s.validateTxnKeys(tv.RequestTxn.Failure); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (s *EtcdServer) Compact(ctx context.Context, r *pb.CompactionRequest) (*pb.CompactionResponse, error) {
 	result, err := s.processInternalRaftRequestOnce(ctx, pb.InternalRaftRequest{Compaction: r})
 	if r.Physical && result != nil && result.physc != nil {
@@ -332,6 +400,9 @@ func (s *EtcdServer) waitLeader(ctx context.Context) (*membership.Member, error)
 }
 
 func (s *EtcdServer) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmResponse, error) {
+	if r.Action == pb.AlarmRequest_ACTIVATE && r.Alarm == pb.AlarmType_MAINTENANCE && !s.clusterVersionAtLeast(maintenanceModeClusterVersion) {
+		return nil, ErrNotCapable
+	}
 	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{Alarm: r})
 	if err != nil {
 		return nil, err
@@ -339,6 +410,27 @@ func (s *EtcdServer) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmRe
 	return resp.(*pb.AlarmResponse), nil
 }
 
+// CompactionConfig changes the auto-compaction mode and retention of every
+// member through raft. If r.Mode is empty, the configuration in effect on
+// this member is returned instead.
+func (s *EtcdServer) CompactionConfig(ctx context.Context, r *pb.CompactionConfigRequest) (*pb.CompactionConfigResponse, error) {
+	if r.Mode == "" {
+		mode, retention := s.compactionConfig()
+		return &pb.CompactionConfigResponse{Header: newHeader(s), Mode: mode, Retention: int64(retention)}, nil
+	}
+	if (r.Mode != v3compactor.ModePeriodic && r.Mode != v3compactor.ModeRevision) || r.Retention < 0 {
+		return nil, ErrInvalidCompactionConfig
+	}
+	if !s.clusterVersionAtLeast(compactionConfigClusterVersion) {
+		return nil, ErrNotCapable
+	}
+	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{CompactionConfig: r})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*pb.CompactionConfigResponse), nil
+}
+
 func (s *EtcdServer) AuthEnable(ctx context.Context, r *pb.AuthEnableRequest) (*pb.AuthEnableResponse, error) {
 	resp, err := s.raftRequestOnce(ctx, pb.InternalRaftRequest{AuthEnable: r})
 	if err != nil {
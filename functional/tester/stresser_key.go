@@ -36,6 +36,10 @@ import (
 )
 
 type keyStresser struct {
+	// atomicModifiedKeys records the number of keys created and deleted by the stresser.
+	// Accessed through atomics, so must stay first to be 64-bit aligned on 32-bit platforms.
+	atomicModifiedKeys int64
+
 	lg *zap.Logger
 
 	m *rpcpb.Member
@@ -67,9 +71,6 @@ type keyStresser struct {
 	ems    map[string]int
 	paused bool
 
-	// atomicModifiedKeys records the number of keys created and deleted by the stresser.
-	atomicModifiedKeys int64
-
 	stressTable *stressTable
 }
 
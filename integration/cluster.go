@@ -131,6 +131,11 @@ type ClusterConfig struct {
 
 	QuotaBackendBytes int64
 
+	// RevisionTimes records the commit time of each revision.
+	RevisionTimes bool
+
+	MaintenanceModeTimeout time.Duration
+
 	MaxTxnOps              uint
 	MaxRequestBytes        uint
 	SnapshotCount          uint64
@@ -280,6 +285,8 @@ func (c *cluster) mustNewMember(t *testing.T) *member {
 			peerTLS:                  c.cfg.PeerTLS,
 			clientTLS:                c.cfg.ClientTLS,
 			quotaBackendBytes:        c.cfg.QuotaBackendBytes,
+			revisionTimes:            c.cfg.RevisionTimes,
+			maintenanceModeTimeout:   c.cfg.MaintenanceModeTimeout,
 			maxTxnOps:                c.cfg.MaxTxnOps,
 			maxRequestBytes:          c.cfg.MaxRequestBytes,
 			snapshotCount:            c.cfg.SnapshotCount,
@@ -564,6 +571,8 @@ type memberConfig struct {
 	clientTLS                *transport.TLSInfo
 	authToken                string
 	quotaBackendBytes        int64
+	revisionTimes            bool
+	maintenanceModeTimeout   time.Duration
 	maxTxnOps                uint
 	maxRequestBytes          uint
 	snapshotCount            uint64
@@ -622,6 +631,8 @@ func mustNewMember(t *testing.T, mcfg memberConfig) *member {
 	m.InitialElectionTickAdvance = true
 	m.TickMs = uint(tickDuration / time.Millisecond)
 	m.QuotaBackendBytes = mcfg.quotaBackendBytes
+	m.RevisionTimes = mcfg.revisionTimes
+	m.MaintenanceModeTimeout = mcfg.maintenanceModeTimeout
 	m.MaxTxnOps = mcfg.maxTxnOps
 	if m.MaxTxnOps == 0 {
 		m.MaxTxnOps = embed.DefaultMaxTxnOps
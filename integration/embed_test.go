@@ -16,6 +16,7 @@ package integration
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net/url"
 	"os"
@@ -26,6 +27,11 @@ import (
 
 	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/embed"
+	"github.com/coreos/etcd/pkg/transport"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func TestEmbedEtcd(t *testing.T) {
@@ -165,6 +171,147 @@ func testEmbedEtcdGracefulStop(t *testing.T, secure bool) {
 	}
 }
 
+// TestEmbedEtcdClientListenerTLS ensures a client listener with its own TLS
+// settings serves them, while the other client listeners keep the shared
+// client TLS settings.
+func TestEmbedEtcdClientListenerTLS(t *testing.T) {
+	cfg := embed.NewConfig()
+	cfg.ClientTLSInfo = testTLSInfo
+	cfg.PeerTLSInfo = testTLSInfo
+	urls := newEmbedURLs(true, 3)
+	setupEmbedCfg(cfg, urls[:2], urls[2:])
+	cfg.ClientListenerTLSInfo = map[string]transport.TLSInfo{
+		urls[1].String(): {
+			CertFile:      "./fixtures/server2.crt",
+			KeyFile:       "./fixtures/server2.key.insecure",
+			TrustedCAFile: "./fixtures/ca.crt",
+		},
+	}
+
+	cfg.Dir = filepath.Join(os.TempDir(), fmt.Sprintf("embed-etcd"))
+	os.RemoveAll(cfg.Dir)
+	defer os.RemoveAll(cfg.Dir)
+
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	<-e.Server.ReadyNotify()
+
+	tlscfg, err := testTLSInfo.ClientConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, wcn := range []string{"example.com", "example2.com"} {
+		conn, err := tls.Dial("unix", urls[i].Host, tlscfg)
+		if err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		cn := conn.ConnectionState().PeerCertificates[0].Subject.CommonName
+		conn.Close()
+		if cn != wcn {
+			t.Errorf("#%d: server certificate CN = %q, want %q", i, cn, wcn)
+		}
+	}
+
+	// the listener with its own settings does not require client certificates
+	tlscfg.Certificates = nil
+	cli, err := clientv3.New(clientv3.Config{Endpoints: []string{urls[1].String()}, TLS: tlscfg})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	if _, err = cli.Put(context.TODO(), "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestEmbedEtcdGRPCCompressionSecure(t *testing.T)   { testEmbedEtcdGRPCCompression(t, true) }
+func TestEmbedEtcdGRPCCompressionInsecure(t *testing.T) { testEmbedEtcdGRPCCompression(t, false) }
+
+// testEmbedEtcdGRPCCompression ensures a listener that compresses gRPC
+// responses compresses them only for the clients that accept gzip.
+func testEmbedEtcdGRPCCompression(t *testing.T, secure bool) {
+	cfg := embed.NewConfig()
+	if secure {
+		cfg.ClientTLSInfo = testTLSInfo
+		cfg.PeerTLSInfo = testTLSInfo
+	}
+	urls := newEmbedURLs(secure, 2)
+	setupEmbedCfg(cfg, []url.URL{urls[0]}, []url.URL{urls[1]})
+	cfg.ExperimentalGRPCCompressionURLs = urls[0].String()
+
+	cfg.Dir = filepath.Join(os.TempDir(), fmt.Sprintf("embed-etcd"))
+	os.RemoveAll(cfg.Dir)
+	defer os.RemoveAll(cfg.Dir)
+
+	e, err := embed.StartEtcd(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	<-e.Server.ReadyNotify()
+
+	newClient := func(acceptGZIP bool) *clientv3.Client {
+		ccfg := clientv3.Config{Endpoints: []string{urls[0].String()}, AcceptGZIP: acceptGZIP}
+		if secure {
+			if ccfg.TLS, err = testTLSInfo.ClientConfig(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		cli, cerr := clientv3.New(ccfg)
+		if cerr != nil {
+			t.Fatal(cerr)
+		}
+		return cli
+	}
+
+	val := strings.Repeat("a", 4096)
+	for _, acceptGZIP := range []bool{true, false} {
+		cli := newClient(acceptGZIP)
+		defer cli.Close()
+
+		for i := 0; i < 10; i++ {
+			if _, err = cli.Put(context.TODO(), fmt.Sprintf("foo%d", i), val); err != nil {
+				t.Fatal(err)
+			}
+		}
+		resp, err := cli.Get(context.TODO(), "foo", clientv3.WithPrefix())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) != 10 || string(resp.Kvs[0].Value) != val {
+			t.Fatalf("unexpected range response %+v", resp)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		wch := cli.Watch(ctx, "foo", clientv3.WithPrefix(), clientv3.WithRev(1))
+		n := 0
+		for n < 10 {
+			wresp, ok := <-wch
+			if !ok {
+				t.Fatalf("watch closed after %d events (%v)", n, ctx.Err())
+			}
+			if err = wresp.Err(); err != nil {
+				t.Fatal(err)
+			}
+			n += len(wresp.Events)
+		}
+		cancel()
+	}
+
+	// a client that accepts gzip without being able to decompress it
+	// fails to read the compressed response
+	cli := newClient(false)
+	defer cli.Close()
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("grpc-accept-encoding", "gzip"))
+	_, err = cli.Get(ctx, "foo0")
+	if st, _ := status.FromError(err); st.Code() != codes.Unimplemented {
+		t.Fatalf("expected code %v, got %v", codes.Unimplemented, err)
+	}
+}
+
 func newEmbedURLs(secure bool, n int) (urls []url.URL) {
 	scheme := "unix"
 	if secure {
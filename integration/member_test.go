@@ -20,9 +20,11 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/coreos/etcd/client"
+	"github.com/coreos/etcd/etcdserver"
 	"github.com/coreos/etcd/pkg/testutil"
 )
 
@@ -83,6 +85,39 @@ func TestLaunchDuplicateMemberShouldFail(t *testing.T) {
 	}
 }
 
+// TestStartMemberTwice ensures starting a running server again is a no-op.
+func TestStartMemberTwice(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	c := NewCluster(t, 1)
+	c.Launch(t)
+	defer c.Terminate(t)
+
+	s := c.Members[0].s
+	donec := s.StopNotify()
+	s.Start()
+	if s.StopNotify() != donec {
+		t.Fatalf("expected the second start to keep the running server")
+	}
+	clusterMustProgress(t, c.Members)
+}
+
+// TestLaunchMemberWALInUse ensures a second server on the data directory
+// of a running member fails instead of waiting on its files.
+func TestLaunchMemberWALInUse(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	c := NewCluster(t, 1)
+	c.Launch(t)
+	defer c.Terminate(t)
+
+	cfg := c.Members[0].ServerConfig
+	cfg.NewCluster = false
+	if _, err := etcdserver.NewServer(cfg); err == nil || !strings.Contains(err.Error(), "in use") {
+		t.Fatalf("err = %v, want wal directory in use", err)
+	}
+}
+
 func TestSnapshotAndRestartMember(t *testing.T) {
 	defer testutil.AfterTest(t)
 	m := mustNewMember(t, memberConfig{name: "snapAndRestartTest"})
@@ -141,6 +141,54 @@ func TestV3AlarmDeactivate(t *testing.T) {
 	}
 }
 
+// TestV3AlarmNoSpaceDuringMaintenance ensures that raising and disarming the
+// NOSPACE alarm keeps rejecting writes while the MAINTENANCE alarm is active.
+func TestV3AlarmNoSpaceDuringMaintenance(t *testing.T) {
+	defer testutil.AfterTest(t)
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+	kvc := toGRPC(clus.RandClient()).KV
+	mt := toGRPC(clus.RandClient()).Maintenance
+
+	maintenanceReq := &pb.AlarmRequest{
+		MemberID: uint64(clus.Members[0].ID()),
+		Action:   pb.AlarmRequest_ACTIVATE,
+		Alarm:    pb.AlarmType_MAINTENANCE,
+	}
+	if _, err := mt.Alarm(context.TODO(), maintenanceReq); err != nil {
+		t.Fatal(err)
+	}
+	nospaceReq := &pb.AlarmRequest{
+		MemberID: uint64(clus.Members[0].ID()),
+		Action:   pb.AlarmRequest_ACTIVATE,
+		Alarm:    pb.AlarmType_NOSPACE,
+	}
+	if _, err := mt.Alarm(context.TODO(), nospaceReq); err != nil {
+		t.Fatal(err)
+	}
+
+	put := &pb.PutRequest{Key: []byte("foo"), Value: []byte("bar")}
+	if _, err := kvc.Put(context.TODO(), put); !eqErrGRPC(err, rpctypes.ErrGRPCMaintenanceMode) {
+		t.Fatalf("put got %v, expected %v", err, rpctypes.ErrGRPCMaintenanceMode)
+	}
+
+	nospaceReq.Action = pb.AlarmRequest_DEACTIVATE
+	if _, err := mt.Alarm(context.TODO(), nospaceReq); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kvc.Put(context.TODO(), put); !eqErrGRPC(err, rpctypes.ErrGRPCMaintenanceMode) {
+		t.Fatalf("put got %v, expected %v", err, rpctypes.ErrGRPCMaintenanceMode)
+	}
+
+	maintenanceReq.Action = pb.AlarmRequest_DEACTIVATE
+	if _, err := mt.Alarm(context.TODO(), maintenanceReq); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := kvc.Put(context.TODO(), put); err != nil {
+		t.Fatal(err)
+	}
+}
+
 type fakeConsistentIndex struct{ rev uint64 }
 
 func (f *fakeConsistentIndex) ConsistentIndex() uint64 { return f.rev }
@@ -17,7 +17,9 @@ package integration
 import (
 	"context"
 	"testing"
+	"time"
 
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/pkg/testutil"
 
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
@@ -38,3 +40,64 @@ func TestHealthCheck(t *testing.T) {
 		t.Fatalf("status expected %s, got %s", healthpb.HealthCheckResponse_SERVING, resp.Status)
 	}
 }
+
+func TestHealthCheckAlarm(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	alarm := &pb.AlarmRequest{
+		MemberID: uint64(clus.Members[0].ID()),
+		Action:   pb.AlarmRequest_ACTIVATE,
+		Alarm:    pb.AlarmType_NOSPACE,
+	}
+	if _, err := clus.Members[0].s.Alarm(context.TODO(), alarm); err != nil {
+		t.Fatal(err)
+	}
+	cli := healthpb.NewHealthClient(clus.RandClient().ActiveConnection())
+	waitHealthStatus(t, cli, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	alarm.Action = pb.AlarmRequest_DEACTIVATE
+	if _, err := clus.Members[0].s.Alarm(context.TODO(), alarm); err != nil {
+		t.Fatal(err)
+	}
+	waitHealthStatus(t, cli, healthpb.HealthCheckResponse_SERVING)
+}
+
+func TestHealthCheckRestart(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	m := clus.Members[0]
+	m.Stop(t)
+	if err := m.Restart(t); err != nil {
+		t.Fatal(err)
+	}
+	cli, err := NewClientV3(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cli.Close()
+	waitHealthStatus(t, healthpb.NewHealthClient(cli.ActiveConnection()), healthpb.HealthCheckResponse_SERVING)
+}
+
+func waitHealthStatus(t *testing.T, cli healthpb.HealthClient, w healthpb.HealthCheckResponse_ServingStatus) {
+	stopc := time.After(5 * time.Second)
+	for {
+		resp, err := cli.Check(context.TODO(), &healthpb.HealthCheckRequest{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Status == w {
+			return
+		}
+		select {
+		case <-stopc:
+			t.Fatalf("timed out waiting for status %s, got %s", w, resp.Status)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
@@ -16,12 +16,16 @@ package integration
 
 import (
 	"context"
+	"encoding/binary"
 	"fmt"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/coreos/etcd/clientv3"
 	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
+	"github.com/coreos/etcd/mvcc/backend"
 	"github.com/coreos/etcd/mvcc/mvccpb"
 	"github.com/coreos/etcd/pkg/testutil"
 
@@ -876,3 +880,112 @@ func leaseExist(t *testing.T, clus *ClusterV3, leaseID int64) bool {
 
 	return true
 }
+
+// TestV3OrphanLeaseKeys ensures the leader deletes the keys attached to
+// leases missing from the lessor, and keeps keys attached to leases that
+// are granted while it scans for them.
+func TestV3OrphanLeaseKeys(t *testing.T) {
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	var ids []clientv3.LeaseID
+	for _, k := range []string{"orphan", "live"} {
+		lresp, err := cli.Grant(context.TODO(), fiveMinTTL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err = cli.Put(context.TODO(), k, "bar", clientv3.WithLease(lresp.ID)); err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, lresp.ID)
+	}
+	if _, err := cli.Put(context.TODO(), "nolease", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	orphanID := ids[0]
+
+	// drop the lease but not its key, as a crash in the middle of
+	// revoking it would
+	m := clus.Members[0]
+	m.Stop(t)
+	be := backend.NewDefaultBackend(filepath.Join(m.DataDir, "member", "snap", "db"))
+	idb := make([]byte, 8)
+	binary.BigEndian.PutUint64(idb, uint64(orphanID))
+	tx := be.BatchTx()
+	tx.Lock()
+	tx.UnsafeDelete([]byte("lease"), idb)
+	tx.Unlock()
+	be.Close()
+
+	m.OrphanLeaseKeyCheckTime = 50 * time.Millisecond
+	m.Restart(t)
+	clus.waitLeader(t, clus.Members)
+
+	nc, err := NewClientV3(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer nc.Close()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+	wch := nc.Watch(ctx, "orphan")
+
+	// grant leases and attach keys to them while the cleanup runs
+	donec := make(chan error, 1)
+	go func() {
+		for i := 0; i < 20; i++ {
+			lresp, gerr := nc.Grant(ctx, fiveMinTTL)
+			if gerr != nil {
+				donec <- gerr
+				return
+			}
+			if _, gerr = nc.Put(ctx, fmt.Sprintf("granted/%d", i), "bar", clientv3.WithLease(lresp.ID)); gerr != nil {
+				donec <- gerr
+				return
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		donec <- nil
+	}()
+
+	wresp := <-wch
+	if err = wresp.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(wresp.Events) != 1 || wresp.Events[0].Type != mvccpb.DELETE {
+		t.Fatalf("expected orphan key deleted, got %+v", wresp.Events)
+	}
+	if err = <-donec; err != nil {
+		t.Fatal(err)
+	}
+
+	// reusing the ID of the missing lease attaches keys to a lease that
+	// exists again
+	if _, err = toGRPC(nc).Lease.LeaseGrant(ctx, &pb.LeaseGrantRequest{ID: int64(orphanID), TTL: fiveMinTTL}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = nc.Put(ctx, "orphan", "baz", clientv3.WithLease(orphanID)); err != nil {
+		t.Fatal(err)
+	}
+
+	// wait for a few more cleanup passes
+	time.Sleep(5 * m.OrphanLeaseKeyCheckTime)
+	for _, k := range []string{"orphan", "live", "nolease"} {
+		resp, gerr := nc.Get(ctx, k)
+		if gerr != nil {
+			t.Fatal(gerr)
+		}
+		if len(resp.Kvs) != 1 {
+			t.Errorf("expected key %q to exist", k)
+		}
+	}
+	resp, err := nc.Get(ctx, "granted/", clientv3.WithPrefix(), clientv3.WithCountOnly())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Count != 20 {
+		t.Errorf("expected 20 keys attached to granted leases, got %d", resp.Count)
+	}
+}
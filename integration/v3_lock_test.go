@@ -103,6 +103,43 @@ func TestMutexSessionRelock(t *testing.T) {
 	}
 }
 
+// TestMutexRevFencing ensures that each acquisition of a lock gets a
+// greater revision, matching the creation revision of the lock key.
+func TestMutexRevFencing(t *testing.T) {
+	defer testutil.AfterTest(t)
+
+	clus := NewClusterV3(t, &ClusterConfig{Size: 1})
+	defer clus.Terminate(t)
+
+	cli := clus.Client(0)
+	lastRev := int64(0)
+	for i := 0; i < 3; i++ {
+		session, err := concurrency.NewSession(cli)
+		if err != nil {
+			t.Fatal(err)
+		}
+		m := concurrency.NewMutex(session, "test-mutex")
+		if err = m.Lock(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := cli.Get(context.TODO(), m.Key())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Kvs) != 1 || resp.Kvs[0].CreateRevision != m.Rev() {
+			t.Fatalf("#%d: expected lock key created at revision %d, got %+v", i, m.Rev(), resp.Kvs)
+		}
+		if m.Rev() <= lastRev {
+			t.Fatalf("#%d: expected revision greater than %d, got %d", i, lastRev, m.Rev())
+		}
+		lastRev = m.Rev()
+		if err = m.Unlock(context.TODO()); err != nil {
+			t.Fatal(err)
+		}
+		session.Close()
+	}
+}
+
 // TestMutexWaitsOnCurrentHolder ensures a mutex is only acquired once all
 // waiters older than the new owner are gone by testing the case where
 // the waiter prior to the acquirer expires before the current holder.
@@ -0,0 +1,87 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lease
+
+import (
+	"sync"
+	"time"
+)
+
+// EventType is the kind of a lease lifecycle event.
+type EventType string
+
+const (
+	EventGrant  EventType = "grant"
+	EventRenew  EventType = "renew"
+	EventExpire EventType = "expire"
+	EventRevoke EventType = "revoke"
+)
+
+// eventBufSize is the number of events buffered for each subscriber;
+// events are dropped for subscribers that fall further behind.
+const eventBufSize = 1024
+
+// Event is a lease lifecycle event, for debugging.
+type Event struct {
+	Type EventType `json:"type"`
+	ID   LeaseID   `json:"id"`
+	TTL  int64     `json:"ttl"`
+	// Keys is the number of keys attached to the lease.
+	Keys int       `json:"keys"`
+	Time time.Time `json:"time"`
+}
+
+// eventBroadcaster fans lease events out to subscribers without blocking
+// the lessor.
+type eventBroadcaster struct {
+	mu   sync.Mutex
+	subs map[chan Event]struct{}
+}
+
+func (eb *eventBroadcaster) subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, eventBufSize)
+	eb.mu.Lock()
+	if eb.subs == nil {
+		eb.subs = make(map[chan Event]struct{})
+	}
+	eb.subs[ch] = struct{}{}
+	eb.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			eb.mu.Lock()
+			delete(eb.subs, ch)
+			eb.mu.Unlock()
+		})
+	}
+}
+
+func newEvent(typ EventType, l *Lease) Event {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return Event{Type: typ, ID: l.ID, TTL: l.ttl, Keys: len(l.itemSet), Time: l.clock.Now()}
+}
+
+func (eb *eventBroadcaster) emit(ev Event) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	for ch := range eb.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
@@ -18,10 +18,13 @@ import (
 	"container/heap"
 	"testing"
 	"time"
+
+	"github.com/jonboulle/clockwork"
 )
 
 func TestLeaseQueue(t *testing.T) {
 	le := &lessor{
+		clock:     clockwork.NewRealClock(),
 		leaseHeap: make(LeaseQueue, 0),
 		leaseMap:  make(map[LeaseID]*Lease),
 	}
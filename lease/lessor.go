@@ -16,6 +16,7 @@ package lease
 
 import (
 	"container/heap"
+	"context"
 	"encoding/binary"
 	"errors"
 	"math"
@@ -23,8 +24,11 @@ import (
 	"sync"
 	"time"
 
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/lease/leasepb"
 	"github.com/coreos/etcd/mvcc/backend"
+
+	"github.com/jonboulle/clockwork"
 )
 
 // NoLease is a special LeaseID representing the absence of a lease.
@@ -41,6 +45,9 @@ var (
 	// maximum number of leases to revoke per second; configurable for tests
 	leaseRevokeRate = 1000
 
+	// maximum number of lease checkpoints to batch into a single consensus log entry
+	maxLeaseCheckpointBatchSize = 1000
+
 	ErrNotPrimary       = errors.New("not a primary lessor")
 	ErrLeaseNotFound    = errors.New("lease not found")
 	ErrLeaseExists      = errors.New("lease already exists")
@@ -57,6 +64,9 @@ type TxnDelete interface {
 // RangeDeleter is a TxnDelete constructor.
 type RangeDeleter func() TxnDelete
 
+// Checkpointer permits checkpointing of lease remaining TTLs to the consensus log.
+type Checkpointer func(ctx context.Context, lc *pb.LeaseCheckpointRequest)
+
 type LeaseID int64
 
 // Lessor owns leases. It can grant, revoke, renew and modify leases for lessee.
@@ -66,6 +76,10 @@ type Lessor interface {
 	// new TxnDeletes.
 	SetRangeDeleter(rd RangeDeleter)
 
+	// SetCheckpointer lets the primary lessor checkpoint the remaining
+	// TTLs of leases, so that they are not reset on leader change or restart.
+	SetCheckpointer(cp Checkpointer)
+
 	// Grant grants a lease that expires at least after TTL seconds.
 	Grant(id LeaseID, ttl int64) (*Lease, error)
 	// Revoke revokes a lease with given ID. The item attached to the
@@ -73,6 +87,10 @@ type Lessor interface {
 	// will be returned.
 	Revoke(id LeaseID) error
 
+	// Checkpoint applies the remainingTTL of a lease. The remainingTTL is used in Promote to set
+	// the expiry of leases to less than the full TTL when possible.
+	Checkpoint(id LeaseID, remainingTTL int64) error
+
 	// Attach attaches given leaseItem to the lease with given LeaseID.
 	// If the lease does not exist, an error will be returned.
 	Attach(id LeaseID, items []LeaseItem) error
@@ -106,6 +124,11 @@ type Lessor interface {
 	// ExpiredLeasesC returns a chan that is used to receive expired leases.
 	ExpiredLeasesC() <-chan []*Lease
 
+	// Events subscribes to lease lifecycle events, for debugging. Events
+	// are dropped if the receiver falls behind. The returned function
+	// cancels the subscription.
+	Events() (<-chan Event, func())
+
 	// Recover recovers the lessor state from the given backend and RangeDeleter.
 	Recover(b backend.Backend, rd RangeDeleter)
 
@@ -115,10 +138,12 @@ type Lessor interface {
 }
 
 // lessor implements Lessor interface.
-// TODO: use clockwork for testability.
 type lessor struct {
 	mu sync.RWMutex
 
+	// clock is the source of time for lease expiry and checkpoints.
+	clock clockwork.Clock
+
 	// demotec is set when the lessor is the primary.
 	// demotec will be closed if the lessor is demoted.
 	demotec chan struct{}
@@ -131,6 +156,10 @@ type lessor struct {
 	// leased range (or key) by the RangeDeleter.
 	rd RangeDeleter
 
+	// When the primary lessor checkpoints the remaining TTLs of leases,
+	// it proposes them through the Checkpointer.
+	cp Checkpointer
+
 	// backend to persist leases. We only persist lease ID and expiry for now.
 	// The leased items can be recovered by iterating all the keys in kv.
 	b backend.Backend
@@ -139,6 +168,14 @@ type lessor struct {
 	// requests for shorter TTLs are extended to the minimum TTL.
 	minLeaseTTL int64
 
+	// checkpointInterval is the interval at which the primary lessor
+	// checkpoints the remaining TTLs of leases.
+	checkpointInterval time.Duration
+	// nextCheckpoint is when the primary lessor checkpoints next.
+	nextCheckpoint time.Time
+
+	events eventBroadcaster
+
 	expiredC chan []*Lease
 	// stopC is a channel whose closure indicates that the lessor should be stopped.
 	stopC chan struct{}
@@ -146,17 +183,37 @@ type lessor struct {
 	doneC chan struct{}
 }
 
-func NewLessor(b backend.Backend, minLeaseTTL int64) Lessor {
-	return newLessor(b, minLeaseTTL)
+type LessorConfig struct {
+	MinLeaseTTL int64
+	// CheckpointInterval is the interval at which the primary lessor
+	// checkpoints the remaining TTLs of leases. Defaults to 5 minutes.
+	CheckpointInterval time.Duration
+	// Clock is the source of time for lease expiry. Defaults to the real
+	// clock; tests use a fake clock to expire leases without waiting.
+	Clock clockwork.Clock
+}
+
+func NewLessor(b backend.Backend, cfg LessorConfig) Lessor {
+	return newLessor(b, cfg)
 }
 
-func newLessor(b backend.Backend, minLeaseTTL int64) *lessor {
+func newLessor(b backend.Backend, cfg LessorConfig) *lessor {
+	checkpointInterval := cfg.CheckpointInterval
+	if checkpointInterval == 0 {
+		checkpointInterval = 5 * time.Minute
+	}
+	clock := cfg.Clock
+	if clock == nil {
+		clock = clockwork.NewRealClock()
+	}
 	l := &lessor{
-		leaseMap:    make(map[LeaseID]*Lease),
-		itemMap:     make(map[LeaseItem]LeaseID),
-		leaseHeap:   make(LeaseQueue, 0),
-		b:           b,
-		minLeaseTTL: minLeaseTTL,
+		clock:              clock,
+		leaseMap:           make(map[LeaseID]*Lease),
+		itemMap:            make(map[LeaseItem]LeaseID),
+		leaseHeap:          make(LeaseQueue, 0),
+		b:                  b,
+		minLeaseTTL:        cfg.MinLeaseTTL,
+		checkpointInterval: checkpointInterval,
 		// expiredC is a small buffered chan to avoid unnecessary blocking.
 		expiredC: make(chan []*Lease, 16),
 		stopC:    make(chan struct{}),
@@ -193,6 +250,13 @@ func (le *lessor) SetRangeDeleter(rd RangeDeleter) {
 	le.rd = rd
 }
 
+func (le *lessor) SetCheckpointer(cp Checkpointer) {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	le.cp = cp
+}
+
 func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	if id == NoLease {
 		return nil, ErrLeaseNotFound
@@ -207,6 +271,7 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 	l := &Lease{
 		ID:      id,
 		ttl:     ttl,
+		clock:   le.clock,
 		itemSet: make(map[LeaseItem]struct{}),
 		revokec: make(chan struct{}),
 	}
@@ -235,6 +300,7 @@ func (le *lessor) Grant(id LeaseID, ttl int64) (*Lease, error) {
 
 	leaseTotalTTLs.Observe(float64(l.ttl))
 	leaseGranted.Inc()
+	le.events.emit(newEvent(EventGrant, l))
 	return l, nil
 }
 
@@ -256,6 +322,9 @@ func (le *lessor) Revoke(id LeaseID) error {
 
 	txn := le.rd()
 
+	// record the attached key count before the keys are detached
+	ev := newEvent(EventRevoke, l)
+
 	// sort keys so deletes are in same order among all members,
 	// otherwise the backened hashes will be different
 	keys := l.Keys()
@@ -272,9 +341,26 @@ func (le *lessor) Revoke(id LeaseID) error {
 	// deleting the keys if etcdserver fails in between.
 	le.b.BatchTx().UnsafeDelete(leaseBucketName, int64ToBytes(int64(l.ID)))
 
+	// gofail: var lessorBeforeRevokeTxnEnd struct{}
 	txn.End()
 
 	leaseRevoked.Inc()
+	le.events.emit(ev)
+	return nil
+}
+
+func (le *lessor) Checkpoint(id LeaseID, remainingTTL int64) error {
+	le.mu.Lock()
+	defer le.mu.Unlock()
+
+	l, ok := le.leaseMap[id]
+	if !ok {
+		return ErrLeaseNotFound
+	}
+	l.remainingTTL = remainingTTL
+	// persist the checkpoint so that the remaining TTL also survives
+	// restarts of the whole cluster
+	l.persistTo(le.b)
 	return nil
 }
 
@@ -316,11 +402,24 @@ func (le *lessor) Renew(id LeaseID) (int64, error) {
 		}
 	}
 
+	// Clear the checkpointed remaining TTL so that the renewed lease
+	// does not expire early after a leader change.
+	clearRemainingTTL := le.cp != nil && l.remainingTTL > 0
+	l.remainingTTL = 0
+
 	l.refresh(0)
 	item := &LeaseWithTime{id: l.ID, expiration: l.expiry.UnixNano()}
 	heap.Push(&le.leaseHeap, item)
 
+	if clearRemainingTTL {
+		cp := le.cp
+		le.mu.Unlock()
+		unlock = func() {}
+		cp(context.Background(), &pb.LeaseCheckpointRequest{Checkpoints: []*pb.LeaseCheckpoint{{ID: int64(l.ID), Remaining_TTL: 0}}})
+	}
+
 	leaseRenewed.Inc()
+	le.events.emit(newEvent(EventRenew, l))
 	return l.ttl, nil
 }
 
@@ -481,6 +580,10 @@ func (le *lessor) ExpiredLeasesC() <-chan []*Lease {
 	return le.expiredC
 }
 
+func (le *lessor) Events() (<-chan Event, func()) {
+	return le.events.subscribe()
+}
+
 func (le *lessor) Stop() {
 	close(le.stopC)
 	<-le.doneC
@@ -506,6 +609,9 @@ func (le *lessor) runLoop() {
 			case <-le.stopC:
 				return
 			case le.expiredC <- ls:
+				for _, l := range ls {
+					le.events.emit(newEvent(EventExpire, l))
+				}
 			default:
 				// the receiver of expiredC is probably busy handling
 				// other stuff
@@ -513,14 +619,48 @@ func (le *lessor) runLoop() {
 			}
 		}
 
+		le.checkpointLeases()
+
 		select {
-		case <-time.After(500 * time.Millisecond):
+		case <-le.clock.After(500 * time.Millisecond):
 		case <-le.stopC:
 			return
 		}
 	}
 }
 
+// checkpointLeases proposes the remaining TTLs of all leases once every
+// checkpoint interval, if the lessor is the primary.
+func (le *lessor) checkpointLeases() {
+	le.mu.Lock()
+	if !le.isPrimary() || le.cp == nil || le.clock.Now().Before(le.nextCheckpoint) {
+		le.mu.Unlock()
+		return
+	}
+	le.nextCheckpoint = le.clock.Now().Add(le.checkpointInterval)
+	cps := make([]*pb.LeaseCheckpoint, 0, len(le.leaseMap))
+	for _, l := range le.leaseMap {
+		remaining := int64(math.Ceil(l.Remaining().Seconds()))
+		// leases that expire before the next checkpoint would
+		// gain nothing from being checkpointed
+		if time.Duration(l.ttl)*time.Second <= le.checkpointInterval || remaining <= 0 {
+			continue
+		}
+		cps = append(cps, &pb.LeaseCheckpoint{ID: int64(l.ID), Remaining_TTL: remaining})
+	}
+	cp := le.cp
+	le.mu.Unlock()
+
+	for len(cps) > 0 {
+		n := len(cps)
+		if n > maxLeaseCheckpointBatchSize {
+			n = maxLeaseCheckpointBatchSize
+		}
+		cp(context.Background(), &pb.LeaseCheckpointRequest{Checkpoints: cps[:n]})
+		cps = cps[n:]
+	}
+}
+
 // expireExists returns true if expiry items exist.
 // It pops only when expiry item exists.
 // "next" is true, to indicate that it may exist in next attempt.
@@ -538,7 +678,7 @@ func (le *lessor) expireExists() (l *Lease, ok bool, next bool) {
 		return nil, false, true
 	}
 
-	if time.Now().UnixNano() < item.expiration {
+	if le.clock.Now().UnixNano() < item.expiration {
 		// Candidate expirations are caught up, reinsert this item
 		// and no need to revoke (nothing is expiry)
 		return l, false, false
@@ -598,8 +738,10 @@ func (le *lessor) initAndRecover() {
 			lpb.TTL = le.minLeaseTTL
 		}
 		le.leaseMap[ID] = &Lease{
-			ID:  ID,
-			ttl: lpb.TTL,
+			ID:           ID,
+			ttl:          lpb.TTL,
+			remainingTTL: lpb.RemainingTTL,
+			clock:        le.clock,
 			// itemSet will be filled in when recover key-value pairs
 			// set expiry to forever, refresh when promoted
 			itemSet: make(map[LeaseItem]struct{}),
@@ -616,10 +758,14 @@ func (le *lessor) initAndRecover() {
 type Lease struct {
 	ID  LeaseID
 	ttl int64 // time to live in seconds
+	// remainingTTL is the remaining time to live in seconds, if zero valued it is considered unset and the full ttl should be used
+	remainingTTL int64
 	// expiryMu protects concurrent accesses to expiry
 	expiryMu sync.RWMutex
 	// expiry is time when lease should expire. no expiration when expiry.IsZero() is true
 	expiry time.Time
+	// clock is the clock of the lessor that granted the lease.
+	clock clockwork.Clock
 
 	// mu protects concurrent accesses to itemSet
 	mu      sync.RWMutex
@@ -634,7 +780,7 @@ func (l *Lease) expired() bool {
 func (l *Lease) persistTo(b backend.Backend) {
 	key := int64ToBytes(int64(l.ID))
 
-	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl}
+	lpb := leasepb.Lease{ID: int64(l.ID), TTL: l.ttl, RemainingTTL: l.remainingTTL}
 	val, err := lpb.Marshal()
 	if err != nil {
 		panic("failed to marshal lease proto item")
@@ -650,9 +796,17 @@ func (l *Lease) TTL() int64 {
 	return l.ttl
 }
 
+// getRemainingTTL returns the last checkpointed remaining TTL of the lease.
+func (l *Lease) getRemainingTTL() int64 {
+	if l.remainingTTL > 0 {
+		return l.remainingTTL
+	}
+	return l.ttl
+}
+
 // refresh refreshes the expiry of the lease.
 func (l *Lease) refresh(extend time.Duration) {
-	newExpiry := time.Now().Add(extend + time.Duration(l.ttl)*time.Second)
+	newExpiry := l.clock.Now().Add(extend + time.Duration(l.getRemainingTTL())*time.Second)
 	l.expiryMu.Lock()
 	defer l.expiryMu.Unlock()
 	l.expiry = newExpiry
@@ -683,7 +837,7 @@ func (l *Lease) Remaining() time.Duration {
 	if l.expiry.IsZero() {
 		return time.Duration(math.MaxInt64)
 	}
-	return time.Until(l.expiry)
+	return l.expiry.Sub(l.clock.Now())
 }
 
 type LeaseItem struct {
@@ -702,10 +856,14 @@ type FakeLessor struct{}
 
 func (fl *FakeLessor) SetRangeDeleter(dr RangeDeleter) {}
 
+func (fl *FakeLessor) SetCheckpointer(cp Checkpointer) {}
+
 func (fl *FakeLessor) Grant(id LeaseID, ttl int64) (*Lease, error) { return nil, nil }
 
 func (fl *FakeLessor) Revoke(id LeaseID) error { return nil }
 
+func (fl *FakeLessor) Checkpoint(id LeaseID, remainingTTL int64) error { return nil }
+
 func (fl *FakeLessor) Attach(id LeaseID, items []LeaseItem) error { return nil }
 
 func (fl *FakeLessor) GetLease(item LeaseItem) LeaseID            { return 0 }
@@ -725,4 +883,6 @@ func (fl *FakeLessor) ExpiredLeasesC() <-chan []*Lease { return nil }
 
 func (fl *FakeLessor) Recover(b backend.Backend, rd RangeDeleter) {}
 
+func (fl *FakeLessor) Events() (<-chan Event, func()) { return nil, func() {} }
+
 func (fl *FakeLessor) Stop() {}
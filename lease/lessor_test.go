@@ -15,6 +15,7 @@
 package lease
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -25,7 +26,10 @@ import (
 	"testing"
 	"time"
 
+	pb "github.com/coreos/etcd/etcdserver/etcdserverpb"
 	"github.com/coreos/etcd/mvcc/backend"
+
+	"github.com/jonboulle/clockwork"
 )
 
 const (
@@ -41,7 +45,7 @@ func TestLessorGrant(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer le.Stop()
 	le.Promote(0)
 
@@ -102,7 +106,7 @@ func TestLeaseConcurrentKeys(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer le.Stop()
 	le.SetRangeDeleter(func() TxnDelete { return newFakeDeleter(be) })
 
@@ -150,7 +154,7 @@ func TestLessorRevoke(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer le.Stop()
 	var fd *fakeDeleter
 	le.SetRangeDeleter(func() TxnDelete {
@@ -202,7 +206,7 @@ func TestLessorRenew(t *testing.T) {
 	defer be.Close()
 	defer os.RemoveAll(dir)
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer le.Stop()
 	le.Promote(0)
 
@@ -229,6 +233,61 @@ func TestLessorRenew(t *testing.T) {
 	}
 }
 
+// TestLessorEvents ensures the lessor emits lifecycle events with the
+// number of attached keys.
+func TestLessorEvents(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	le.SetRangeDeleter(func() TxnDelete { return newFakeDeleter(be) })
+	le.Promote(0)
+
+	evc, cancel := le.Events()
+	defer cancel()
+
+	l, err := le.Grant(1, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = le.Attach(l.ID, []LeaseItem{{"foo"}, {"bar"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = le.Renew(l.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err = le.Revoke(l.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	wevs := []struct {
+		typ  EventType
+		keys int
+	}{{EventGrant, 0}, {EventRenew, 2}, {EventRevoke, 2}}
+	for i, wev := range wevs {
+		select {
+		case ev := <-evc:
+			if ev.Type != wev.typ || ev.ID != l.ID || ev.Keys != wev.keys {
+				t.Errorf("#%d: event = %+v, want type %s with %d keys", i, ev, wev.typ, wev.keys)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("#%d: timed out waiting for %s event", i, wev.typ)
+		}
+	}
+
+	cancel()
+	if _, err = le.Grant(2, 100); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-evc:
+		t.Errorf("unexpected event %+v after cancel", ev)
+	default:
+	}
+}
+
 // TestLessorRenewExtendPileup ensures Lessor extends leases on promotion if too many
 // expire at the same time.
 func TestLessorRenewExtendPileup(t *testing.T) {
@@ -239,7 +298,7 @@ func TestLessorRenewExtendPileup(t *testing.T) {
 	dir, be := NewTestBackend(t)
 	defer os.RemoveAll(dir)
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	ttl := int64(10)
 	for i := 1; i <= leaseRevokeRate*10; i++ {
 		if _, err := le.Grant(LeaseID(2*i), ttl); err != nil {
@@ -258,7 +317,7 @@ func TestLessorRenewExtendPileup(t *testing.T) {
 	bcfg.Path = filepath.Join(dir, "be")
 	be = backend.New(bcfg)
 	defer be.Close()
-	le = newLessor(be, minLeaseTTL)
+	le = newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer le.Stop()
 
 	// extend after recovery should extend expiration on lease pile-up
@@ -287,7 +346,7 @@ func TestLessorDetach(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer le.Stop()
 	le.SetRangeDeleter(func() TxnDelete { return newFakeDeleter(be) })
 
@@ -327,7 +386,7 @@ func TestLessorRecover(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer le.Stop()
 	l1, err1 := le.Grant(1, 10)
 	l2, err2 := le.Grant(2, 20)
@@ -336,7 +395,7 @@ func TestLessorRecover(t *testing.T) {
 	}
 
 	// Create a new lessor with the same backend
-	nle := newLessor(be, minLeaseTTL)
+	nle := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer nle.Stop()
 	nl1 := nle.Lookup(l1.ID)
 	if nl1 == nil || nl1.ttl != l1.ttl {
@@ -356,7 +415,7 @@ func TestLessorExpire(t *testing.T) {
 
 	testMinTTL := int64(1)
 
-	le := newLessor(be, testMinTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: testMinTTL})
 	defer le.Stop()
 
 	le.Promote(1 * time.Second)
@@ -401,6 +460,51 @@ func TestLessorExpire(t *testing.T) {
 	}
 }
 
+// TestLessorExpireFakeClock ensures that leases expire once the lessor's
+// clock passes their TTL, without waiting for the TTL in real time.
+func TestLessorExpireFakeClock(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	fc := clockwork.NewFakeClock()
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL, Clock: fc})
+	defer le.Stop()
+
+	le.Promote(0)
+	l, err := le.Grant(1, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r := l.Remaining(); r != 100*time.Second {
+		t.Fatalf("remaining = %v, want 100s", r)
+	}
+
+	// wait for the run loop to sleep on the clock, and to come back to
+	// it after each advance
+	fc.BlockUntil(1)
+	fc.Advance(99 * time.Second)
+	fc.BlockUntil(1)
+	select {
+	case el := <-le.ExpiredLeasesC():
+		t.Fatalf("lease %x expired before its TTL", el[0].ID)
+	default:
+	}
+	if r := l.Remaining(); r != time.Second {
+		t.Fatalf("remaining = %v, want 1s", r)
+	}
+
+	fc.Advance(2 * time.Second)
+	select {
+	case el := <-le.ExpiredLeasesC():
+		if el[0].ID != l.ID {
+			t.Fatalf("expired id = %x, want %x", el[0].ID, l.ID)
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("failed to receive expired lease")
+	}
+}
+
 func TestLessorExpireAndDemote(t *testing.T) {
 	dir, be := NewTestBackend(t)
 	defer os.RemoveAll(dir)
@@ -408,7 +512,7 @@ func TestLessorExpireAndDemote(t *testing.T) {
 
 	testMinTTL := int64(1)
 
-	le := newLessor(be, testMinTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: testMinTTL})
 	defer le.Stop()
 
 	le.Promote(1 * time.Second)
@@ -456,7 +560,7 @@ func TestLessorMaxTTL(t *testing.T) {
 	defer os.RemoveAll(dir)
 	defer be.Close()
 
-	le := newLessor(be, minLeaseTTL)
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
 	defer le.Stop()
 
 	_, err := le.Grant(1, MaxLeaseTTL+1)
@@ -465,6 +569,83 @@ func TestLessorMaxTTL(t *testing.T) {
 	}
 }
 
+// TestLessorCheckpointPersistAndPromote ensures a checkpointed remaining TTL is
+// persisted and used by a recovered lessor when it is promoted.
+func TestLessorCheckpointPersistAndPromote(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer le.Stop()
+	l, err := le.Grant(1, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = le.Checkpoint(l.ID, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err = le.Checkpoint(2, 10); err != ErrLeaseNotFound {
+		t.Fatalf("expected %v, got %v", ErrLeaseNotFound, err)
+	}
+
+	// Create a new lessor with the same backend
+	nle := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL})
+	defer nle.Stop()
+	nle.Promote(0)
+	nl := nle.Lookup(l.ID)
+	if nl == nil {
+		t.Fatalf("lease %d not recovered", l.ID)
+	}
+	if nl.ttl != 100 {
+		t.Errorf("ttl = %d, want 100", nl.ttl)
+	}
+	if r := nl.Remaining(); r > 10*time.Second || r < 9*time.Second {
+		t.Errorf("remaining = %v, want about 10s", r)
+	}
+
+	// renewal resets to the full TTL
+	if _, err = nle.Renew(l.ID); err != nil {
+		t.Fatal(err)
+	}
+	if r := nl.Remaining(); r < 99*time.Second {
+		t.Errorf("remaining = %v, want about 100s", r)
+	}
+}
+
+// TestLessorCheckpointLeases ensures the primary lessor proposes the
+// remaining TTLs of leases longer than the checkpoint interval.
+func TestLessorCheckpointLeases(t *testing.T) {
+	dir, be := NewTestBackend(t)
+	defer os.RemoveAll(dir)
+	defer be.Close()
+
+	le := newLessor(be, LessorConfig{MinLeaseTTL: minLeaseTTL, CheckpointInterval: time.Minute})
+	defer le.Stop()
+	cpc := make(chan *pb.LeaseCheckpointRequest, 1)
+	le.SetCheckpointer(func(ctx context.Context, lc *pb.LeaseCheckpointRequest) { cpc <- lc })
+
+	if _, err := le.Grant(1, 30); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := le.Grant(2, 300); err != nil {
+		t.Fatal(err)
+	}
+	le.Promote(0)
+
+	select {
+	case lc := <-cpc:
+		if len(lc.Checkpoints) != 1 || lc.Checkpoints[0].ID != 2 {
+			t.Fatalf("expected checkpoint of lease 2 only, got %+v", lc.Checkpoints)
+		}
+		if ttl := lc.Checkpoints[0].Remaining_TTL; ttl <= 0 || ttl > 300 {
+			t.Fatalf("remaining TTL = %d, want (0, 300]", ttl)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for checkpoint")
+	}
+}
+
 type fakeDeleter struct {
 	deleted []string
 	tx      backend.BatchTx
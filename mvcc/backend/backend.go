@@ -241,6 +241,8 @@ func (b *backend) Snapshot() Snapshot {
 	return &snapshot{tx, stopc, donec}
 }
 
+// IgnoreKey is a key left out of the backend hash. An empty Key leaves
+// out the whole bucket.
 type IgnoreKey struct {
 	Bucket string
 	Key    string
@@ -258,6 +260,9 @@ func (b *backend) Hash(ignores map[IgnoreKey]struct{}) (uint32, error) {
 			if b == nil {
 				return fmt.Errorf("cannot get hash of bucket %s", string(next))
 			}
+			if _, ok := ignores[IgnoreKey{Bucket: string(next)}]; ok {
+				continue
+			}
 			h.Write(next)
 			b.ForEach(func(k, v []byte) error {
 				bk := IgnoreKey{Bucket: string(next), Key: string(k)}
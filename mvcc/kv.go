@@ -15,6 +15,8 @@
 package mvcc
 
 import (
+	"time"
+
 	"github.com/coreos/etcd/lease"
 	"github.com/coreos/etcd/mvcc/backend"
 	"github.com/coreos/etcd/mvcc/mvccpb"
@@ -116,6 +118,20 @@ type KV interface {
 	// Compact frees all superseded keys with revisions less than rev.
 	Compact(rev int64) (<-chan struct{}, error)
 
+	// CompactionStatus returns the progress of the last compaction.
+	CompactionStatus() CompactionStatus
+
+	// RevisionAt returns the latest revision committed at or before t on
+	// this member.
+	RevisionAt(t time.Time) (int64, error)
+
+	// RevisionTime returns the time rev was committed at on this member.
+	RevisionTime(rev int64) (time.Time, error)
+
+	// SetRevisionTimes turns recording the commit time of each revision,
+	// for RevisionAt and RevisionTime, on or off.
+	SetRevisionTimes(enabled bool)
+
 	// Commit commits outstanding txns into the underlying backend.
 	Commit()
 
@@ -128,6 +144,23 @@ type KV interface {
 type WatchableKV interface {
 	KV
 	Watchable
+
+	// WatcherStats returns the number of watchers in each sync state.
+	WatcherStats() WatcherStats
+
+	// SetEventHistorySize keeps the latest size events in memory for
+	// watchers catching up on past revisions. 0 disables it.
+	SetEventHistorySize(size int)
+}
+
+// WatcherStats reports how many watchers a WatchableKV is tracking.
+type WatcherStats struct {
+	// Synced is the number of watchers caught up with the store.
+	Synced int `json:"synced"`
+	// Unsynced is the number of watchers still catching up on past events.
+	Unsynced int `json:"unsynced"`
+	// Victims is the number of watchers blocked on a full watch channel.
+	Victims int `json:"victims"`
 }
 
 // Watchable is the interface that wraps the NewWatchStream function.
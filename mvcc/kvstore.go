@@ -76,6 +76,17 @@ type store struct {
 	// through atomics so must be 64-bit aligned.
 	consistentIndex uint64
 
+	// compactionPauseLatency is the txn duration, in nanoseconds, above
+	// which compaction yields to txns. Accessed through atomics.
+	compactionPauseLatency int64
+	// lastSlowTxnStart is the start time, in Unix nanoseconds, of the
+	// latest txn that took longer than compactionPauseLatency. Accessed
+	// through atomics.
+	lastSlowTxnStart int64
+	// revTimesFrom is the first revision with a recorded commit time, or
+	// 0 if commit times are not recorded. Accessed through atomics.
+	revTimesFrom int64
+
 	// mu read locks for txns and write locks for non-txn store changes.
 	mu sync.RWMutex
 
@@ -86,7 +97,8 @@ type store struct {
 
 	le lease.Lessor
 
-	// revMuLock protects currentRev and compactMainRev.
+	// revMuLock protects currentRev, compactMainRev and the compaction
+	// progress.
 	// Locked at end of write txn and released after write txn unlock lock.
 	// Locked before locking read txn and released after locking.
 	revMu sync.RWMutex
@@ -94,11 +106,20 @@ type store struct {
 	currentRev int64
 	// compactMainRev is the main revision of the last compaction.
 	compactMainRev int64
+	// finishedCompactRev is the main revision of the last compaction
+	// whose superseded keys were all deleted from the backend.
+	finishedCompactRev int64
+	// compactProgressRev is the main revision up to which the running
+	// compaction has deleted superseded keys from the backend.
+	compactProgressRev int64
 
 	// bytesBuf8 is a byte slice of length 8
 	// to avoid a repetitive allocation in saveIndex.
 	bytesBuf8 []byte
 
+	// now returns the time write txns record their revision committed at.
+	now func() time.Time
+
 	fifoSched schedule.Scheduler
 
 	stopc chan struct{}
@@ -116,10 +137,12 @@ func NewStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig ConsistentI
 
 		le: le,
 
-		currentRev:     1,
-		compactMainRev: -1,
+		currentRev:         1,
+		compactMainRev:     -1,
+		finishedCompactRev: -1,
 
 		bytesBuf8: make([]byte, 8),
+		now:       time.Now,
 		fifoSched: schedule.NewFIFOScheduler(),
 
 		stopc: make(chan struct{}),
@@ -254,7 +277,9 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 	tx.UnsafePut(metaBucketName, scheduledCompactKeyName, rbytes)
 	tx.Unlock()
 	// ensure that desired compaction is persisted
+	// gofail: var compactBeforeCommitScheduledCompact struct{}
 	s.b.ForceCommit()
+	// gofail: var compactAfterCommitScheduledCompact struct{}
 
 	s.mu.Unlock()
 	s.revMu.Unlock()
@@ -278,6 +303,57 @@ func (s *store) Compact(rev int64) (<-chan struct{}, error) {
 	return ch, nil
 }
 
+// CompactionStatus reports the progress of deleting superseded keys from
+// the backend after a compaction. Deletion runs in the background in
+// batches; a compaction interrupted by a restart is resumed on recovery.
+type CompactionStatus struct {
+	// Scheduled is the revision of the last requested compaction.
+	Scheduled int64 `json:"scheduled"`
+	// Finished is the revision of the last compaction whose superseded
+	// keys were all deleted.
+	Finished int64 `json:"finished"`
+	// Progress is the revision up to which superseded keys were deleted
+	// by the compaction in progress. It equals Finished when no
+	// compaction is running.
+	Progress int64 `json:"progress"`
+}
+
+func (s *store) CompactionStatus() CompactionStatus {
+	s.revMu.RLock()
+	defer s.revMu.RUnlock()
+	return CompactionStatus{Scheduled: s.compactMainRev, Finished: s.finishedCompactRev, Progress: s.compactProgressRev}
+}
+
+// SetCompactionPauseLatency makes compaction yield between batches while
+// txns take longer than d to complete. 0 never yields.
+func (s *store) SetCompactionPauseLatency(d time.Duration) {
+	atomic.StoreInt64(&s.compactionPauseLatency, int64(d))
+}
+
+// observeTxn records a txn that started at start and just ended, so that
+// compaction can yield while txns are slow.
+func (s *store) observeTxn(start time.Time) {
+	d := atomic.LoadInt64(&s.compactionPauseLatency)
+	if d > 0 && int64(time.Since(start)) > d {
+		atomic.StoreInt64(&s.lastSlowTxnStart, start.UnixNano())
+	}
+}
+
+// slowTxnSince reports whether a txn that started after t was slow.
+func (s *store) slowTxnSince(t time.Time) bool {
+	return atomic.LoadInt64(&s.lastSlowTxnStart) > t.UnixNano()
+}
+
+func (s *store) setCompactionProgress(rev int64, finished bool) {
+	s.revMu.Lock()
+	s.compactProgressRev = rev
+	if finished {
+		s.finishedCompactRev = rev
+	}
+	s.revMu.Unlock()
+	dbCompactionProgressRev.Set(float64(rev))
+}
+
 // DefaultIgnores is a map of keys to ignore in hash checking.
 var DefaultIgnores map[backend.IgnoreKey]struct{}
 
@@ -286,6 +362,8 @@ func init() {
 		// consistent index might be changed due to v2 internal sync, which
 		// is not controllable by the user.
 		{Bucket: string(metaBucketName), Key: string(consistentIndexKeyName)}: {},
+		// revision times are when each member committed the revision.
+		{Bucket: string(revTimeBucketName)}: {},
 	}
 }
 
@@ -312,10 +390,18 @@ func (s *store) Restore(b backend.Backend) error {
 	s.kvindex = newTreeIndex(s.lg)
 	s.currentRev = 1
 	s.compactMainRev = -1
+	s.finishedCompactRev = -1
+	s.compactProgressRev = 0
 	s.fifoSched = schedule.NewFIFOScheduler()
 	s.stopc = make(chan struct{})
 
-	return s.restore()
+	if err := s.restore(); err != nil {
+		return err
+	}
+	if s.recordsRevTimes() {
+		s.startRevTimes()
+	}
+	return nil
 }
 
 func (s *store) restore() error {
@@ -340,6 +426,8 @@ func (s *store) restore() error {
 	_, finishedCompactBytes := tx.UnsafeRange(metaBucketName, finishedCompactKeyName, nil, 0)
 	if len(finishedCompactBytes) != 0 {
 		s.compactMainRev = bytesToRev(finishedCompactBytes[0]).main
+		s.finishedCompactRev = s.compactMainRev
+		s.compactProgressRev = s.compactMainRev
 
 		if s.lg != nil {
 			s.lg.Info(
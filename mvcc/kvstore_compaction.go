@@ -21,12 +21,20 @@ import (
 	"go.uber.org/zap"
 )
 
+// compactionMaxYield bounds how long compaction yields to slow txns
+// between two batches, so that it always makes progress.
+const compactionMaxYield = 5 * time.Second
+
 func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struct{}) bool {
 	totalStart := time.Now()
 	defer dbCompactionTotalMs.Observe(float64(time.Since(totalStart) / time.Millisecond))
 	keyCompactions := 0
 	defer func() { dbCompactionKeysCounter.Add(float64(keyCompactions)) }()
 
+	if s.recordsRevTimes() {
+		s.deleteRevTimes(compactMainRev)
+	}
+
 	end := make([]byte, 8)
 	binary.BigEndian.PutUint64(end, uint64(compactMainRev+1))
 
@@ -51,8 +59,11 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 		if len(keys) < int(batchsize) {
 			rbytes := make([]byte, 8+1+8)
 			revToBytes(revision{main: compactMainRev}, rbytes)
+			// gofail: var compactBeforeSetFinishedCompact struct{}
 			tx.UnsafePut(metaBucketName, finishedCompactKeyName, rbytes)
 			tx.Unlock()
+			// gofail: var compactAfterSetFinishedCompact struct{}
+			s.setCompactionProgress(compactMainRev, true)
 			if s.lg != nil {
 				s.lg.Info(
 					"finished scheduled compaction",
@@ -68,12 +79,25 @@ func (s *store) scheduleCompaction(compactMainRev int64, keep map[revision]struc
 		// update last
 		revToBytes(revision{main: rev.main, sub: rev.sub + 1}, last)
 		tx.Unlock()
+		// gofail: var compactAfterBatch struct{}
+		s.setCompactionProgress(rev.main, false)
 		dbCompactionPauseMs.Observe(float64(time.Since(start) / time.Millisecond))
 
-		select {
-		case <-time.After(100 * time.Millisecond):
-		case <-s.stopc:
-			return false
+		// yield to txns that are slow for reasons other than this batch;
+		// txns that waited on the batch started before it was unlocked.
+		unlocked := time.Now()
+		deadline := unlocked.Add(compactionMaxYield)
+		for {
+			select {
+			case <-time.After(100 * time.Millisecond):
+			case <-s.stopc:
+				return false
+			}
+			if !s.slowTxnSince(unlocked) || time.Now().After(deadline) {
+				break
+			}
+			dbCompactionYieldsCounter.Inc()
+			unlocked = time.Now()
 		}
 	}
 }
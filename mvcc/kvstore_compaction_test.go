@@ -77,6 +77,9 @@ func TestScheduleCompaction(t *testing.T) {
 		tx.Unlock()
 
 		s.scheduleCompaction(tt.rev, tt.keep)
+		if cs := s.CompactionStatus(); cs.Finished != tt.rev || cs.Progress != tt.rev {
+			t.Errorf("#%d: compaction status = %+v, want finished and progress %d", i, cs, tt.rev)
+		}
 
 		tx.Lock()
 		for _, rev := range tt.wrevs {
@@ -129,8 +132,39 @@ func TestCompactAllAndRestore(t *testing.T) {
 	if s1.Rev() != rev {
 		t.Errorf("rev = %v, want %v", s1.Rev(), rev)
 	}
+	wcs := CompactionStatus{Scheduled: rev, Finished: rev, Progress: rev}
+	if cs := s1.CompactionStatus(); cs != wcs {
+		t.Errorf("compaction status = %+v, want %+v", cs, wcs)
+	}
 	_, err = s1.Range([]byte("foo"), nil, RangeOptions{})
 	if err != nil {
 		t.Errorf("unexpect range error %v", err)
 	}
 }
+
+func TestCompactionPauseLatency(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer os.Remove(tmpPath)
+	defer s.Close()
+
+	since := time.Now()
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	if s.slowTxnSince(since) {
+		t.Fatal("txn is slow without a pause latency")
+	}
+
+	s.SetCompactionPauseLatency(time.Nanosecond)
+	s.Put([]byte("foo"), []byte("bar1"), lease.NoLease)
+	if !s.slowTxnSince(since) {
+		t.Fatal("write txn is not slow with a pause latency of 1ns")
+	}
+	since = time.Now()
+	s.Range([]byte("foo"), nil, RangeOptions{})
+	if !s.slowTxnSince(since) {
+		t.Fatal("read txn is not slow with a pause latency of 1ns")
+	}
+	if s.slowTxnSince(time.Now()) {
+		t.Fatal("txn started in the future is slow")
+	}
+}
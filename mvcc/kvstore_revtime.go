@@ -0,0 +1,187 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"sort"
+	"sync/atomic"
+	"time"
+
+	"github.com/coreos/etcd/mvcc/backend"
+)
+
+// revTimeBucketName holds the time each revision was committed at on this
+// member, keyed by main revision. The times are local to the member, so
+// the bucket is left out of the backend hash.
+var revTimeBucketName = []byte("revtime")
+
+var (
+	// ErrRevisionTimeUnknown is returned for times before the first
+	// revision with a recorded commit time, and for revisions without one.
+	ErrRevisionTimeUnknown = errors.New("mvcc: no revision committed time recorded at or before the given time")
+	// ErrRevisionTimesDisabled is returned by revision time lookups while
+	// commit times are not recorded.
+	ErrRevisionTimesDisabled = errors.New("mvcc: revision commit times are not recorded")
+)
+
+// revTimeDeleteBatch is the number of recorded times deleted per batch.
+const revTimeDeleteBatch = 10000
+
+func revTimeKey(rev int64) []byte {
+	k := make([]byte, 8)
+	binary.BigEndian.PutUint64(k, uint64(rev))
+	return k
+}
+
+func saveRevTime(tx backend.BatchTx, rev int64, t time.Time) {
+	v := make([]byte, 8)
+	binary.BigEndian.PutUint64(v, uint64(t.UnixNano()))
+	tx.UnsafePut(revTimeBucketName, revTimeKey(rev), v)
+}
+
+// unsafeRevTime returns the recorded commit time of rev, in Unix
+// nanoseconds.
+func unsafeRevTime(tx backend.ReadTx, rev int64) (int64, bool) {
+	_, vs := tx.UnsafeRange(revTimeBucketName, revTimeKey(rev), nil, 0)
+	if len(vs) == 0 {
+		return 0, false
+	}
+	return int64(binary.BigEndian.Uint64(vs[0])), true
+}
+
+func (s *store) SetRevisionTimes(enabled bool) {
+	if !enabled {
+		atomic.StoreInt64(&s.revTimesFrom, 0)
+		return
+	}
+	s.startRevTimes()
+}
+
+func (s *store) recordsRevTimes() bool { return atomic.LoadInt64(&s.revTimesFrom) > 0 }
+
+// startRevTimes starts recording commit times. Lookups rely on every
+// revision from the first recorded one to the current one having a time,
+// so times left from before recording was last turned off are dropped.
+func (s *store) startRevTimes() {
+	tx := s.b.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+	tx.UnsafeCreateBucket(revTimeBucketName)
+
+	// write txns hold the batch tx lock until they update currentRev
+	s.revMu.RLock()
+	cur := s.currentRev
+	s.revMu.RUnlock()
+
+	from := cur + 1
+	if _, ok := unsafeRevTime(tx, cur); ok {
+		keys, _ := tx.UnsafeRange(revTimeBucketName, revTimeKey(0), revTimeKey(cur), 1)
+		from = cur
+		if len(keys) > 0 {
+			from = int64(binary.BigEndian.Uint64(keys[0]))
+		}
+	} else {
+		for n := revTimeDeleteBatch; n == revTimeDeleteBatch; {
+			n = unsafeDeleteRevTimes(tx, math.MaxInt64)
+		}
+	}
+	atomic.StoreInt64(&s.revTimesFrom, from)
+}
+
+// RevisionAt returns the latest revision committed at or before t. The
+// keyspace as of t can then be read with RangeOptions.Rev.
+func (s *store) RevisionAt(t time.Time) (int64, error) {
+	lo := atomic.LoadInt64(&s.revTimesFrom)
+	if lo == 0 {
+		return 0, ErrRevisionTimesDisabled
+	}
+	s.revMu.RLock()
+	compactRev, cur := s.compactMainRev, s.currentRev
+	s.revMu.RUnlock()
+	if lo < compactRev {
+		lo = compactRev
+	}
+
+	tx := s.b.ReadTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	// every revision from lo to cur has a recorded time, and times only
+	// go forward; find the first revision committed after t.
+	i := sort.Search(int(cur-lo+1), func(i int) bool {
+		ns, ok := unsafeRevTime(tx, lo+int64(i))
+		return !ok || ns > t.UnixNano()
+	})
+	if i == 0 {
+		return 0, ErrRevisionTimeUnknown
+	}
+	return lo + int64(i) - 1, nil
+}
+
+// RevisionTime returns the time rev was committed at.
+func (s *store) RevisionTime(rev int64) (time.Time, error) {
+	from := atomic.LoadInt64(&s.revTimesFrom)
+	if from == 0 {
+		return time.Time{}, ErrRevisionTimesDisabled
+	}
+	s.revMu.RLock()
+	compactRev, cur := s.compactMainRev, s.currentRev
+	s.revMu.RUnlock()
+	if rev > cur {
+		return time.Time{}, ErrFutureRev
+	}
+	if rev < compactRev {
+		return time.Time{}, ErrCompacted
+	}
+	if rev < from {
+		return time.Time{}, ErrRevisionTimeUnknown
+	}
+
+	tx := s.b.ReadTx()
+	tx.Lock()
+	ns, ok := unsafeRevTime(tx, rev)
+	tx.Unlock()
+	if !ok {
+		return time.Time{}, ErrRevisionTimeUnknown
+	}
+	return time.Unix(0, ns), nil
+}
+
+// deleteRevTimes deletes the recorded times of the revisions before
+// compactMainRev, in batches.
+func (s *store) deleteRevTimes(compactMainRev int64) {
+	for {
+		tx := s.b.BatchTx()
+		tx.Lock()
+		n := unsafeDeleteRevTimes(tx, compactMainRev)
+		tx.Unlock()
+		if n < revTimeDeleteBatch {
+			return
+		}
+	}
+}
+
+// unsafeDeleteRevTimes deletes up to revTimeDeleteBatch recorded times of
+// the revisions before rev, returning how many it deleted.
+func unsafeDeleteRevTimes(tx backend.BatchTx, rev int64) int {
+	keys, _ := tx.UnsafeRange(revTimeBucketName, revTimeKey(0), revTimeKey(rev), revTimeDeleteBatch)
+	for _, k := range keys {
+		tx.UnsafeDelete(revTimeBucketName, k)
+	}
+	return len(keys)
+}
@@ -0,0 +1,207 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc/backend"
+	"go.uber.org/zap"
+)
+
+func TestStoreRevisionAt(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer cleanup(s, b, tmpPath)
+	s.SetRevisionTimes(true)
+
+	// times[i] is between the commits of revisions i+1 and i+2
+	var times []time.Time
+	for i := 0; i < 3; i++ {
+		times = append(times, time.Now())
+		time.Sleep(time.Millisecond)
+		s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+		time.Sleep(time.Millisecond)
+	}
+	times = append(times, time.Now())
+
+	if _, err := s.RevisionAt(times[0]); err != ErrRevisionTimeUnknown {
+		t.Fatalf("err = %v, want %v", err, ErrRevisionTimeUnknown)
+	}
+	for i := 1; i < len(times); i++ {
+		rev, err := s.RevisionAt(times[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rev != int64(i+1) {
+			t.Errorf("#%d: rev = %d, want %d", i, rev, i+1)
+		}
+	}
+
+	// the times of compacted revisions are deleted
+	done, err := s.Compact(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	if _, err := s.RevisionAt(times[1]); err != ErrRevisionTimeUnknown {
+		t.Fatalf("err = %v, want %v", err, ErrRevisionTimeUnknown)
+	}
+	if rev, err := s.RevisionAt(times[2]); err != nil || rev != 3 {
+		t.Fatalf("rev, err = %d, %v, want 3, nil", rev, err)
+	}
+	tx := s.b.BatchTx()
+	tx.Lock()
+	keys, _ := tx.UnsafeRange(revTimeBucketName, revTimeKey(0), revTimeKey(3), 0)
+	tx.Unlock()
+	if len(keys) != 0 {
+		t.Errorf("got %d times of compacted revisions, want 0", len(keys))
+	}
+}
+
+func TestStoreRevisionTime(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer cleanup(s, b, tmpPath)
+	s.SetRevisionTimes(true)
+
+	before := time.Now()
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+	after := time.Now()
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+
+	tm, err := s.RevisionTime(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tm.Before(before) || tm.After(after) {
+		t.Fatalf("time = %v, want between %v and %v", tm, before, after)
+	}
+	if rev, err := s.RevisionAt(tm); err != nil || rev != 2 {
+		t.Fatalf("rev, err = %d, %v, want 2, nil", rev, err)
+	}
+
+	// the initial revision has no recorded time
+	if _, err := s.RevisionTime(1); err != ErrRevisionTimeUnknown {
+		t.Fatalf("err = %v, want %v", err, ErrRevisionTimeUnknown)
+	}
+	if _, err := s.RevisionTime(4); err != ErrFutureRev {
+		t.Fatalf("err = %v, want %v", err, ErrFutureRev)
+	}
+	done, err := s.Compact(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-done
+	if _, err := s.RevisionTime(2); err != ErrCompacted {
+		t.Fatalf("err = %v, want %v", err, ErrCompacted)
+	}
+}
+
+// TestStoreRevisionTimeBatchTxLocked ensures lookups do not wait for the
+// batch tx lock held by writes.
+func TestStoreRevisionTimeBatchTxLocked(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer cleanup(s, b, tmpPath)
+	s.SetRevisionTimes(true)
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+
+	tx := s.b.BatchTx()
+	tx.Lock()
+	defer tx.Unlock()
+
+	donec := make(chan error, 1)
+	go func() {
+		tm, err := s.RevisionTime(2)
+		if err == nil {
+			_, err = s.RevisionAt(tm)
+		}
+		donec <- err
+	}()
+	select {
+	case err := <-donec:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("revision time lookup blocked on the batch tx lock")
+	}
+}
+
+func TestStoreRevisionTimesDisabled(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer func() { cleanup(s, b, tmpPath) }()
+
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease) // rev 2
+	if _, err := s.RevisionAt(time.Now()); err != ErrRevisionTimesDisabled {
+		t.Fatalf("err = %v, want %v", err, ErrRevisionTimesDisabled)
+	}
+	if _, err := s.RevisionTime(2); err != ErrRevisionTimesDisabled {
+		t.Fatalf("err = %v, want %v", err, ErrRevisionTimesDisabled)
+	}
+
+	// times start with the first revision written after enabling
+	s.SetRevisionTimes(true)
+	if _, err := s.RevisionAt(time.Now()); err != ErrRevisionTimeUnknown {
+		t.Fatalf("err = %v, want %v", err, ErrRevisionTimeUnknown)
+	}
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease) // rev 3
+	if rev, err := s.RevisionAt(time.Now()); err != nil || rev != 3 {
+		t.Fatalf("rev, err = %d, %v, want 3, nil", rev, err)
+	}
+
+	// times from before a gap in recording are dropped
+	s.SetRevisionTimes(false)
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease) // rev 4
+	s.SetRevisionTimes(true)
+	if _, err := s.RevisionTime(3); err != ErrRevisionTimeUnknown {
+		t.Fatalf("err = %v, want %v", err, ErrRevisionTimeUnknown)
+	}
+	s.Put([]byte("foo"), []byte("bar"), lease.NoLease) // rev 5
+	if rev, err := s.RevisionAt(time.Now()); err != nil || rev != 5 {
+		t.Fatalf("rev, err = %d, %v, want 5, nil", rev, err)
+	}
+
+	// recorded times survive a restart
+	s.Close()
+	s = NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	s.SetRevisionTimes(true)
+	if _, err := s.RevisionTime(5); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestStoreHashIgnoresRevisionTimes(t *testing.T) {
+	var hashes []uint32
+	for _, enabled := range []bool{false, true} {
+		b, tmpPath := backend.NewDefaultTmpBackend()
+		s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+		s.SetRevisionTimes(enabled)
+		s.Put([]byte("foo"), []byte("bar"), lease.NoLease)
+		h, _, err := s.Hash()
+		cleanup(s, b, tmpPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		hashes = append(hashes, h)
+	}
+	if hashes[0] != hashes[1] {
+		t.Fatalf("hash with revision times %d, want %d", hashes[1], hashes[0])
+	}
+}
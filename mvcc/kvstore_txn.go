@@ -15,6 +15,8 @@
 package mvcc
 
 import (
+	"time"
+
 	"github.com/coreos/etcd/lease"
 	"github.com/coreos/etcd/mvcc/backend"
 	"github.com/coreos/etcd/mvcc/mvccpb"
@@ -27,16 +29,19 @@ type storeTxnRead struct {
 
 	firstRev int64
 	rev      int64
+	// start is when the txn was requested, before waiting on locks.
+	start time.Time
 }
 
 func (s *store) Read() TxnRead {
+	start := time.Now()
 	s.mu.RLock()
 	tx := s.b.ReadTx()
 	s.revMu.RLock()
 	tx.Lock()
 	firstRev, rev := s.compactMainRev, s.currentRev
 	s.revMu.RUnlock()
-	return newMetricsTxnRead(&storeTxnRead{s, tx, firstRev, rev})
+	return newMetricsTxnRead(&storeTxnRead{s, tx, firstRev, rev, start})
 }
 
 func (tr *storeTxnRead) FirstRev() int64 { return tr.firstRev }
@@ -49,6 +54,7 @@ func (tr *storeTxnRead) Range(key, end []byte, ro RangeOptions) (r *RangeResult,
 func (tr *storeTxnRead) End() {
 	tr.tx.Unlock()
 	tr.s.mu.RUnlock()
+	tr.s.observeTxn(tr.start)
 }
 
 type storeTxnWrite struct {
@@ -60,11 +66,12 @@ type storeTxnWrite struct {
 }
 
 func (s *store) Write() TxnWrite {
+	start := time.Now()
 	s.mu.RLock()
 	tx := s.b.BatchTx()
 	tx.Lock()
 	tw := &storeTxnWrite{
-		storeTxnRead: storeTxnRead{s, tx, 0, 0},
+		storeTxnRead: storeTxnRead{s, tx, 0, 0, start},
 		tx:           tx,
 		beginRev:     s.currentRev,
 		changes:      make([]mvccpb.KeyValue, 0, 4),
@@ -97,6 +104,10 @@ func (tw *storeTxnWrite) Put(key, value []byte, lease lease.LeaseID) int64 {
 func (tw *storeTxnWrite) End() {
 	// only update index if the txn modifies the mvcc state.
 	if len(tw.changes) != 0 {
+		if tw.s.recordsRevTimes() {
+			saveRevTime(tw.tx, tw.beginRev+1, tw.s.now())
+		}
+		// gofail: var mvccBeforeSaveIndex struct{}
 		tw.s.saveIndex(tw.tx)
 		// hold revMu lock to prevent new read txns from opening until writeback.
 		tw.s.revMu.Lock()
@@ -107,6 +118,7 @@ func (tw *storeTxnWrite) End() {
 		tw.s.revMu.Unlock()
 	}
 	tw.s.mu.RUnlock()
+	tw.s.observeTxn(tw.start)
 }
 
 func (tr *storeTxnRead) rangeKeys(key, end []byte, curRev int64, ro RangeOptions) (*RangeResult, error) {
@@ -85,6 +85,22 @@ var (
 			Help:      "Total number of unsynced slow watchers.",
 		})
 
+	watchEventHistoryHitsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "watch_event_history_hits_total",
+			Help:      "Total number of unsynced watcher syncs served from the in-memory event history.",
+		})
+
+	watchEventHistoryMissesCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "watch_event_history_misses_total",
+			Help:      "Total number of unsynced watcher syncs that read the backend because the events were older than the in-memory event history.",
+		})
+
 	totalEventsCounter = prometheus.NewCounter(
 		prometheus.CounterOpts{
 			Namespace: "etcd_debugging",
@@ -101,6 +117,41 @@ var (
 			Help:      "Total number of pending events to be sent.",
 		})
 
+	prefixRevisionsCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "prefix_revisions_total",
+			Help:      "Total number of key revisions written by puts and deletes, by metrics key prefix.",
+		},
+		[]string{"prefix"})
+
+	putValueSizeBytes = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "put_value_size_bytes",
+			Help:      "Bucketed histogram of the value sizes of puts, by metrics key prefix.",
+
+			// lowest bucket start of upper bound 64 bytes with factor 4
+			// highest bucket start of 64 bytes * 4^8 == 4 MiB
+			Buckets: prometheus.ExponentialBuckets(64, 4, 9),
+		},
+		[]string{"prefix"})
+
+	watchEventSizeBytes = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "watch_event_size_bytes",
+			Help:      "Bucketed histogram of the encoded sizes of watch events sent to watchers, by metrics key prefix.",
+
+			// lowest bucket start of upper bound 64 bytes with factor 4
+			// highest bucket start of 64 bytes * 4^8 == 4 MiB
+			Buckets: prometheus.ExponentialBuckets(64, 4, 9),
+		},
+		[]string{"prefix"})
+
 	indexCompactionPauseMs = prometheus.NewHistogram(
 		prometheus.HistogramOpts{
 			Namespace: "etcd_debugging",
@@ -145,6 +196,22 @@ var (
 			Help:      "Total number of db keys compacted.",
 		})
 
+	dbCompactionProgressRev = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "db_compaction_progress_revision",
+			Help:      "The revision up to which the running db compaction has deleted superseded keys.",
+		})
+
+	dbCompactionYieldsCounter = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "etcd_debugging",
+			Subsystem: "mvcc",
+			Name:      "db_compaction_yields_total",
+			Help:      "Total number of times db compaction waited longer between batches for slow txns.",
+		})
+
 	dbTotalSize = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
 		Namespace: "etcd_debugging",
 		Subsystem: "mvcc",
@@ -211,16 +278,23 @@ func init() {
 	prometheus.MustRegister(watchStreamGauge)
 	prometheus.MustRegister(watcherGauge)
 	prometheus.MustRegister(slowWatcherGauge)
+	prometheus.MustRegister(watchEventHistoryHitsCounter)
+	prometheus.MustRegister(watchEventHistoryMissesCounter)
 	prometheus.MustRegister(totalEventsCounter)
 	prometheus.MustRegister(pendingEventsGauge)
 	prometheus.MustRegister(indexCompactionPauseMs)
 	prometheus.MustRegister(dbCompactionPauseMs)
 	prometheus.MustRegister(dbCompactionTotalMs)
 	prometheus.MustRegister(dbCompactionKeysCounter)
+	prometheus.MustRegister(dbCompactionProgressRev)
+	prometheus.MustRegister(dbCompactionYieldsCounter)
 	prometheus.MustRegister(dbTotalSize)
 	prometheus.MustRegister(dbTotalSizeInUse)
 	prometheus.MustRegister(hashSec)
 	prometheus.MustRegister(hashRevSec)
+	prometheus.MustRegister(prefixRevisionsCounter)
+	prometheus.MustRegister(putValueSizeBytes)
+	prometheus.MustRegister(watchEventSizeBytes)
 }
 
 // ReportEventReceived reports that an event is received.
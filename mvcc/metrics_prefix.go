@@ -0,0 +1,72 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"bytes"
+	"sync/atomic"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+// MaxMetricsKeyPrefixes is the most key prefixes metrics can be reported
+// by, since each prefix adds a series to every per-prefix metric.
+const MaxMetricsKeyPrefixes = 16
+
+// metricsKeyPrefixes holds the []string of key prefixes metrics are
+// reported by.
+var metricsKeyPrefixes atomic.Value
+
+// SetMetricsKeyPrefixes sets the key prefixes that the revisions written,
+// put value sizes and watch event sizes are reported by. Sizes of keys
+// under none of them are reported under the empty prefix; their revisions
+// are not counted.
+func SetMetricsKeyPrefixes(prefixes []string) {
+	metricsKeyPrefixes.Store(append([]string(nil), prefixes...))
+}
+
+// metricsKeyPrefix returns the longest metrics key prefix of key.
+func metricsKeyPrefix(key []byte) (prefix string, ok bool) {
+	prefixes, _ := metricsKeyPrefixes.Load().([]string)
+	for _, p := range prefixes {
+		if len(p) > len(prefix) && bytes.HasPrefix(key, []byte(p)) {
+			prefix, ok = p, true
+		}
+	}
+	return prefix, ok
+}
+
+// ReportWatchEventSizes records the encoded sizes of watch events sent to a
+// watcher, by metrics key prefix.
+func ReportWatchEventSizes(evs []*mvccpb.Event) {
+	for _, ev := range evs {
+		p, _ := metricsKeyPrefix(ev.Kv.Key)
+		watchEventSizeBytes.WithLabelValues(p).Observe(float64(ev.Size()))
+	}
+}
+
+// reportPrefixRevisions counts the key revisions of a write txn by
+// metrics key prefix.
+func reportPrefixRevisions(changes []mvccpb.KeyValue) {
+	prefixes, _ := metricsKeyPrefixes.Load().([]string)
+	if len(prefixes) == 0 {
+		return
+	}
+	for i := range changes {
+		if p, ok := metricsKeyPrefix(changes[i].Key); ok {
+			prefixRevisionsCounter.WithLabelValues(p).Inc()
+		}
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"testing"
+
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc/backend"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"go.uber.org/zap"
+)
+
+func TestPrefixRevisions(t *testing.T) {
+	SetMetricsKeyPrefixes([]string{"pr/", "pr/b/"})
+	defer SetMetricsKeyPrefixes(nil)
+
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer cleanup(s, b, tmpPath)
+
+	s.Put([]byte("pr/a"), []byte("1"), lease.NoLease)
+	s.Put([]byte("pr/b/c"), []byte("1"), lease.NoLease)
+	s.Put([]byte("pr/b/d"), []byte("1"), lease.NoLease)
+	s.Put([]byte("other"), []byte("1"), lease.NoLease)
+	// one revision per deleted key
+	s.DeleteRange([]byte("pr/b/"), []byte("pr/b0"))
+
+	tests := []struct {
+		prefix string
+		wn     int
+	}{
+		{"pr/", 1},
+		{"pr/b/", 4},
+	}
+	for i, tt := range tests {
+		if n := readCounterInt(prefixRevisionsCounter.WithLabelValues(tt.prefix)); n != tt.wn {
+			t.Errorf("#%d: %q revisions = %d, want %d", i, tt.prefix, n, tt.wn)
+		}
+	}
+}
+
+func TestPrefixSizes(t *testing.T) {
+	SetMetricsKeyPrefixes([]string{"ps/"})
+	defer SetMetricsKeyPrefixes(nil)
+
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := newWatchableStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer cleanup(s, b, tmpPath)
+
+	s.Put([]byte("ps/a"), make([]byte, 100), lease.NoLease)
+	s.Put([]byte("ps/b"), make([]byte, 200), lease.NoLease)
+
+	if n, sum := readHistogram(putValueSizeBytes.WithLabelValues("ps/")); n != 2 || sum != 300 {
+		t.Errorf("put value sizes: %d samples summing to %d, want 2 summing to 300", n, sum)
+	}
+	// watch events are observed when sent, not when written
+	if n, _ := readHistogram(watchEventSizeBytes.WithLabelValues("ps/")); n != 0 {
+		t.Errorf("watch event sizes: %d samples, want 0", n)
+	}
+
+	ev := &mvccpb.Event{Kv: &mvccpb.KeyValue{Key: []byte("ps/a"), Value: make([]byte, 100)}}
+	ReportWatchEventSizes([]*mvccpb.Event{ev, ev})
+	if n, sum := readHistogram(watchEventSizeBytes.WithLabelValues("ps/")); n != 2 || sum != 2*ev.Size() {
+		t.Errorf("watch event sizes: %d samples summing to %d, want 2 summing to %d", n, sum, 2*ev.Size())
+	}
+}
+
+func readHistogram(o prometheus.Observer) (count, sum int) {
+	ch := make(chan prometheus.Metric, 1)
+	o.(prometheus.Histogram).Collect(ch)
+	m := <-ch
+	mm := &dto.Metric{}
+	m.Write(mm)
+	return int(mm.GetHistogram().GetSampleCount()), int(mm.GetHistogram().GetSampleSum())
+}
+
+func readCounterInt(c prometheus.Counter) int {
+	ch := make(chan prometheus.Metric, 1)
+	c.Collect(ch)
+	m := <-ch
+	mm := &dto.Metric{}
+	m.Write(mm)
+	return int(mm.GetCounter().GetValue())
+}
@@ -43,6 +43,8 @@ func (tw *metricsTxnWrite) DeleteRange(key, end []byte) (n, rev int64) {
 
 func (tw *metricsTxnWrite) Put(key, value []byte, lease lease.LeaseID) (rev int64) {
 	tw.puts++
+	p, _ := metricsKeyPrefix(key)
+	putValueSizeBytes.WithLabelValues(p).Observe(float64(len(value)))
 	return tw.TxnWrite.Put(key, value, lease)
 }
 
@@ -54,4 +56,5 @@ func (tw *metricsTxnWrite) End() {
 	rangeCounter.Add(float64(tw.ranges))
 	putCounter.Add(float64(tw.puts))
 	deleteCounter.Add(float64(tw.deletes))
+	reportPrefixRevisions(tw.Changes())
 }
@@ -60,6 +60,9 @@ type watchableStore struct {
 	// The key of the map is the key that the watcher watches on.
 	synced watcherGroup
 
+	// history holds recent events for unsynced watchers to catch up from.
+	history *eventHistory
+
 	stopc chan struct{}
 	wg    sync.WaitGroup
 }
@@ -78,6 +81,7 @@ func newWatchableStore(lg *zap.Logger, b backend.Backend, le lease.Lessor, ig Co
 		victimc:  make(chan struct{}, 1),
 		unsynced: newWatcherGroup(),
 		synced:   newWatcherGroup(),
+		history:  newEventHistory(0),
 		stopc:    make(chan struct{}),
 	}
 	s.store.ReadView = &readView{s}
@@ -197,6 +201,7 @@ func (s *watchableStore) Restore(b backend.Backend) error {
 		s.unsynced.add(wa)
 	}
 	s.synced = newWatcherGroup()
+	s.history = newEventHistory(len(s.history.ring))
 	return nil
 }
 
@@ -339,23 +344,17 @@ func (s *watchableStore) syncWatchers() int {
 	compactionRev := s.store.compactMainRev
 
 	wg, minRev := s.unsynced.choose(maxWatchersPerSync, curRev, compactionRev)
-	minBytes, maxBytes := newRevBytes(), newRevBytes()
-	revToBytes(revision{main: minRev}, minBytes)
-	revToBytes(revision{main: curRev + 1}, maxBytes)
-
-	// UnsafeRange returns keys and values. And in boltdb, keys are revisions.
-	// values are actual key-value pairs in backend.
-	tx := s.store.b.ReadTx()
-	tx.Lock()
-	revs, vs := tx.UnsafeRange(keyBucketName, minBytes, maxBytes, 0)
-	var evs []mvccpb.Event
-	if s.store != nil && s.store.lg != nil {
-		evs = kvsToEvents(s.store.lg, wg, revs, vs)
-	} else {
-		// TODO: remove this in v3.5
-		evs = kvsToEvents(nil, wg, revs, vs)
+	evs, ok := s.history.since(minRev, wg)
+	if s.history.enabled() {
+		if ok {
+			watchEventHistoryHitsCounter.Inc()
+		} else {
+			watchEventHistoryMissesCounter.Inc()
+		}
+	}
+	if !ok {
+		evs = s.rangeEvents(wg, minRev, curRev)
 	}
-	tx.Unlock()
 
 	var victims watcherBatch
 	wb := newWatcherBatch(wg, evs)
@@ -405,6 +404,26 @@ func (s *watchableStore) syncWatchers() int {
 	return s.unsynced.size()
 }
 
+// rangeEvents reads the events of the keys watched by wg from revision
+// minRev to curRev from the backend.
+func (s *watchableStore) rangeEvents(wg *watcherGroup, minRev, curRev int64) []mvccpb.Event {
+	minBytes, maxBytes := newRevBytes(), newRevBytes()
+	revToBytes(revision{main: minRev}, minBytes)
+	revToBytes(revision{main: curRev + 1}, maxBytes)
+
+	// UnsafeRange returns keys and values. And in boltdb, keys are revisions.
+	// values are actual key-value pairs in backend.
+	tx := s.store.b.ReadTx()
+	tx.Lock()
+	defer tx.Unlock()
+	revs, vs := tx.UnsafeRange(keyBucketName, minBytes, maxBytes, 0)
+	if s.store != nil && s.store.lg != nil {
+		return kvsToEvents(s.store.lg, wg, revs, vs)
+	}
+	// TODO: remove this in v3.5
+	return kvsToEvents(nil, wg, revs, vs)
+}
+
 // kvsToEvents gets all events for the watchers from all key-value pairs
 func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []mvccpb.Event) {
 	for i, v := range vals {
@@ -435,6 +454,7 @@ func kvsToEvents(lg *zap.Logger, wg *watcherGroup, revs, vals [][]byte) (evs []m
 // notify notifies the fact that given event at the given rev just happened to
 // watchers that watch on the key of the event.
 func (s *watchableStore) notify(rev int64, evs []mvccpb.Event) {
+	s.history.add(rev, evs)
 	var victim watcherBatch
 	for w, eb := range newWatcherBatch(&s.synced, evs) {
 		if eb.revs != 1 {
@@ -477,6 +497,16 @@ func (s *watchableStore) addVictim(victim watcherBatch) {
 
 func (s *watchableStore) rev() int64 { return s.store.Rev() }
 
+func (s *watchableStore) WatcherStats() WatcherStats {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	ws := WatcherStats{Synced: s.synced.size(), Unsynced: s.unsynced.size()}
+	for _, wb := range s.victims {
+		ws.Victims += len(wb)
+	}
+	return ws
+}
+
 func (s *watchableStore) progress(w *watcher) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
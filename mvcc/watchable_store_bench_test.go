@@ -134,7 +134,8 @@ func BenchmarkWatchableStoreUnsyncedCancel(b *testing.B) {
 
 		// to make the test not crash from assigning to nil map.
 		// 'synced' doesn't get populated in this test.
-		synced: newWatcherGroup(),
+		synced:  newWatcherGroup(),
+		history: newEventHistory(0),
 	}
 
 	defer func() {
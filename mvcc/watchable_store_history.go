@@ -0,0 +1,90 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"math"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+)
+
+// eventHistory keeps the most recent events in memory, so that unsynced
+// watchers starting at a recent revision catch up without reading the
+// backend.
+type eventHistory struct {
+	// ring holds up to len(ring) events from start, oldest first.
+	ring  []mvccpb.Event
+	start int
+	n     int
+	// firstRev is the lowest revision all events of which are held.
+	firstRev int64
+}
+
+func newEventHistory(size int) *eventHistory {
+	return &eventHistory{ring: make([]mvccpb.Event, size), firstRev: math.MaxInt64}
+}
+
+// add records the events of revision rev, evicting the oldest events to
+// make room for them.
+func (h *eventHistory) add(rev int64, evs []mvccpb.Event) {
+	size := len(h.ring)
+	if size == 0 {
+		return
+	}
+	if len(evs) > size {
+		h.start, h.n, h.firstRev = 0, 0, rev+1
+		return
+	}
+	for h.n+len(evs) > size {
+		h.firstRev = h.ring[h.start].Kv.ModRevision + 1
+		h.ring[h.start] = mvccpb.Event{}
+		h.start = (h.start + 1) % size
+		h.n--
+	}
+	if h.firstRev > rev {
+		h.firstRev = rev
+	}
+	for _, ev := range evs {
+		h.ring[(h.start+h.n)%size] = ev
+		h.n++
+	}
+}
+
+// since returns the events from revision minRev on of the keys watched by
+// wg, or false if some of those events are no longer held.
+func (h *eventHistory) since(minRev int64, wg *watcherGroup) ([]mvccpb.Event, bool) {
+	if minRev < h.firstRev {
+		return nil, false
+	}
+	var evs []mvccpb.Event
+	for i := 0; i < h.n; i++ {
+		ev := h.ring[(h.start+i)%len(h.ring)]
+		if ev.Kv.ModRevision >= minRev && wg.contains(string(ev.Kv.Key)) {
+			evs = append(evs, ev)
+		}
+	}
+	return evs, true
+}
+
+// enabled reports whether the history holds any events.
+func (h *eventHistory) enabled() bool { return len(h.ring) > 0 }
+
+// SetEventHistorySize keeps the latest size events in memory for watchers
+// catching up on past revisions. 0 disables it.
+func (s *watchableStore) SetEventHistorySize(size int) {
+	s.mu.Lock()
+	s.history = newEventHistory(size)
+	s.mu.Unlock()
+}
@@ -0,0 +1,114 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mvcc
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc/backend"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"go.uber.org/zap"
+)
+
+func TestEventHistoryEvict(t *testing.T) {
+	ev := func(key string, rev int64) mvccpb.Event {
+		return mvccpb.Event{Kv: &mvccpb.KeyValue{Key: []byte(key), ModRevision: rev}}
+	}
+	wg := newWatcherGroup()
+	wg.add(&watcher{key: []byte("a"), end: []byte("z")})
+
+	h := newEventHistory(2)
+	if _, ok := h.since(2, &wg); ok {
+		t.Fatal("expected miss on empty history")
+	}
+	h.add(2, []mvccpb.Event{ev("a", 2), ev("b", 2)})
+	if evs, ok := h.since(2, &wg); !ok || len(evs) != 2 {
+		t.Fatalf("since(2) = %v, %v, want 2 events", evs, ok)
+	}
+
+	// evicting one event of revision 2 loses the whole revision
+	h.add(3, []mvccpb.Event{ev("c", 3)})
+	if _, ok := h.since(2, &wg); ok {
+		t.Fatal("expected miss on partially evicted revision")
+	}
+	if evs, ok := h.since(3, &wg); !ok || !reflect.DeepEqual(evs, []mvccpb.Event{ev("c", 3)}) {
+		t.Fatalf("since(3) = %v, %v, want [c]", evs, ok)
+	}
+
+	// revisions larger than the history drop everything
+	h.add(4, []mvccpb.Event{ev("d", 4), ev("e", 4), ev("f", 4)})
+	if _, ok := h.since(4, &wg); ok {
+		t.Fatal("expected miss on revision larger than history")
+	}
+	h.add(5, []mvccpb.Event{ev("g", 5)})
+	if evs, ok := h.since(5, &wg); !ok || len(evs) != 1 {
+		t.Fatalf("since(5) = %v, %v, want 1 event", evs, ok)
+	}
+}
+
+// TestWatchEventHistory ensures watchers catching up from memory get the
+// same events as watchers catching up from the backend.
+func TestWatchEventHistory(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := newWatchableStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+	defer func() {
+		s.Close()
+		os.Remove(tmpPath)
+	}()
+	s.SetEventHistorySize(4)
+
+	s.Put([]byte("foo"), []byte("1"), lease.NoLease) // rev 2
+	s.Put([]byte("bar"), []byte("2"), lease.NoLease) // rev 3
+	s.Put([]byte("foo"), []byte("3"), lease.NoLease) // rev 4
+	s.DeleteRange([]byte("foo"), nil)                // rev 5
+	s.Put([]byte("foo"), []byte("5"), lease.NoLease) // rev 6
+
+	s.mu.Lock()
+	wg := newWatcherGroup()
+	wg.add(&watcher{key: []byte("foo")})
+	_, hit := s.history.since(4, &wg)
+	_, miss := s.history.since(2, &wg)
+	s.mu.Unlock()
+	if !hit || miss {
+		t.Fatalf("since(4), since(2) = %v, %v, want true, false", hit, miss)
+	}
+
+	readAll := func(rev int64, n int) []mvccpb.Event {
+		w := s.NewWatchStream()
+		defer w.Close()
+		w.Watch(0, []byte("foo"), nil, rev)
+		var evs []mvccpb.Event
+		for len(evs) < n {
+			select {
+			case resp := <-w.Chan():
+				evs = append(evs, resp.Events...)
+			case <-time.After(5 * time.Second):
+				t.Fatalf("timed out after %d events", len(evs))
+			}
+		}
+		return evs
+	}
+	fromMem, fromBackend := readAll(4, 3), readAll(2, 4)
+	if !reflect.DeepEqual(fromMem, fromBackend[1:]) {
+		t.Fatalf("events from history = %+v, want %+v", fromMem, fromBackend[1:])
+	}
+	if fromMem[1].Type != mvccpb.DELETE || fromMem[1].Kv.ModRevision != 5 {
+		t.Fatalf("unexpected delete event %+v", fromMem[1])
+	}
+}
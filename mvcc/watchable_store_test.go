@@ -76,6 +76,36 @@ func TestNewWatcherCancel(t *testing.T) {
 	}
 }
 
+func TestWatcherStats(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+
+	// manually create watchableStore so that syncWatchersLoop does
+	// not move the unsynced watcher while checking stats.
+	s := &watchableStore{
+		store:    NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil),
+		unsynced: newWatcherGroup(),
+		synced:   newWatcherGroup(),
+		history:  newEventHistory(0),
+	}
+
+	defer func() {
+		s.store.Close()
+		os.Remove(tmpPath)
+	}()
+
+	testKey := []byte("foo")
+	testValue := []byte("bar")
+	s.Put(testKey, testValue, lease.NoLease)
+
+	w := s.NewWatchStream()
+	w.Watch(0, testKey, nil, 0)
+	w.Watch(0, testKey, nil, 1)
+
+	if ws := s.WatcherStats(); ws != (WatcherStats{Synced: 1, Unsynced: 1}) {
+		t.Fatalf("watcher stats = %+v, want synced 1, unsynced 1", ws)
+	}
+}
+
 // TestCancelUnsynced tests if running CancelFunc removes watchers from unsynced.
 func TestCancelUnsynced(t *testing.T) {
 	b, tmpPath := backend.NewDefaultTmpBackend()
@@ -90,7 +120,8 @@ func TestCancelUnsynced(t *testing.T) {
 
 		// to make the test not crash from assigning to nil map.
 		// 'synced' doesn't get populated in this test.
-		synced: newWatcherGroup(),
+		synced:  newWatcherGroup(),
+		history: newEventHistory(0),
 	}
 
 	defer func() {
@@ -143,6 +174,7 @@ func TestSyncWatchers(t *testing.T) {
 		store:    NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil),
 		unsynced: newWatcherGroup(),
 		synced:   newWatcherGroup(),
+		history:  newEventHistory(0),
 	}
 
 	defer func() {
@@ -606,6 +638,84 @@ func TestWatchVictims(t *testing.T) {
 	}
 }
 
+// TestWatchEventOrderPutDelete tests that events for a single key are
+// delivered in revision order, and that puts and deletes from concurrent
+// writers are never reordered, for both synced and unsynced watchers.
+func TestWatchEventOrderPutDelete(t *testing.T) {
+	b, tmpPath := backend.NewDefaultTmpBackend()
+	s := newWatchableStore(zap.NewExample(), b, &lease.FakeLessor{}, nil)
+
+	defer func() {
+		s.store.Close()
+		os.Remove(tmpPath)
+	}()
+
+	testKey, testValue := []byte("foo"), []byte("bar")
+	s.Put(testKey, testValue, lease.NoLease)
+
+	// startRev 0 creates synced watchers; startRev 1 forces the watcher
+	// through the unsynced group, replaying from the backend first.
+	var streams []WatchStream
+	for _, startRev := range []int64{0, 0, 1, 1} {
+		w := s.NewWatchStream()
+		defer w.Close()
+		w.Watch(0, testKey, nil, startRev)
+		streams = append(streams, w)
+	}
+
+	numWriters, numOps := 8, 50
+	var wg sync.WaitGroup
+	wg.Add(numWriters)
+	for i := 0; i < numWriters; i++ {
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < numOps; j++ {
+				if (i+j)%2 == 0 {
+					s.Put(testKey, testValue, lease.NoLease)
+				} else {
+					s.DeleteRange(testKey, nil)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	lastRev := s.rev()
+
+	for i, w := range streams {
+		var (
+			prevRev int64
+			prevEv  *mvccpb.Event
+		)
+		timer := time.After(10 * time.Second)
+		for prevRev < lastRev {
+			var wr WatchResponse
+			select {
+			case wr = <-w.Chan():
+			case <-timer:
+				t.Fatalf("#%d: timed out at revision %d, want %d", i, prevRev, lastRev)
+			}
+			for j := range wr.Events {
+				ev := wr.Events[j]
+				if ev.Kv.ModRevision <= prevRev {
+					t.Fatalf("#%d: event revision %d not after %d", i, ev.Kv.ModRevision, prevRev)
+				}
+				if prevEv != nil {
+					if prevEv.Type == mvccpb.DELETE && ev.Type == mvccpb.DELETE {
+						t.Fatalf("#%d: two deletes in a row at revision %d", i, ev.Kv.ModRevision)
+					}
+					if prevEv.Type == mvccpb.DELETE && ev.Kv.CreateRevision != ev.Kv.ModRevision {
+						t.Fatalf("#%d: put after delete has create revision %d, want %d", i, ev.Kv.CreateRevision, ev.Kv.ModRevision)
+					}
+					if prevEv.Type == mvccpb.PUT && ev.Type == mvccpb.PUT && ev.Kv.Version != prevEv.Kv.Version+1 {
+						t.Fatalf("#%d: version %d after %d", i, ev.Kv.Version, prevEv.Kv.Version)
+					}
+				}
+				prevRev, prevEv = ev.Kv.ModRevision, &ev
+			}
+		}
+	}
+}
+
 // TestStressWatchCancelClose tests closing a watch stream while
 // canceling its watches.
 func TestStressWatchCancelClose(t *testing.T) {
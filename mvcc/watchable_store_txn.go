@@ -38,7 +38,9 @@ func (tw *watchableStoreTxnWrite) End() {
 	// end write txn under watchable store lock so the updates are visible
 	// when asynchronous event posting checks the current store revision
 	tw.s.mu.Lock()
+	// gofail: var mvccBeforeNotify struct{}
 	tw.s.notify(rev, evs)
+	// gofail: var mvccAfterNotify struct{}
 	tw.TxnWrite.End()
 	tw.s.mu.Unlock()
 }
@@ -298,6 +298,7 @@ func TestWatcherRequestProgress(t *testing.T) {
 		store:    NewStore(zap.NewExample(), b, &lease.FakeLessor{}, nil),
 		unsynced: newWatcherGroup(),
 		synced:   newWatcherGroup(),
+		history:  newEventHistory(0),
 	}
 
 	defer func() {
@@ -0,0 +1,391 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package k8sproto renders Kubernetes objects that the Kubernetes API
+// server stores in etcd in their protobuf encoding, for debugging.
+//
+// The Kubernetes API schemas are not available to etcd, so only the
+// envelope (API version and kind) and the object metadata, which all
+// Kubernetes objects share, are decoded by name. Other object fields are
+// keyed by their protobuf field numbers, and the wire type decides how a
+// value is shown.
+package k8sproto
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/gogo/protobuf/proto"
+	"gopkg.in/yaml.v2"
+)
+
+// RegistryPrefix is the default key prefix of the Kubernetes API server.
+const RegistryPrefix = "/registry/"
+
+// maxDepth bounds the nesting of decoded messages.
+const maxDepth = 32
+
+var (
+	// magic prefixes every protobuf encoded Kubernetes object.
+	magic = []byte("k8s\x00")
+
+	ErrNotEncoded = errors.New("k8sproto: value is not a protobuf encoded Kubernetes object")
+	errMalformed  = errors.New("k8sproto: malformed protobuf message")
+)
+
+// IsRegistryKey reports whether key is stored by the Kubernetes API server
+// under the default prefix.
+func IsRegistryKey(key []byte) bool {
+	return bytes.HasPrefix(key, []byte(RegistryPrefix))
+}
+
+// Resource returns the resource type segment of a key under the registry
+// prefix, e.g. "pods" for "/registry/pods/default/nginx". Resources of API
+// groups are stored under the group name, e.g. "apiextensions.k8s.io".
+func Resource(key []byte) (string, bool) {
+	if !IsRegistryKey(key) {
+		return "", false
+	}
+	rest := key[len(RegistryPrefix):]
+	i := bytes.IndexByte(rest, '/')
+	if i <= 0 {
+		return "", false
+	}
+	return string(rest[:i]), true
+}
+
+// IsEncoded reports whether value is a protobuf encoded Kubernetes object.
+func IsEncoded(value []byte) bool {
+	return bytes.HasPrefix(value, magic)
+}
+
+// Decode decodes a protobuf encoded Kubernetes object into an ordered map
+// with its API version, kind and fields.
+func Decode(value []byte) (yaml.MapSlice, error) {
+	if !IsEncoded(value) {
+		return nil, ErrNotEncoded
+	}
+	// the envelope is a runtime.Unknown message
+	fs, err := parse(value[len(magic):])
+	if err != nil {
+		return nil, err
+	}
+	var (
+		apiVersion, kind, encoding, contentType string
+		raw                                     []byte
+	)
+	for _, f := range fs {
+		switch f.num {
+		case 1: // typeMeta
+			tfs, terr := parse(f.bytes)
+			if terr != nil {
+				return nil, terr
+			}
+			for _, tf := range tfs {
+				switch tf.num {
+				case 1:
+					apiVersion = string(tf.bytes)
+				case 2:
+					kind = string(tf.bytes)
+				}
+			}
+		case 2:
+			raw = f.bytes
+		case 3:
+			encoding = string(f.bytes)
+		case 4:
+			contentType = string(f.bytes)
+		}
+	}
+
+	obj := yaml.MapSlice{
+		{Key: "apiVersion", Value: apiVersion},
+		{Key: "kind", Value: kind},
+	}
+	if encoding != "" || contentType != "" {
+		// not a plain protobuf message; show the raw bytes
+		obj = append(obj,
+			yaml.MapItem{Key: "contentEncoding", Value: encoding},
+			yaml.MapItem{Key: "contentType", Value: contentType},
+			yaml.MapItem{Key: "raw", Value: base64.StdEncoding.EncodeToString(raw)},
+		)
+		return obj, nil
+	}
+	fields, err := decodeObject(raw)
+	if err != nil {
+		return nil, err
+	}
+	return append(obj, yaml.MapItem{Key: "fields", Value: fields}), nil
+}
+
+// ToYAML renders a protobuf encoded Kubernetes object as YAML.
+func ToYAML(value []byte) ([]byte, error) {
+	obj, err := Decode(value)
+	if err != nil {
+		return nil, err
+	}
+	return yaml.Marshal(obj)
+}
+
+type field struct {
+	num   int
+	wire  int
+	bytes []byte
+	n     uint64
+}
+
+// parse splits a protobuf message into its fields.
+func parse(b []byte) ([]field, error) {
+	var fs []field
+	for len(b) > 0 {
+		tag, n := proto.DecodeVarint(b)
+		if n == 0 || tag>>3 == 0 {
+			return nil, errMalformed
+		}
+		b = b[n:]
+		f := field{num: int(tag >> 3), wire: int(tag & 7)}
+		switch f.wire {
+		case proto.WireVarint:
+			if f.n, n = proto.DecodeVarint(b); n == 0 {
+				return nil, errMalformed
+			}
+		case proto.WireFixed64:
+			if len(b) < 8 {
+				return nil, errMalformed
+			}
+			n = 8
+			for i := 7; i >= 0; i-- {
+				f.n = f.n<<8 | uint64(b[i])
+			}
+		case proto.WireBytes:
+			l, ln := proto.DecodeVarint(b)
+			if ln == 0 || uint64(len(b)-ln) < l {
+				return nil, errMalformed
+			}
+			f.bytes, n = b[ln:ln+int(l)], ln+int(l)
+		case proto.WireFixed32:
+			if len(b) < 4 {
+				return nil, errMalformed
+			}
+			n = 4
+			for i := 3; i >= 0; i-- {
+				f.n = f.n<<8 | uint64(b[i])
+			}
+		default:
+			// groups are not used by Kubernetes
+			return nil, fmt.Errorf("k8sproto: unsupported wire type %d", f.wire)
+		}
+		b = b[n:]
+		fs = append(fs, f)
+	}
+	return fs, nil
+}
+
+// decodeMessage renders the fields of a message keyed by field number,
+// in the order they first appear. Repeated fields become lists.
+func decodeMessage(b []byte, depth int) (yaml.MapSlice, error) {
+	fs, err := parse(b)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ms    yaml.MapSlice
+		index = make(map[int]int)
+		count = make(map[int]int)
+	)
+	for _, f := range fs {
+		count[f.num]++
+	}
+	for _, f := range fs {
+		v := decodeValue(f, depth)
+		i, ok := index[f.num]
+		if !ok {
+			index[f.num] = len(ms)
+			if count[f.num] > 1 {
+				v = []interface{}{v}
+			}
+			ms = append(ms, yaml.MapItem{Key: f.num, Value: v})
+			continue
+		}
+		ms[i].Value = append(ms[i].Value.([]interface{}), v)
+	}
+	return ms, nil
+}
+
+// objectMetaFields names the fields of ObjectMeta, the metadata stored as
+// field 1 of every Kubernetes object.
+var objectMetaFields = map[int]string{
+	1:  "name",
+	2:  "generateName",
+	3:  "namespace",
+	4:  "selfLink",
+	5:  "uid",
+	6:  "resourceVersion",
+	7:  "generation",
+	8:  "creationTimestamp",
+	9:  "deletionTimestamp",
+	10: "deletionGracePeriodSeconds",
+	11: "labels",
+	12: "annotations",
+	13: "ownerReferences",
+	14: "finalizers",
+	15: "clusterName",
+	17: "managedFields",
+}
+
+// decodeObject renders the fields of a Kubernetes object like decodeMessage,
+// with its metadata decoded by name under "metadata".
+func decodeObject(b []byte) (yaml.MapSlice, error) {
+	ms, err := decodeMessage(b, 0)
+	if err != nil {
+		return nil, err
+	}
+	fs, err := parse(b)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range fs {
+		if f.num != 1 || f.wire != proto.WireBytes {
+			continue
+		}
+		md, merr := decodeObjectMeta(f.bytes)
+		if merr != nil {
+			// not an ObjectMeta; keep the field as decoded by number
+			break
+		}
+		for i := range ms {
+			if ms[i].Key == 1 {
+				ms[i] = yaml.MapItem{Key: "metadata", Value: md}
+			}
+		}
+		break
+	}
+	return ms, nil
+}
+
+// decodeObjectMeta renders an ObjectMeta message with its fields named.
+// Labels and annotations become maps, timestamps RFC 3339 strings and
+// finalizers, owner references and managed fields lists. Unknown fields are
+// keyed by number.
+func decodeObjectMeta(b []byte) (yaml.MapSlice, error) {
+	fs, err := parse(b)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		md    yaml.MapSlice
+		index = make(map[int]int)
+	)
+	for _, f := range fs {
+		var key interface{} = f.num
+		if name, ok := objectMetaFields[f.num]; ok {
+			key = name
+		}
+		i, seen := index[f.num]
+		if !seen {
+			i = len(md)
+			index[f.num] = i
+			md = append(md, yaml.MapItem{Key: key})
+		}
+		switch {
+		case (f.num == 11 || f.num == 12) && f.wire == proto.WireBytes:
+			e, eerr := decodeMapEntry(f.bytes)
+			if eerr != nil {
+				return nil, eerr
+			}
+			m, _ := md[i].Value.(yaml.MapSlice)
+			md[i].Value = append(m, e)
+		case f.num == 13 || f.num == 14 || f.num == 17:
+			l, _ := md[i].Value.([]interface{})
+			md[i].Value = append(l, decodeValue(f, 1))
+		case (f.num == 8 || f.num == 9) && f.wire == proto.WireBytes:
+			md[i].Value = decodeTime(f)
+		default:
+			md[i].Value = decodeValue(f, 1)
+		}
+	}
+	return md, nil
+}
+
+// decodeMapEntry decodes an entry of a map<string, string> field.
+func decodeMapEntry(b []byte) (yaml.MapItem, error) {
+	fs, err := parse(b)
+	if err != nil {
+		return yaml.MapItem{}, err
+	}
+	var k, v string
+	for _, f := range fs {
+		switch f.num {
+		case 1:
+			k = string(f.bytes)
+		case 2:
+			v = string(f.bytes)
+		}
+	}
+	return yaml.MapItem{Key: k, Value: v}, nil
+}
+
+// decodeTime renders a metav1.Time message (seconds and nanoseconds since
+// the epoch) as an RFC 3339 string.
+func decodeTime(f field) interface{} {
+	fs, err := parse(f.bytes)
+	if err != nil {
+		return decodeValue(f, 1)
+	}
+	var sec, nsec uint64
+	for _, tf := range fs {
+		switch tf.num {
+		case 1:
+			sec = tf.n
+		case 2:
+			nsec = tf.n
+		}
+	}
+	return time.Unix(int64(sec), int64(nsec)).UTC().Format(time.RFC3339)
+}
+
+func decodeValue(f field, depth int) interface{} {
+	if f.wire != proto.WireBytes {
+		return f.n
+	}
+	if isText(f.bytes) {
+		return string(f.bytes)
+	}
+	if depth < maxDepth {
+		if m, err := decodeMessage(f.bytes, depth+1); err == nil {
+			return m
+		}
+	}
+	return base64.StdEncoding.EncodeToString(f.bytes)
+}
+
+// isText reports whether b looks like a string rather than an embedded
+// message. Embedded messages start with a tag byte, which is rarely a
+// printable character.
+func isText(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if !unicode.IsPrint(r) && !unicode.IsSpace(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,121 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package k8sproto
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+func bytesField(num int, v []byte) []byte {
+	b := proto.EncodeVarint(uint64(num<<3 | proto.WireBytes))
+	b = append(b, proto.EncodeVarint(uint64(len(v)))...)
+	return append(b, v...)
+}
+
+func varintField(num int, v uint64) []byte {
+	return append(proto.EncodeVarint(uint64(num<<3|proto.WireVarint)), proto.EncodeVarint(v)...)
+}
+
+func TestToYAML(t *testing.T) {
+	// a ConfigMap with metadata {name, namespace, generation,
+	// creationTimestamp, labels, finalizers} and two data entries
+	meta := append(bytesField(1, []byte("cm")), bytesField(3, []byte("default"))...)
+	meta = append(meta, varintField(7, 2)...)
+	meta = append(meta, bytesField(8, append(varintField(1, 1530000000), varintField(2, 0)...))...)
+	meta = append(meta, bytesField(11, append(bytesField(1, []byte("app")), bytesField(2, []byte("web"))...))...)
+	meta = append(meta, bytesField(11, append(bytesField(1, []byte("tier")), bytesField(2, []byte("front"))...))...)
+	meta = append(meta, bytesField(14, []byte("example.com/cleanup"))...)
+	obj := bytesField(1, meta)
+	obj = append(obj, bytesField(2, append(bytesField(1, []byte("a")), bytesField(2, []byte("x"))...))...)
+	obj = append(obj, bytesField(2, append(bytesField(1, []byte("b")), bytesField(2, []byte("y"))...))...)
+
+	typeMeta := append(bytesField(1, []byte("v1")), bytesField(2, []byte("ConfigMap"))...)
+	v := append([]byte("k8s\x00"), bytesField(1, typeMeta)...)
+	v = append(v, bytesField(2, obj)...)
+
+	out, err := ToYAML(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w := `apiVersion: v1
+kind: ConfigMap
+fields:
+  metadata:
+    name: cm
+    namespace: default
+    generation: 2
+    creationTimestamp: 2018-06-26T08:00:00Z
+    labels:
+      app: web
+      tier: front
+    finalizers:
+    - example.com/cleanup
+  2:
+  - 1: a
+    2: x
+  - 1: b
+    2: "y"
+`
+	if string(out) != w {
+		t.Errorf("yaml = \n%s\nwant\n%s", out, w)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	if _, err := Decode([]byte(`{"kind":"Pod"}`)); err != ErrNotEncoded {
+		t.Errorf("err = %v, want %v", err, ErrNotEncoded)
+	}
+	// truncated length delimited field
+	if _, err := Decode([]byte("k8s\x00\x0a\x05ab")); err == nil {
+		t.Error("expected error on truncated message")
+	}
+}
+
+func TestIsRegistryKey(t *testing.T) {
+	tests := []struct {
+		key string
+		w   bool
+	}{
+		{"/registry/pods/default/a", true},
+		{"/registry", false},
+		{"/foo/registry/", false},
+	}
+	for i, tt := range tests {
+		if g := IsRegistryKey([]byte(tt.key)); g != tt.w {
+			t.Errorf("#%d: IsRegistryKey(%q) = %v, want %v", i, tt.key, g, tt.w)
+		}
+	}
+}
+
+func TestResource(t *testing.T) {
+	tests := []struct {
+		key string
+		w   string
+		wok bool
+	}{
+		{"/registry/pods/default/a", "pods", true},
+		{"/registry/namespaces/default", "namespaces", true},
+		{"/registry/pods", "", false},
+		{"/registry//a", "", false},
+		{"/foo/pods/a", "", false},
+	}
+	for i, tt := range tests {
+		if g, ok := Resource([]byte(tt.key)); g != tt.w || ok != tt.wok {
+			t.Errorf("#%d: Resource(%q) = %q, %v, want %q, %v", i, tt.key, g, ok, tt.w, tt.wok)
+		}
+	}
+}
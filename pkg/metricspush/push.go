@@ -0,0 +1,219 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metricspush pushes prometheus metrics to a Pushgateway or to a
+// statsd server, for deployments that do not scrape metrics.
+package metricspush
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+)
+
+// Pusher sends gathered metric families to a metrics backend.
+type Pusher interface {
+	// Push sends the current values of the metric families.
+	Push(mfs []*dto.MetricFamily) error
+	// Close releases the resources of the pusher.
+	Close() error
+}
+
+// New returns a Pusher for the URL u. Schemes "http" and "https" push to
+// the Pushgateway at u, grouped under job "etcd" and the given instance.
+// Scheme "statsd" sends to the statsd server at host:port over UDP, with
+// labels appended to metric names; "dogstatsd" sends labels as tags.
+// Pushes to a Pushgateway fail if they take longer than timeout, which
+// should be at most the push interval so that pushes do not pile up.
+func New(u, instance string, timeout time.Duration) (Pusher, error) {
+	pu, err := url.Parse(u)
+	if err != nil {
+		return nil, err
+	}
+	switch pu.Scheme {
+	case "http", "https":
+		group := strings.TrimSuffix(pu.Path, "/") + "/metrics/job/etcd/instance/"
+		pu.RawPath = (&url.URL{Path: group}).EscapedPath() + url.PathEscape(instance)
+		pu.Path = group + instance
+		return &gatewayPusher{url: pu.String(), c: &http.Client{Timeout: timeout}}, nil
+	case "statsd", "dogstatsd":
+		if pu.Host == "" {
+			return nil, fmt.Errorf("metricspush: missing statsd address in %q", u)
+		}
+		conn, err := net.Dial("udp", pu.Host)
+		if err != nil {
+			return nil, err
+		}
+		return &statsdPusher{w: conn, tags: pu.Scheme == "dogstatsd", last: make(map[string]float64)}, nil
+	}
+	return nil, fmt.Errorf("metricspush: unsupported scheme %q in %q", pu.Scheme, u)
+}
+
+// gatewayPusher replaces the metrics of its group on a Pushgateway on
+// every push.
+type gatewayPusher struct {
+	url string
+	c   *http.Client
+}
+
+func (p *gatewayPusher) Push(mfs []*dto.MetricFamily) error {
+	var buf bytes.Buffer
+	enc := expfmt.NewEncoder(&buf, expfmt.FmtText)
+	for _, mf := range mfs {
+		if err := enc.Encode(mf); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequest(http.MethodPut, p.url, &buf)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", string(expfmt.FmtText))
+	resp, err := p.c.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		b, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("metricspush: unexpected status %d from %s: %s", resp.StatusCode, p.url, b)
+	}
+	return nil
+}
+
+func (p *gatewayPusher) Close() error { return nil }
+
+// maxPacketSize keeps statsd packets within the MTU of most networks.
+const maxPacketSize = 1432
+
+// statsdPusher sends gauges as statsd gauges, and counters, histogram
+// and summary counts and sums as statsd counters of their increase since
+// the previous push.
+type statsdPusher struct {
+	w    io.WriteCloser
+	tags bool
+	// last holds the previous value of each counter line.
+	last map[string]float64
+}
+
+func (p *statsdPusher) Push(mfs []*dto.MetricFamily) error {
+	var lines []string
+	for _, mf := range mfs {
+		for _, m := range mf.Metric {
+			switch mf.GetType() {
+			case dto.MetricType_COUNTER:
+				lines = p.appendCounter(lines, mf.GetName(), m.Label, m.Counter.GetValue())
+			case dto.MetricType_GAUGE:
+				lines = append(lines, p.line(mf.GetName(), m.Label, m.Gauge.GetValue(), "g"))
+			case dto.MetricType_UNTYPED:
+				lines = append(lines, p.line(mf.GetName(), m.Label, m.Untyped.GetValue(), "g"))
+			case dto.MetricType_HISTOGRAM:
+				lines = p.appendCounter(lines, mf.GetName()+"_count", m.Label, float64(m.Histogram.GetSampleCount()))
+				lines = p.appendCounter(lines, mf.GetName()+"_sum", m.Label, m.Histogram.GetSampleSum())
+			case dto.MetricType_SUMMARY:
+				lines = p.appendCounter(lines, mf.GetName()+"_count", m.Label, float64(m.Summary.GetSampleCount()))
+				lines = p.appendCounter(lines, mf.GetName()+"_sum", m.Label, m.Summary.GetSampleSum())
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	for _, l := range lines {
+		if buf.Len() > 0 && buf.Len()+1+len(l) > maxPacketSize {
+			if _, err := p.w.Write(buf.Bytes()); err != nil {
+				return err
+			}
+			buf.Reset()
+		}
+		if buf.Len() > 0 {
+			buf.WriteByte('\n')
+		}
+		buf.WriteString(l)
+	}
+	if buf.Len() > 0 {
+		_, err := p.w.Write(buf.Bytes())
+		return err
+	}
+	return nil
+}
+
+// appendCounter appends the increase of a counter since the last push,
+// if any. Counters that went down were reset, and send their value.
+func (p *statsdPusher) appendCounter(lines []string, name string, lps []*dto.LabelPair, v float64) []string {
+	id := p.line(name, lps, 0, "c")
+	d := v - p.last[id]
+	if d < 0 {
+		d = v
+	}
+	p.last[id] = v
+	if d == 0 {
+		return lines
+	}
+	return append(lines, p.line(name, lps, d, "c"))
+}
+
+func (p *statsdPusher) line(name string, lps []*dto.LabelPair, v float64, typ string) string {
+	val := strconv.FormatFloat(v, 'f', -1, 64)
+	if len(lps) == 0 {
+		return name + ":" + val + "|" + typ
+	}
+	if p.tags {
+		tags := make([]string, 0, len(lps))
+		for _, lp := range lps {
+			tags = append(tags, lp.GetName()+":"+dogstatsdEscape(lp.GetValue()))
+		}
+		sort.Strings(tags)
+		return name + ":" + val + "|" + typ + "|#" + strings.Join(tags, ",")
+	}
+	for _, lp := range lps {
+		name += "." + lp.GetName() + "." + statsdEscape(lp.GetValue())
+	}
+	return name + ":" + val + "|" + typ
+}
+
+// statsdEscape replaces the characters that statsd reserves in names.
+func statsdEscape(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ':', '|', '@', '.', ' ', '\n':
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+// dogstatsdEscape replaces the characters that separate DogStatsD tags
+// and datagram fields.
+func dogstatsdEscape(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ',', '|', '\n':
+			return '_'
+		}
+		return r
+	}, s)
+}
+
+func (p *statsdPusher) Close() error { return p.w.Close() }
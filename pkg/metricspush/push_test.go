@@ -0,0 +1,152 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metricspush
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func gather(t *testing.T, cs ...prometheus.Collector) []*dto.MetricFamily {
+	reg := prometheus.NewRegistry()
+	for _, c := range cs {
+		reg.MustRegister(c)
+	}
+	mfs, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mfs
+}
+
+func TestGatewayPush(t *testing.T) {
+	var method, path, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		method, path, body = r.Method, r.URL.Path, string(b)
+	}))
+	defer srv.Close()
+
+	p, err := New(srv.URL+"/", "infra 1", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	c := prometheus.NewCounter(prometheus.CounterOpts{Name: "foo_total", Help: "foo"})
+	c.Add(3)
+	if err = p.Push(gather(t, c)); err != nil {
+		t.Fatal(err)
+	}
+	if method != http.MethodPut {
+		t.Errorf("method = %s, want PUT", method)
+	}
+	if wpath := "/metrics/job/etcd/instance/infra 1"; path != wpath {
+		t.Errorf("path = %q, want %q", path, wpath)
+	}
+	if !strings.Contains(body, "foo_total 3") {
+		t.Errorf("body = %q, want foo_total 3", body)
+	}
+}
+
+func TestGatewayPushTimeout(t *testing.T) {
+	donec := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-donec
+	}))
+	defer srv.Close()
+	defer close(donec)
+
+	p, err := New(srv.URL, "infra1", 100*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+	errc := make(chan error, 1)
+	go func() { errc <- p.Push(nil) }()
+	select {
+	case err = <-errc:
+		if err == nil {
+			t.Fatal("expected timeout error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("push did not time out")
+	}
+}
+
+type bufCloser struct{ bytes.Buffer }
+
+func (b *bufCloser) Close() error { return nil }
+
+func TestStatsdPush(t *testing.T) {
+	c := prometheus.NewCounterVec(prometheus.CounterOpts{Name: "foo_total", Help: "foo"}, []string{"type"})
+	g := prometheus.NewGauge(prometheus.GaugeOpts{Name: "bar", Help: "bar"})
+	h := prometheus.NewHistogram(prometheus.HistogramOpts{Name: "baz_seconds", Help: "baz"})
+	c.WithLabelValues("a.b").Add(2)
+	c.WithLabelValues("x,y|z").Add(1)
+	g.Set(1.5)
+	h.Observe(0.5)
+
+	tests := []struct {
+		tags bool
+		want [][]string
+	}{
+		{
+			false,
+			[][]string{
+				{"bar:1.5|g", "baz_seconds_count:1|c", "baz_seconds_sum:0.5|c", "foo_total.type.a_b:2|c", "foo_total.type.x,y_z:1|c"},
+				// only counters that increased are sent again
+				{"bar:1.5|g", "foo_total.type.a_b:1|c"},
+			},
+		},
+		{
+			true,
+			[][]string{
+				{"bar:1.5|g", "baz_seconds_count:1|c", "baz_seconds_sum:0.5|c", "foo_total:3|c|#type:a.b", "foo_total:1|c|#type:x_y_z"},
+				{"bar:1.5|g", "foo_total:1|c|#type:a.b"},
+			},
+		},
+	}
+	for i, tt := range tests {
+		w := &bufCloser{}
+		p := &statsdPusher{w: w, tags: tt.tags, last: make(map[string]float64)}
+		for j, want := range tt.want {
+			if j > 0 {
+				c.WithLabelValues("a.b").Inc()
+			}
+			w.Reset()
+			if err := p.Push(gather(t, c, g, h)); err != nil {
+				t.Fatal(err)
+			}
+			if got := strings.Split(w.String(), "\n"); !reflect.DeepEqual(got, want) {
+				t.Errorf("#%d.%d: lines = %q, want %q", i, j, got, want)
+			}
+		}
+	}
+}
+
+func TestNewUnsupportedScheme(t *testing.T) {
+	if _, err := New("udp://127.0.0.1:8125", "infra1", time.Second); err == nil {
+		t.Fatal("expected error for unsupported scheme")
+	}
+}
@@ -18,19 +18,68 @@ package osutil
 
 import (
 	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
 	"go.uber.org/zap"
 )
 
+// InterruptHandler is a function that is called on receiving a
+// console interrupt, close, logoff or shutdown event.
 type InterruptHandler func()
 
-// RegisterInterruptHandler is a no-op on windows
-func RegisterInterruptHandler(h InterruptHandler) {}
+var (
+	interruptRegisterMu, interruptExitMu sync.Mutex
+	// interruptHandlers holds all registered InterruptHandlers in order
+	// they will be executed.
+	interruptHandlers = []InterruptHandler{}
+)
+
+// RegisterInterruptHandler registers a new InterruptHandler. Handlers registered
+// after interrupt handing was initiated will not be executed.
+func RegisterInterruptHandler(h InterruptHandler) {
+	interruptRegisterMu.Lock()
+	defer interruptRegisterMu.Unlock()
+	interruptHandlers = append(interruptHandlers, h)
+}
+
+// HandleInterrupts calls the handler functions on receiving CTRL_C_EVENT or
+// CTRL_BREAK_EVENT, which Go delivers as os.Interrupt, or on the console
+// being closed or the system logging off or shutting down, which Go
+// delivers as SIGTERM.
+func HandleInterrupts(lg *zap.Logger) {
+	notifier := make(chan os.Signal, 1)
+	signal.Notify(notifier, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		sig := <-notifier
 
-// HandleInterrupts is a no-op on windows
-func HandleInterrupts(*zap.Logger) {}
+		interruptRegisterMu.Lock()
+		ihs := make([]InterruptHandler, len(interruptHandlers))
+		copy(ihs, interruptHandlers)
+		interruptRegisterMu.Unlock()
+
+		interruptExitMu.Lock()
+
+		if lg != nil {
+			lg.Info("received signal; shutting down", zap.String("signal", sig.String()))
+		} else {
+			plog.Noticef("received %v signal, shutting down...", sig)
+		}
+
+		for _, h := range ihs {
+			h()
+		}
+		signal.Stop(notifier)
+		// windows cannot re-raise the event to ourselves; the handlers
+		// have stopped the server, so exit as a clean stop.
+		os.Exit(0)
+	}()
+}
 
-// Exit calls os.Exit
+// Exit relays to os.Exit if no interrupt handlers are running, blocks otherwise.
 func Exit(code int) {
+	interruptExitMu.Lock()
 	os.Exit(code)
 }
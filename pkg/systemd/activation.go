@@ -0,0 +1,65 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package systemd
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+)
+
+// listenFdsStart is the first file descriptor passed by systemd
+// (SD_LISTEN_FDS_START).
+const listenFdsStart = 3
+
+// Listeners returns the listeners passed to the process by systemd
+// socket activation ("LISTEN_PID" and "LISTEN_FDS"), in the order they
+// were declared in the socket unit. It returns nil if the process was
+// not socket-activated. The environment variables are unset, so that
+// child processes do not inherit them.
+// Reference: https://www.freedesktop.org/software/systemd/man/sd_listen_fds.html.
+func Listeners() ([]net.Listener, error) {
+	defer func() {
+		os.Unsetenv("LISTEN_PID")
+		os.Unsetenv("LISTEN_FDS")
+		os.Unsetenv("LISTEN_FDNAMES")
+	}()
+
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil
+	}
+	nfds, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || nfds <= 0 {
+		return nil, nil
+	}
+
+	lns := make([]net.Listener, 0, nfds)
+	for fd := listenFdsStart; fd < listenFdsStart+nfds; fd++ {
+		f := os.NewFile(uintptr(fd), "LISTEN_FD_"+strconv.Itoa(fd))
+		ln, lerr := net.FileListener(f)
+		// net.FileListener dups the descriptor
+		f.Close()
+		if lerr != nil {
+			for _, l := range lns {
+				l.Close()
+			}
+			return nil, fmt.Errorf("systemd: fd %d is not a listening socket (%v)", fd, lerr)
+		}
+		lns = append(lns, ln)
+	}
+	return lns, nil
+}
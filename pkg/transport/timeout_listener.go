@@ -27,6 +27,13 @@ func NewTimeoutListener(addr string, scheme string, tlsinfo *TLSInfo, rdtimeoutd
 	if err != nil {
 		return nil, err
 	}
+	return NewTimeoutListenerFromListener(ln, scheme, tlsinfo, rdtimeoutd, wtimeoutd)
+}
+
+// NewTimeoutListenerFromListener is like NewTimeoutListener but wraps an
+// already opened listener instead of listening on an address.
+func NewTimeoutListenerFromListener(ln net.Listener, scheme string, tlsinfo *TLSInfo, rdtimeoutd, wtimeoutd time.Duration) (net.Listener, error) {
+	var err error
 	ln = &rwTimeoutListener{
 		Listener:   ln,
 		rdtimeoutd: rdtimeoutd,
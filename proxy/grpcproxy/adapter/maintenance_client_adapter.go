@@ -48,6 +48,14 @@ func (s *mts2mtc) HashKV(ctx context.Context, r *pb.HashKVRequest, opts ...grpc.
 	return s.mts.HashKV(ctx, r)
 }
 
+func (s *mts2mtc) RevisionTime(ctx context.Context, r *pb.RevisionTimeRequest, opts ...grpc.CallOption) (*pb.RevisionTimeResponse, error) {
+	return s.mts.RevisionTime(ctx, r)
+}
+
+func (s *mts2mtc) CompactionConfig(ctx context.Context, r *pb.CompactionConfigRequest, opts ...grpc.CallOption) (*pb.CompactionConfigResponse, error) {
+	return s.mts.CompactionConfig(ctx, r)
+}
+
 func (s *mts2mtc) MoveLeader(ctx context.Context, r *pb.MoveLeaderRequest, opts ...grpc.CallOption) (*pb.MoveLeaderResponse, error) {
 	return s.mts.MoveLeader(ctx, r)
 }
@@ -74,6 +74,16 @@ func (mp *maintenanceProxy) HashKV(ctx context.Context, r *pb.HashKVRequest) (*p
 	return pb.NewMaintenanceClient(conn).HashKV(ctx, r)
 }
 
+func (mp *maintenanceProxy) RevisionTime(ctx context.Context, r *pb.RevisionTimeRequest) (*pb.RevisionTimeResponse, error) {
+	conn := mp.client.ActiveConnection()
+	return pb.NewMaintenanceClient(conn).RevisionTime(ctx, r)
+}
+
+func (mp *maintenanceProxy) CompactionConfig(ctx context.Context, r *pb.CompactionConfigRequest) (*pb.CompactionConfigResponse, error) {
+	conn := mp.client.ActiveConnection()
+	return pb.NewMaintenanceClient(conn).CompactionConfig(ctx, r)
+}
+
 func (mp *maintenanceProxy) Alarm(ctx context.Context, r *pb.AlarmRequest) (*pb.AlarmResponse, error) {
 	conn := mp.client.ActiveConnection()
 	return pb.NewMaintenanceClient(conn).Alarm(ctx, r)
@@ -0,0 +1,282 @@
+// Copyright 2017 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/coreos/etcd/pkg/fileutil"
+	"github.com/coreos/etcd/pkg/testutil"
+)
+
+// failpointCrash is a request that crashes a member at a failpoint, and the
+// state the member must recover to after a restart.
+type failpointCrash struct {
+	fp string
+	// txns is the number of txns of 128 puts written before the crash, so
+	// that compaction takes more than one batch.
+	txns  int
+	crash func(ctx context.Context, cli *clientv3.Client, lid clientv3.LeaseID, rev int64) error
+	check func(cx failpointCtx)
+}
+
+type failpointCtx struct {
+	t   *testing.T
+	cli *clientv3.Client
+	lid clientv3.LeaseID
+	// rev is the revision before the crash.
+	rev int64
+}
+
+// TestFailpointRecovery crashes a member at the failpoints in mvcc and lease
+// and ensures it recovers its revision, watch history and leases on
+// restart. It needs an etcd binary built with FAILPOINTS=1, copied to
+// etcd-failpoints in the bin directory.
+func TestFailpointRecovery(t *testing.T) {
+	fpBinary := binDir + "/etcd-failpoints"
+	if !fileutil.Exist(fpBinary) {
+		t.Skipf("%q does not exist", fpBinary)
+	}
+
+	put := func(ctx context.Context, cli *clientv3.Client, lid clientv3.LeaseID, rev int64) error {
+		_, err := cli.Put(ctx, "foo", "bar2")
+		return err
+	}
+	compact := func(ctx context.Context, cli *clientv3.Client, lid clientv3.LeaseID, rev int64) error {
+		_, err := cli.Compact(ctx, rev, clientv3.WithCompactPhysical())
+		return err
+	}
+	revoke := func(ctx context.Context, cli *clientv3.Client, lid clientv3.LeaseID, rev int64) error {
+		_, err := cli.Revoke(ctx, lid)
+		return err
+	}
+
+	tests := []failpointCrash{
+		{fp: "github.com/coreos/etcd/mvcc/mvccBeforeSaveIndex", crash: put, check: checkPutRecovered},
+		{fp: "github.com/coreos/etcd/mvcc/mvccBeforeNotify", crash: put, check: checkPutRecovered},
+		{fp: "github.com/coreos/etcd/mvcc/mvccAfterNotify", crash: put, check: checkPutRecovered},
+		{fp: "github.com/coreos/etcd/mvcc/compactBeforeCommitScheduledCompact", crash: compact, check: checkCompactRecovered},
+		{fp: "github.com/coreos/etcd/mvcc/compactAfterCommitScheduledCompact", crash: compact, check: checkCompactRecovered},
+		{fp: "github.com/coreos/etcd/mvcc/compactAfterBatch", txns: 80, crash: compact, check: checkCompactRecovered},
+		{fp: "github.com/coreos/etcd/mvcc/compactBeforeSetFinishedCompact", crash: compact, check: checkCompactRecovered},
+		{fp: "github.com/coreos/etcd/mvcc/compactAfterSetFinishedCompact", crash: compact, check: checkCompactRecovered},
+		{fp: "github.com/coreos/etcd/lease/lessorBeforeRevokeTxnEnd", crash: revoke, check: checkRevokeRecovered},
+	}
+	for _, tt := range tests {
+		testFailpointRecovery(t, fpBinary, tt)
+	}
+}
+
+func testFailpointRecovery(t *testing.T, fpBinary string, tt failpointCrash) {
+	defer testutil.AfterTest(t)
+
+	cfg := configNoTLS
+	cfg.execPath = fpBinary
+	epc, err := newEtcdProcessCluster(configStandalone(cfg))
+	if err != nil {
+		t.Fatalf("%s: could not start etcd process cluster (%v)", tt.fp, err)
+	}
+	defer func() {
+		if errC := epc.Close(); errC != nil {
+			t.Fatalf("%s: error closing etcd processes (%v)", tt.fp, errC)
+		}
+	}()
+
+	cli, err := clientv3.New(clientv3.Config{Endpoints: epc.EndpointsV3(), DialTimeout: 3 * time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() { cli.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+	if _, err = cli.Put(ctx, "foo", "bar1"); err != nil {
+		t.Fatalf("%s: %v", tt.fp, err)
+	}
+	lresp, err := cli.Grant(ctx, 100)
+	if err != nil {
+		t.Fatalf("%s: %v", tt.fp, err)
+	}
+	if _, err = cli.Put(ctx, "baz", "bar1", clientv3.WithLease(lresp.ID)); err != nil {
+		t.Fatalf("%s: %v", tt.fp, err)
+	}
+	for i := 0; i < tt.txns; i++ {
+		ops := make([]clientv3.Op, 128)
+		for j := range ops {
+			ops[j] = clientv3.OpPut(fmt.Sprintf("txn/%d/%d", i, j), "v")
+		}
+		if _, err = cli.Txn(ctx).Then(ops...).Commit(); err != nil {
+			t.Fatalf("%s: %v", tt.fp, err)
+		}
+	}
+	gresp, err := cli.Get(ctx, "foo")
+	if err != nil {
+		t.Fatalf("%s: %v", tt.fp, err)
+	}
+	rev := gresp.Header.Revision
+
+	// stop gracefully so that nothing is replayed on the restart below,
+	// then restart with the failpoint armed
+	ep := epc.procs[0]
+	ep.WithStopSignal(syscall.SIGTERM)
+	if err = ep.Stop(); err != nil {
+		t.Fatalf("%s: %v", tt.fp, err)
+	}
+	os.Setenv("GOFAIL_FAILPOINTS", tt.fp+`=panic("e2e")`)
+	proc, err := spawnCmd(append([]string{ep.Config().execPath}, ep.Config().args...))
+	os.Unsetenv("GOFAIL_FAILPOINTS")
+	if err != nil {
+		t.Fatalf("%s: %v", tt.fp, err)
+	}
+	if err = waitReadyExpectProc(proc, etcdServerReadyLines); err != nil {
+		proc.Stop()
+		t.Fatalf("%s: %v", tt.fp, err)
+	}
+
+	cctx, ccancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	if err = tt.crash(cctx, cli, lresp.ID, rev); err == nil {
+		t.Errorf("%s: expected the request to fail", tt.fp)
+	}
+	ccancel()
+	if err = closeWithTimeout(proc, 10*time.Second); err != nil {
+		t.Fatalf("%s: member did not crash (%v)", tt.fp, err)
+	}
+
+	if err = ep.Start(); err != nil {
+		t.Fatalf("%s: could not restart member (%v)", tt.fp, err)
+	}
+	cli.Close()
+	if cli, err = clientv3.New(clientv3.Config{Endpoints: epc.EndpointsV3(), DialTimeout: 3 * time.Second}); err != nil {
+		t.Fatal(err)
+	}
+	tt.check(failpointCtx{t: t, cli: cli, lid: lresp.ID, rev: rev})
+}
+
+// checkPutRecovered ensures the put that crashed the member is applied
+// once, and watchers replay it.
+func checkPutRecovered(cx failpointCtx) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	resp, err := cx.cli.Get(ctx, "foo")
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if resp.Header.Revision != cx.rev+1 {
+		cx.t.Errorf("revision = %d, want %d", resp.Header.Revision, cx.rev+1)
+	}
+	if len(resp.Kvs) != 1 || string(resp.Kvs[0].Value) != "bar2" {
+		cx.t.Errorf("kvs = %+v, want foo=bar2", resp.Kvs)
+	}
+	checkWatchReplay(ctx, cx, "foo", 2, []string{"bar1", "bar2"})
+
+	ttl, err := cx.cli.TimeToLive(ctx, cx.lid)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if ttl.TTL <= 0 {
+		cx.t.Errorf("lease TTL = %d, want the lease alive", ttl.TTL)
+	}
+}
+
+// checkCompactRecovered ensures the compaction that crashed the member
+// takes effect, and watchers of compacted revisions are told so.
+func checkCompactRecovered(cx failpointCtx) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	resp, err := cx.cli.Get(ctx, "foo")
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if resp.Header.Revision != cx.rev {
+		cx.t.Errorf("revision = %d, want %d", resp.Header.Revision, cx.rev)
+	}
+	if _, err = cx.cli.Get(ctx, "foo", clientv3.WithRev(cx.rev-1)); err != rpctypes.ErrCompacted {
+		cx.t.Errorf("range at compacted revision error = %v, want %v", err, rpctypes.ErrCompacted)
+	}
+
+	wch := cx.cli.Watch(ctx, "foo", clientv3.WithRev(2))
+	wresp, ok := <-wch
+	if !ok {
+		cx.t.Fatalf("watch closed: %v", ctx.Err())
+	}
+	if wresp.CompactRevision != cx.rev {
+		cx.t.Errorf("watch compact revision = %d, want %d", wresp.CompactRevision, cx.rev)
+	}
+	if _, err = cx.cli.Compact(ctx, cx.rev); err != rpctypes.ErrCompacted {
+		cx.t.Errorf("compact error = %v, want %v", err, rpctypes.ErrCompacted)
+	}
+}
+
+// checkRevokeRecovered ensures the lease revoke that crashed the member
+// deletes the lease and its keys once.
+func checkRevokeRecovered(cx failpointCtx) {
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Second)
+	defer cancel()
+
+	resp, err := cx.cli.Get(ctx, "baz")
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if resp.Header.Revision != cx.rev+1 {
+		cx.t.Errorf("revision = %d, want %d", resp.Header.Revision, cx.rev+1)
+	}
+	if len(resp.Kvs) != 0 {
+		cx.t.Errorf("kvs = %+v, want none", resp.Kvs)
+	}
+	checkWatchReplay(ctx, cx, "baz", 3, []string{"bar1", ""})
+
+	ttl, err := cx.cli.TimeToLive(ctx, cx.lid)
+	if err != nil {
+		cx.t.Fatal(err)
+	}
+	if ttl.TTL != -1 {
+		cx.t.Errorf("lease TTL = %d, want -1", ttl.TTL)
+	}
+}
+
+// checkWatchReplay ensures a watch on key from rev receives the events with
+// the values wvals; a delete has an empty value.
+func checkWatchReplay(ctx context.Context, cx failpointCtx, key string, rev int64, wvals []string) {
+	wctx, wcancel := context.WithCancel(ctx)
+	defer wcancel()
+	wch := cx.cli.Watch(wctx, key, clientv3.WithRev(rev))
+	var vals []string
+	for len(vals) < len(wvals) {
+		wresp, ok := <-wch
+		if !ok {
+			cx.t.Fatalf("watch on %q closed after %q (%v)", key, vals, ctx.Err())
+		}
+		for _, ev := range wresp.Events {
+			if ev.Type == mvccpb.DELETE {
+				vals = append(vals, "")
+			} else {
+				vals = append(vals, string(ev.Kv.Value))
+			}
+		}
+	}
+	if fmt.Sprint(vals) != fmt.Sprint(wvals) {
+		cx.t.Errorf("watch on %q values = %q, want %q", key, vals, wvals)
+	}
+}
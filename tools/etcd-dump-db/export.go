@@ -0,0 +1,203 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/coreos/etcd/pkg/k8sproto"
+
+	bolt "github.com/coreos/bbolt"
+	"github.com/ghodss/yaml"
+)
+
+// exportedKV is the human-readable form of a key-value pair.
+type exportedKV struct {
+	Key            string      `json:"key"`
+	Value          interface{} `json:"value"`
+	CreateRevision int64       `json:"create_revision"`
+	ModRevision    int64       `json:"mod_revision"`
+	Version        int64       `json:"version"`
+	Lease          string      `json:"lease,omitempty"`
+}
+
+// valueDecoder renders a stored value for export. It may return a string
+// or any value that marshals to JSON.
+type valueDecoder func(key, value []byte) (interface{}, error)
+
+// valueDecoders are the decoders selectable with "export --value-decoder".
+// Decoders for application specific encodings register themselves here.
+var valueDecoders = map[string]valueDecoder{
+	"string": func(_, v []byte) (interface{}, error) { return string(v), nil },
+	"base64": func(_, v []byte) (interface{}, error) { return base64.StdEncoding.EncodeToString(v), nil },
+	"k8s":    k8sValueDecoder,
+}
+
+// k8sValueDecoder renders protobuf encoded Kubernetes objects under the
+// registry prefix, and other values as strings.
+func k8sValueDecoder(k, v []byte) (interface{}, error) {
+	if !k8sproto.IsRegistryKey(k) || !k8sproto.IsEncoded(v) {
+		return string(v), nil
+	}
+	y, err := k8sproto.ToYAML(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode Kubernetes object at %q (%v)", k, err)
+	}
+	j, err := yaml.YAMLToJSON(y)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(j), nil
+}
+
+// execValueDecoder decodes values with an external command, which receives
+// the key as its last argument and the value on stdin. Output that parses
+// as JSON or YAML is exported as a structured value, anything else as a
+// string. It fails if the command is empty.
+func execValueDecoder(command string) (valueDecoder, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, fmt.Errorf("value decoder command %q is empty", command)
+	}
+	return func(k, v []byte) (interface{}, error) {
+		cmd := exec.Command(args[0], append(args[1:], string(k))...)
+		cmd.Stdin = bytes.NewReader(v)
+		var stderr bytes.Buffer
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return nil, fmt.Errorf("value decoder %q failed on key %q (%v: %s)", command, k, err, bytes.TrimSpace(stderr.Bytes()))
+		}
+		if j, jerr := yaml.YAMLToJSON(out); jerr == nil {
+			var st interface{}
+			if json.Unmarshal(j, &st) == nil {
+				if _, ok := st.(string); !ok {
+					return json.RawMessage(j), nil
+				}
+			}
+		}
+		return string(out), nil
+	}, nil
+}
+
+// exportKeyspace writes the keys under prefix as of revision rev (the
+// latest revision if 0), in ascending key order, as JSON or YAML.
+func exportKeyspace(w io.Writer, dbPath, prefix string, rev int64, output string, dec valueDecoder) error {
+	db, err := bolt.Open(dbPath, 0600, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	kvs := make(map[string]mvccpb.KeyValue)
+	err = db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("key"))
+		if b == nil {
+			return fmt.Errorf("got nil bucket for key")
+		}
+		if rev > 0 {
+			// superseded revisions up to the scheduled compaction may
+			// already be deleted
+			if m := tx.Bucket([]byte("meta")); m != nil {
+				if v := m.Get([]byte("scheduledCompactRev")); len(v) != 0 && rev < bytesToRev(v).main {
+					return mvcc.ErrCompacted
+				}
+			}
+			if k, _ := b.Cursor().Last(); k == nil || rev > bytesToRev(k).main {
+				return mvcc.ErrFutureRev
+			}
+		}
+		// revisions are stored in ascending order, so the last revision
+		// of each key seen wins
+		return b.ForEach(func(k, v []byte) error {
+			if rev > 0 && bytesToRev(k).main > rev {
+				return nil
+			}
+			var kv mvccpb.KeyValue
+			if err := kv.Unmarshal(v); err != nil {
+				return err
+			}
+			if !strings.HasPrefix(string(kv.Key), prefix) {
+				return nil
+			}
+			if isTombstone(k) {
+				delete(kvs, string(kv.Key))
+				return nil
+			}
+			kvs[string(kv.Key)] = kv
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(kvs))
+	for k := range kvs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ekvs := make([]exportedKV, 0, len(keys))
+	for _, k := range keys {
+		kv := kvs[k]
+		val, derr := dec(kv.Key, kv.Value)
+		if derr != nil {
+			return derr
+		}
+		ekv := exportedKV{
+			Key:            k,
+			Value:          val,
+			CreateRevision: kv.CreateRevision,
+			ModRevision:    kv.ModRevision,
+			Version:        kv.Version,
+		}
+		if kv.Lease != 0 {
+			ekv.Lease = fmt.Sprintf("%016x", kv.Lease)
+		}
+		ekvs = append(ekvs, ekv)
+	}
+
+	var b []byte
+	switch output {
+	case "json":
+		if b, err = json.MarshalIndent(ekvs, "", "  "); err == nil {
+			b = append(b, '\n')
+		}
+	case "yaml":
+		b, err = yaml.Marshal(ekvs)
+	default:
+		err = fmt.Errorf("unknown output format %q", output)
+	}
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(b)
+	return err
+}
+
+// isTombstone reports whether a key bucket revision marks a deletion.
+func isTombstone(k []byte) bool {
+	return len(k) == 8+1+8+1 && k[len(k)-1] == 't'
+}
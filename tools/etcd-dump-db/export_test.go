@@ -0,0 +1,114 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/backend"
+
+	"go.uber.org/zap"
+)
+
+func TestExportKeyspace(t *testing.T) {
+	dir, err := ioutil.TempDir("", "etcd-dump-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, "db")
+
+	be := backend.NewDefaultBackend(dbPath)
+	s := mvcc.New(zap.NewExample(), be, &lease.FakeLessor{}, nil)
+	s.Put([]byte("foo/a"), []byte("1"), lease.NoLease) // rev 2
+	s.Put([]byte("foo/b"), []byte("1"), lease.NoLease) // rev 3
+	s.Put([]byte("foo/a"), []byte("2"), lease.NoLease) // rev 4
+	s.Put([]byte("bar"), []byte("1"), lease.NoLease)   // rev 5
+	s.DeleteRange([]byte("foo/b"), nil)                // rev 6
+	s.Put([]byte("foo/a"), []byte("3"), lease.NoLease) // rev 7
+	donec, err := s.Compact(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-donec
+	s.Close()
+	be.Close()
+
+	dec := valueDecoders["string"]
+	tests := []struct {
+		prefix string
+		rev    int64
+		wkvs   map[string]string
+		werr   error
+	}{
+		{"", 0, map[string]string{"bar": "1", "foo/a": "3"}, nil},
+		{"foo/", 0, map[string]string{"foo/a": "3"}, nil},
+		{"", 4, map[string]string{"foo/a": "2", "foo/b": "1"}, nil},
+		{"", 5, map[string]string{"bar": "1", "foo/a": "2", "foo/b": "1"}, nil},
+		{"", 6, map[string]string{"bar": "1", "foo/a": "2"}, nil},
+		{"", 3, nil, mvcc.ErrCompacted},
+		{"", 8, nil, mvcc.ErrFutureRev},
+	}
+	for i, tt := range tests {
+		var buf bytes.Buffer
+		err := exportKeyspace(&buf, dbPath, tt.prefix, tt.rev, "json", dec)
+		if err != tt.werr {
+			t.Errorf("#%d: err = %v, want %v", i, err, tt.werr)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		var ekvs []exportedKV
+		if err = json.Unmarshal(buf.Bytes(), &ekvs); err != nil {
+			t.Fatalf("#%d: %v", i, err)
+		}
+		kvs := make(map[string]string)
+		for _, ekv := range ekvs {
+			kvs[ekv.Key] = ekv.Value.(string)
+		}
+		if !reflect.DeepEqual(kvs, tt.wkvs) {
+			t.Errorf("#%d: kvs = %v, want %v", i, kvs, tt.wkvs)
+		}
+	}
+}
+
+func TestExecValueDecoder(t *testing.T) {
+	for _, command := range []string{"", " \t"} {
+		if _, err := execValueDecoder(command); err == nil {
+			t.Errorf("command %q: expected error, got nil", command)
+		}
+	}
+
+	dec, err := execValueDecoder("echo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err := dec([]byte("foo"), []byte("bar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != "foo\n" {
+		t.Errorf("value = %q, want %q", v, "foo\n")
+	}
+}
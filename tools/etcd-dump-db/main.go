@@ -44,18 +44,44 @@ var (
 		Short: "hash computes the hash of db file.",
 		Run:   getHashCommandFunc,
 	}
+	exportCommand = &cobra.Command{
+		Use:   "export [data dir or db file path]",
+		Short: "export prints the keyspace as JSON or YAML.",
+		Run:   exportCommandFunc,
+	}
+	resourceUsageCommand = &cobra.Command{
+		Use:   "resource-usage [data dir or db file path]",
+		Short: "resource-usage reports the storage used by each Kubernetes resource type.",
+		Run:   resourceUsageCommandFunc,
+	}
 )
 
 var iterateBucketLimit uint64
 var iterateBucketDecode bool
 
+var (
+	exportPrefix         string
+	exportRev            int64
+	exportOutput         string
+	exportValueDecoder   string
+	exportValueDecodeCmd string
+)
+
 func init() {
 	iterateBucketCommand.PersistentFlags().Uint64Var(&iterateBucketLimit, "limit", 0, "max number of key-value pairs to iterate (0< to iterate all)")
 	iterateBucketCommand.PersistentFlags().BoolVar(&iterateBucketDecode, "decode", false, "true to decode Protocol Buffer encoded data")
 
+	exportCommand.PersistentFlags().StringVar(&exportPrefix, "prefix", "", "only export keys with the given prefix")
+	exportCommand.PersistentFlags().Int64Var(&exportRev, "rev", 0, "export the keyspace as of this revision (0 for the latest revision)")
+	exportCommand.PersistentFlags().StringVar(&exportOutput, "output", "json", "output format, 'json' or 'yaml'")
+	exportCommand.PersistentFlags().StringVar(&exportValueDecoder, "value-decoder", "string", "how to render values, 'string', 'base64' or 'k8s'")
+	exportCommand.PersistentFlags().StringVar(&exportValueDecodeCmd, "value-decoder-cmd", "", "command that decodes each value from stdin, given the key as its last argument; overrides --value-decoder")
+
 	rootCommand.AddCommand(listBucketCommand)
 	rootCommand.AddCommand(iterateBucketCommand)
 	rootCommand.AddCommand(getHashCommand)
+	rootCommand.AddCommand(exportCommand)
+	rootCommand.AddCommand(resourceUsageCommand)
 }
 
 func main() {
@@ -122,3 +148,51 @@ func getHashCommandFunc(cmd *cobra.Command, args []string) {
 	}
 	fmt.Printf("db path: %s\nHash: %d\n", dp, hash)
 }
+
+func exportCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		log.Fatalf("Must provide at least 1 argument (got %v)", args)
+	}
+	dp := args[0]
+	if !strings.HasSuffix(dp, "db") {
+		dp = filepath.Join(snapDir(dp), "db")
+	}
+	if !existFileOrDir(dp) {
+		log.Fatalf("%q does not exist", dp)
+	}
+
+	dec, ok := valueDecoders[exportValueDecoder]
+	if !ok {
+		log.Fatalf("unknown value decoder %q", exportValueDecoder)
+	}
+	if exportValueDecodeCmd != "" {
+		var err error
+		if dec, err = execValueDecoder(exportValueDecodeCmd); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := exportKeyspace(os.Stdout, dp, exportPrefix, exportRev, exportOutput, dec); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func resourceUsageCommandFunc(cmd *cobra.Command, args []string) {
+	if len(args) < 1 {
+		log.Fatalf("Must provide at least 1 argument (got %v)", args)
+	}
+	dp := args[0]
+	if !strings.HasSuffix(dp, "db") {
+		dp = filepath.Join(snapDir(dp), "db")
+	}
+	if !existFileOrDir(dp) {
+		log.Fatalf("%q does not exist", dp)
+	}
+
+	us, err := getResourceUsage(dp)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = printResourceUsage(os.Stdout, us); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/coreos/etcd/mvcc/mvccpb"
+	"github.com/coreos/etcd/pkg/k8sproto"
+
+	bolt "github.com/coreos/bbolt"
+)
+
+// resourceUsage is the storage used by one Kubernetes resource type.
+type resourceUsage struct {
+	resource string
+	// keys and bytes count the live keys and their key and value sizes.
+	keys  int
+	bytes int
+	// revisions and historyBytes count every revision still stored,
+	// including superseded and deleted ones.
+	revisions    int
+	historyBytes int
+}
+
+// getResourceUsage groups the keys under the Kubernetes registry prefix by
+// resource type.
+func getResourceUsage(dbPath string) ([]*resourceUsage, error) {
+	db, err := bolt.Open(dbPath, 0600, &bolt.Options{ReadOnly: true})
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	usage := make(map[string]*resourceUsage)
+	live := make(map[string]int)
+	err = db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte("key"))
+		if b == nil {
+			return fmt.Errorf("got nil bucket for key")
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var kv mvccpb.KeyValue
+			if err := kv.Unmarshal(v); err != nil {
+				return err
+			}
+			res, ok := k8sproto.Resource(kv.Key)
+			if !ok {
+				return nil
+			}
+			u := usage[res]
+			if u == nil {
+				u = &resourceUsage{resource: res}
+				usage[res] = u
+			}
+			u.revisions++
+			u.historyBytes += len(k) + len(v)
+			if isTombstone(k) {
+				delete(live, string(kv.Key))
+			} else {
+				live[string(kv.Key)] = len(kv.Key) + len(kv.Value)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for k, n := range live {
+		res, _ := k8sproto.Resource([]byte(k))
+		usage[res].keys++
+		usage[res].bytes += n
+	}
+	us := make([]*resourceUsage, 0, len(usage))
+	for _, u := range usage {
+		us = append(us, u)
+	}
+	sort.Slice(us, func(i, j int) bool {
+		if us[i].historyBytes != us[j].historyBytes {
+			return us[i].historyBytes > us[j].historyBytes
+		}
+		return us[i].resource < us[j].resource
+	})
+	return us, nil
+}
+
+func printResourceUsage(w io.Writer, us []*resourceUsage) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "RESOURCE\tKEYS\tBYTES\tREVISIONS\tHISTORY BYTES\t")
+	for _, u := range us {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t\n", u.resource, u.keys, u.bytes, u.revisions, u.historyBytes)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,92 @@
+// Copyright 2018 The etcd Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/coreos/etcd/lease"
+	"github.com/coreos/etcd/mvcc"
+	"github.com/coreos/etcd/mvcc/backend"
+
+	"go.uber.org/zap"
+)
+
+func TestResourceUsage(t *testing.T) {
+	dir, err := ioutil.TempDir("", "etcd-dump-db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dbPath := filepath.Join(dir, "db")
+
+	be := backend.NewDefaultBackend(dbPath)
+	s := mvcc.New(zap.NewExample(), be, &lease.FakeLessor{}, nil)
+	s.Put([]byte("/registry/pods/default/a"), []byte("12345"), lease.NoLease)
+	s.Put([]byte("/registry/pods/default/b"), []byte("12345"), lease.NoLease)
+	s.Put([]byte("/registry/pods/default/a"), []byte("123"), lease.NoLease)
+	s.DeleteRange([]byte("/registry/pods/default/b"), nil)
+	s.Put([]byte("/registry/configmaps/default/c"), []byte("1"), lease.NoLease)
+	s.Put([]byte("/registry"), []byte("1"), lease.NoLease)
+	s.Put([]byte("foo"), []byte("1"), lease.NoLease)
+	s.Close()
+	be.Close()
+
+	us, err := getResourceUsage(dbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(us) != 2 {
+		t.Fatalf("len(usage) = %d, want 2", len(us))
+	}
+	tests := []struct {
+		resource  string
+		keys      int
+		bytes     int
+		revisions int
+	}{
+		// sorted by history bytes
+		{"pods", 1, len("/registry/pods/default/a") + len("123"), 4},
+		{"configmaps", 1, len("/registry/configmaps/default/c") + len("1"), 1},
+	}
+	for i, tt := range tests {
+		u := us[i]
+		if u.resource != tt.resource || u.keys != tt.keys || u.bytes != tt.bytes || u.revisions != tt.revisions {
+			t.Errorf("#%d: usage = %+v, want resource %q, %d keys, %d bytes, %d revisions", i, *u, tt.resource, tt.keys, tt.bytes, tt.revisions)
+		}
+		if u.historyBytes <= u.bytes {
+			t.Errorf("#%d: history bytes = %d, want more than %d", i, u.historyBytes, u.bytes)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = printResourceUsage(&buf, us); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("printed %d lines, want 3:\n%s", len(lines), buf.String())
+	}
+	for i, w := range []string{"RESOURCE", "pods", "configmaps"} {
+		if f := strings.Fields(lines[i]); f[0] != w {
+			t.Errorf("line %d starts with %q, want %q", i, f[0], w)
+		}
+	}
+}
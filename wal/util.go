@@ -17,6 +17,8 @@ package wal
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/coreos/etcd/pkg/fileutil"
@@ -35,6 +37,23 @@ func Exist(dir string) bool {
 	return len(names) != 0
 }
 
+// InUse returns true if the last WAL file in the given directory is locked,
+// which means another process has the WAL open for appending.
+func InUse(lg *zap.Logger, dir string) (bool, error) {
+	names, err := readWALNames(lg, dir)
+	if err != nil {
+		return false, err
+	}
+	l, err := fileutil.TryLockFile(filepath.Join(dir, names[len(names)-1]), os.O_RDWR, fileutil.PrivateFileMode)
+	if err == fileutil.ErrLocked {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return false, l.Close()
+}
+
 // searchIndex returns the last array index of names whose raft index section is
 // equal to or smaller than the given index.
 // The given names MUST be sorted.
@@ -865,3 +865,27 @@ func TestRenameFail(t *testing.T) {
 		t.Fatalf("expected error, got %v", werr)
 	}
 }
+
+// TestInUse ensures InUse detects a WAL opened for appending.
+func TestInUse(t *testing.T) {
+	p, err := ioutil.TempDir(os.TempDir(), "waltest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(p)
+
+	w, err := Create(zap.NewExample(), p, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inUse, err := InUse(zap.NewExample(), p)
+	if err != nil || !inUse {
+		t.Fatalf("InUse = %v, %v, want true, nil", inUse, err)
+	}
+	w.Close()
+
+	inUse, err = InUse(zap.NewExample(), p)
+	if err != nil || inUse {
+		t.Fatalf("InUse = %v, %v, want false, nil", inUse, err)
+	}
+}